@@ -1,6 +1,9 @@
 package common
 
-import corev1 "k8s.io/api/core/v1"
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 const (
 	// StatusCreated is success status for Nexus resources.
@@ -9,11 +12,61 @@ const (
 	StatusError = "error"
 )
 
+const (
+	// ConditionReady shows that the resource is synced with nexus and is ready to use.
+	ConditionReady = "Ready"
+	// ConditionSynced shows that the latest generation of the resource was applied to nexus.
+	ConditionSynced = "Synced"
+	// ConditionDependenciesResolved shows that the resources that the resource depends on,
+	// like the Nexus instance, are available.
+	ConditionDependenciesResolved = "DependenciesResolved"
+	// ConditionSucceeded shows that the one-shot operation, like NexusTaskRun, finished successfully.
+	ConditionSucceeded = "Succeeded"
+	// ConditionFailed shows that the one-shot operation, like NexusTaskRun, finished with an error.
+	ConditionFailed = "Failed"
+	// ConditionConnectionVerified shows that the operator could connect to the external server
+	// configured in nexus, like the LDAP server.
+	ConditionConnectionVerified = "ConnectionVerified"
+)
+
+const (
+	// NexusKind is the kind of the namespaced Nexus resource.
+	NexusKind = "Nexus"
+	// ClusterNexusKind is the kind of the cluster-scoped Nexus resource.
+	ClusterNexusKind = "ClusterNexus"
+)
+
+// DeletionPolicy defines what happens to the object in nexus when the resource is deleted.
+// +kubebuilder:validation:Enum=Delete;Orphan
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete removes the object from nexus when the resource is deleted.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan leaves the object in nexus when the resource is deleted.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
+// ManagementPolicy defines how the operator manages an object that already exists in nexus.
+// +kubebuilder:validation:Enum=Adopt;FailIfExists;ObserveOnly
+type ManagementPolicy string
+
+const (
+	// ManagementPolicyAdopt takes over the existing object unless it is managed by another resource.
+	ManagementPolicyAdopt ManagementPolicy = "Adopt"
+	// ManagementPolicyFailIfExists fails if the object exists in nexus but was not created by the resource.
+	ManagementPolicyFailIfExists ManagementPolicy = "FailIfExists"
+	// ManagementPolicyObserveOnly only checks that the object exists in nexus and never changes it.
+	ManagementPolicyObserveOnly ManagementPolicy = "ObserveOnly"
+)
+
 // NexusRef is a reference to a Nexus instance.
 type NexusRef struct {
 	// Kind specifies the kind of the Nexus resource.
+	// Nexus is looked up in the namespace of the referring resource, ClusterNexus is cluster-scoped.
 	// +optional
 	// +kubebuilder:default=Nexus
+	// +kubebuilder:validation:Enum=Nexus;ClusterNexus
 	Kind string `json:"kind"`
 
 	// Name specifies the name of the Nexus resource.
@@ -50,3 +103,22 @@ type SecretKeySelector struct {
 	// The key of the secret to select from.
 	Key string `json:"key"`
 }
+
+// ReconcileStatus is the common part of the status of resources that are synced with nexus.
+// +kubebuilder:object:generate=true
+type ReconcileStatus struct {
+	// ObservedGeneration is the generation of the resource that was last processed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastSyncedTime is the time when the resource was last successfully applied to nexus.
+	// It is updated at most once a minute while the resource is not changed.
+	// +optional
+	LastSyncedTime *metav1.Time `json:"lastSyncedTime,omitempty"`
+
+	// Conditions contains Ready, Synced and DependenciesResolved conditions of the resource.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,75 @@
+package v1alpha1
+
+import (
+	"path"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterNexusSpec defines the desired state of ClusterNexus.
+type ClusterNexusSpec struct {
+	// Secret is the name of the k8s object Secret related to nexus.
+	// Secret should contain a user field with a nexus username and a password field with a nexus password.
+	// Secret should be placed in the namespace where the operator is deployed.
+	Secret string `json:"secret"`
+
+	// Url is the url of nexus instance.
+	Url string `json:"url"`
+
+	// AllowedNamespaces is a list of namespaces that are allowed to refer to the ClusterNexus.
+	// Glob patterns are supported, e.g. team-*.
+	// If the list is empty, all namespaces are allowed.
+	// +optional
+	// +kubebuilder:example={team-a,team-b-*}
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+
+	// TLS contains TLS settings used to connect to nexus.
+	// Referenced ConfigMaps and Secrets should be placed in the namespace where the operator is deployed.
+	// +optional
+	TLS *NexusTLS `json:"tls,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Connected",type="boolean",JSONPath=".status.connected",description="Is connected to nexus"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version",description="Nexus version"
+// +kubebuilder:printcolumn:name="Read Only",type="boolean",JSONPath=".status.readOnly",description="Is nexus in read-only mode"
+
+// ClusterNexus is the Schema for the clusternexuses API.
+// ClusterNexus is a cluster-scoped Nexus connection that can be shared by resources from many namespaces.
+type ClusterNexus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterNexusSpec `json:"spec,omitempty"`
+	Status NexusStatus      `json:"status,omitempty"`
+}
+
+// IsNamespaceAllowed checks if resources from the given namespace may refer to the ClusterNexus.
+func (in *ClusterNexus) IsNamespaceAllowed(namespace string) bool {
+	if len(in.Spec.AllowedNamespaces) == 0 {
+		return true
+	}
+
+	for _, pattern := range in.Spec.AllowedNamespaces {
+		if ok, err := path.Match(pattern, namespace); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterNexusList contains a list of ClusterNexus.
+type ClusterNexusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterNexus `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterNexus{}, &ClusterNexusList{})
+}
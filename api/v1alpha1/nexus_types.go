@@ -2,6 +2,8 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
 )
 
 // NexusSpec defines the desired state of Nexus.
@@ -12,6 +14,29 @@ type NexusSpec struct {
 
 	// Url is the url of nexus instance.
 	Url string `json:"url"`
+
+	// TLS contains TLS settings used to connect to nexus.
+	// +optional
+	TLS *NexusTLS `json:"tls,omitempty"`
+}
+
+// NexusTLS defines TLS settings used to connect to nexus.
+type NexusTLS struct {
+	// CA is a reference to a ConfigMap or a Secret key with PEM encoded CA certificates.
+	// The certificates are used to verify the nexus server certificate in addition to the system CAs.
+	// +optional
+	CA *common.SourceRef `json:"ca,omitempty"`
+
+	// ClientCertSecret is the name of the kubernetes.io/tls Secret with a client certificate and key.
+	// The certificate is used for mutual TLS authentication.
+	// Secret should contain tls.crt and tls.key fields.
+	// +optional
+	ClientCertSecret string `json:"clientCertSecret,omitempty"`
+
+	// InsecureSkipVerify disables verification of the nexus server certificate.
+	// Use it only for testing purposes.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 }
 
 // NexusStatus defines the observed state of Nexus.
@@ -23,6 +48,29 @@ type NexusStatus struct {
 	// Connected shows if operator is connected to nexus.
 	// +optional
 	Connected bool `json:"connected"`
+
+	// Version is the version of nexus.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Edition is the edition of nexus, e.g. OSS or PRO.
+	// +optional
+	Edition string `json:"edition,omitempty"`
+
+	// NodeID is the identifier of the nexus node.
+	// +optional
+	NodeID string `json:"nodeId,omitempty"`
+
+	// ReadOnly shows if nexus node is in read-only mode.
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// Conditions contain results of nexus system health checks, e.g. BlobStores or AvailableCPUs.
+	// The condition status is False if the check is failed.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -30,6 +78,8 @@ type NexusStatus struct {
 // +kubebuilder:storageversion
 // +kubebuilder:resource:path=nexuses
 // +kubebuilder:printcolumn:name="Connected",type="boolean",JSONPath=".status.connected",description="Is connected to nexus"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version",description="Nexus version"
+// +kubebuilder:printcolumn:name="Read Only",type="boolean",JSONPath=".status.readOnly",description="Is nexus in read-only mode"
 
 // Nexus is the Schema for the nexus API.
 type Nexus struct {
@@ -0,0 +1,83 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+// NexusAnonymousAccessSpec defines the desired state of NexusAnonymousAccess.
+type NexusAnonymousAccessSpec struct {
+	// Enabled allows anonymous users to access nexus.
+	// +required
+	Enabled bool `json:"enabled"`
+
+	// UserID is the username of the anonymous account.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	// +kubebuilder:default=anonymous
+	// +optional
+	UserID string `json:"userId,omitempty"`
+
+	// RealmName is the name of the authentication realm of the anonymous account.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:default=NexusAuthorizingRealm
+	// +optional
+	RealmName string `json:"realmName,omitempty"`
+
+	// NexusRef is a reference to Nexus custom resource.
+	// Only one NexusAnonymousAccess can manage anonymous access of the Nexus instance.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +required
+	NexusRef common.NexusRef `json:"nexusRef"`
+}
+
+// NexusAnonymousAccessStatus defines the observed state of NexusAnonymousAccess.
+type NexusAnonymousAccessStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
+	// Enabled shows whether anonymous access is enabled in nexus.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Value is a status of the anonymous access.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Error is an error message if something went wrong.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Enabled",type="boolean",JSONPath=".status.enabled",description="Is anonymous access enabled in nexus"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the anonymous access synced with nexus"
+
+// NexusAnonymousAccess is the Schema for the nexusanonymousaccesses API.
+// It manages the anonymous access settings of the Nexus instance.
+type NexusAnonymousAccess struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NexusAnonymousAccessSpec   `json:"spec,omitempty"`
+	Status NexusAnonymousAccessStatus `json:"status,omitempty"`
+}
+
+func (in *NexusAnonymousAccess) GetNexusRef() common.NexusRef {
+	return in.Spec.NexusRef
+}
+
+// +kubebuilder:object:root=true
+
+// NexusAnonymousAccessList contains a list of NexusAnonymousAccess.
+type NexusAnonymousAccessList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NexusAnonymousAccess `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NexusAnonymousAccess{}, &NexusAnonymousAccessList{})
+}
@@ -40,6 +40,20 @@ type NexusBlobStoreSpec struct {
 	// NexusRef is a reference to Nexus custom resource.
 	// +required
 	NexusRef common.NexusRef `json:"nexusRef"`
+
+	// DeletionPolicy defines what happens to the object in nexus when the resource is deleted.
+	// Delete removes the object from nexus, Orphan leaves it in nexus.
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the object if it already exists in nexus.
+	// Adopt takes over the object, FailIfExists fails if the object was not created by the resource,
+	// ObserveOnly only checks that the object exists and never changes or deletes it.
+	// The object can't be managed by two resources at the same time.
+	// +optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy common.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 type SoftQuota struct {
@@ -148,6 +162,8 @@ type S3AdvancedBucketConnection struct {
 
 // NexusBlobStoreStatus defines the observed state of NexusBlobStore.
 type NexusBlobStoreStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is a status of the blob store.
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -160,6 +176,7 @@ type NexusBlobStoreStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Status of the blob store"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the blob store synced with nexus"
 
 // NexusBlobStore is the Schema for the nexusblobstores API.
 type NexusBlobStore struct {
@@ -33,6 +33,12 @@ type NexusCleanupPolicySpec struct {
 	// NexusRef is a reference to Nexus custom resource.
 	// +required
 	NexusRef common.NexusRef `json:"nexusRef"`
+
+	// DeletionPolicy defines what happens to the object in nexus when the resource is deleted.
+	// Delete removes the object from nexus, Orphan leaves it in nexus.
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 type Criteria struct {
@@ -64,6 +70,8 @@ type Criteria struct {
 
 // NexusCleanupPolicyStatus defines the observed state of NexusCleanupPolicy.
 type NexusCleanupPolicyStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is a status of the cleanup policy.
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -79,6 +87,7 @@ func (in *NexusCleanupPolicy) GetNexusRef() common.NexusRef {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the cleanup policy synced with nexus"
 
 // NexusCleanupPolicy is the Schema for the cleanuppolicies API.
 type NexusCleanupPolicy struct {
@@ -0,0 +1,92 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+// NexusContentSelectorSpec defines the desired state of NexusContentSelector.
+type NexusContentSelectorSpec struct {
+	// Name is the name of the content selector.
+	// Name should be unique across all content selectors.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +kubebuilder:validation:MaxLength=512
+	// +required
+	// +kubebuilder:example="team-a-artifacts"
+	Name string `json:"name"`
+
+	// Description of the content selector.
+	// +optional
+	// +kubebuilder:example="Artifacts of team A"
+	Description string `json:"description,omitempty"`
+
+	// Expression is the CSEL expression that identifies content.
+	// It compares format, path and coordinate.<name> with string literals using ==, !=, =~ and =^ operators.
+	// Comparisons can be combined with and (&&), or (||) and parentheses.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	// +kubebuilder:example="format == \"maven2\" and path =^ \"/com/acme/teama/\""
+	Expression string `json:"expression"`
+
+	// NexusRef is a reference to Nexus custom resource.
+	// +required
+	NexusRef common.NexusRef `json:"nexusRef"`
+
+	// DeletionPolicy defines what happens to the object in nexus when the resource is deleted.
+	// Delete removes the object from nexus, Orphan leaves it in nexus.
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the object if it already exists in nexus.
+	// Adopt takes over the object, FailIfExists fails if the object was not created by the resource,
+	// ObserveOnly only checks that the object exists and never changes or deletes it.
+	// The object can't be managed by two resources at the same time.
+	// +optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy common.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+// NexusContentSelectorStatus defines the observed state of NexusContentSelector.
+type NexusContentSelectorStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
+	// Value is a status of the content selector.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Error is an error message if something went wrong.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the content selector synced with nexus"
+
+// NexusContentSelector is the Schema for the nexuscontentselectors API.
+type NexusContentSelector struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NexusContentSelectorSpec   `json:"spec,omitempty"`
+	Status NexusContentSelectorStatus `json:"status,omitempty"`
+}
+
+func (in *NexusContentSelector) GetNexusRef() common.NexusRef {
+	return in.Spec.NexusRef
+}
+
+// +kubebuilder:object:root=true
+
+// NexusContentSelectorList contains a list of NexusContentSelector.
+type NexusContentSelectorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NexusContentSelector `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NexusContentSelector{}, &NexusContentSelectorList{})
+}
@@ -0,0 +1,280 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+const (
+	LdapAuthSchemeNone      = "NONE"
+	LdapAuthSchemeSimple    = "SIMPLE"
+	LdapAuthSchemeDigestMD5 = "DIGEST_MD5"
+	LdapAuthSchemeCramMD5   = "CRAM_MD5"
+
+	LdapGroupTypeStatic  = "static"
+	LdapGroupTypeDynamic = "dynamic"
+)
+
+// NexusLdapServerSpec defines the desired state of NexusLdapServer.
+type NexusLdapServerSpec struct {
+	// Name is the name of the LDAP server connection in nexus.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	// +required
+	// +kubebuilder:example="corporate-ldap"
+	Name string `json:"name"`
+
+	// Order defines the position of the LDAP server in the list of servers nexus uses to authenticate users.
+	// Servers with a lower order are used first, servers of the same order are sorted by name.
+	// LDAP servers that are not managed by the operator are placed after the managed ones.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=0
+	Order int32 `json:"order,omitempty"`
+
+	// Connection contains the settings of the connection to the LDAP server.
+	// +required
+	Connection LdapConnection `json:"connection"`
+
+	// UserMapping defines how users are found in LDAP.
+	// +optional
+	UserMapping LdapUserMapping `json:"userMapping,omitempty"`
+
+	// GroupMapping defines how LDAP groups are mapped to nexus roles.
+	// If it is not set, LDAP groups are not used as roles.
+	// +optional
+	GroupMapping *LdapGroupMapping `json:"groupMapping,omitempty"`
+
+	// NexusRef is a reference to Nexus custom resource.
+	// +required
+	NexusRef common.NexusRef `json:"nexusRef"`
+
+	// DeletionPolicy defines what happens to the object in nexus when the resource is deleted.
+	// Delete removes the object from nexus, Orphan leaves it in nexus.
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the object if it already exists in nexus.
+	// Adopt takes over the object, FailIfExists fails if the object was not created by the resource,
+	// ObserveOnly only checks that the object exists and never changes or deletes it.
+	// The object can't be managed by two resources at the same time.
+	// +optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy common.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+// LdapConnection contains the settings of the connection to the LDAP server.
+// +kubebuilder:validation:XValidation:rule="self.authScheme == 'NONE' || (has(self.authUsername) && has(self.authPasswordRef))",message="authUsername and authPasswordRef are required if authScheme is not NONE"
+// +kubebuilder:validation:XValidation:rule="!(self.authScheme == 'DIGEST_MD5' || self.authScheme == 'CRAM_MD5') || has(self.authRealm)",message="authRealm is required if authScheme is DIGEST_MD5 or CRAM_MD5"
+type LdapConnection struct {
+	// Protocol is the protocol of the connection.
+	// +optional
+	// +kubebuilder:validation:Enum=ldap;ldaps
+	// +kubebuilder:default=ldap
+	Protocol string `json:"protocol,omitempty"`
+
+	// Host is the hostname of the LDAP server.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	// +kubebuilder:example="ldap.example.com"
+	Host string `json:"host"`
+
+	// Port is the port of the LDAP server.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=389
+	Port int32 `json:"port,omitempty"`
+
+	// SearchBase is the LDAP location to be added to the connection URL.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	// +kubebuilder:example="dc=example,dc=com"
+	SearchBase string `json:"searchBase"`
+
+	// UseTrustStore enables using certificates stored in the nexus truststore for ldaps connections.
+	// The operator can't verify such connections, so the ConnectionVerified condition is Unknown.
+	// +optional
+	UseTrustStore bool `json:"useTrustStore,omitempty"`
+
+	// AuthScheme is the authentication scheme used for connecting to the LDAP server.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;SIMPLE;DIGEST_MD5;CRAM_MD5
+	// +kubebuilder:default=SIMPLE
+	AuthScheme string `json:"authScheme,omitempty"`
+
+	// AuthRealm is the SASL realm to bind to. It is required if authScheme is DIGEST_MD5 or CRAM_MD5.
+	// +optional
+	AuthRealm string `json:"authRealm,omitempty"`
+
+	// AuthUsername is the user to bind with.
+	// It must be a fully qualified username if the SIMPLE authentication is used.
+	// +optional
+	// +kubebuilder:example="cn=nexus,ou=services,dc=example,dc=com"
+	AuthUsername string `json:"authUsername,omitempty"`
+
+	// AuthPasswordRef is a reference to the Secret key with the password to bind with.
+	// The password is updated in nexus when the Secret changes.
+	// +optional
+	AuthPasswordRef *common.SecretKeySelector `json:"authPasswordRef,omitempty"`
+
+	// ConnectionTimeoutSeconds is the time to wait before the connection times out.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	// +kubebuilder:default=30
+	ConnectionTimeoutSeconds int32 `json:"connectionTimeoutSeconds,omitempty"`
+
+	// ConnectionRetryDelaySeconds is the time to wait before retrying the connection.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=300
+	ConnectionRetryDelaySeconds int32 `json:"connectionRetryDelaySeconds,omitempty"`
+
+	// MaxIncidentsCount is the number of retry attempts.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=3
+	MaxIncidentsCount int32 `json:"maxIncidentsCount,omitempty"`
+}
+
+// LdapUserMapping defines how users are found in LDAP.
+type LdapUserMapping struct {
+	// BaseDN is the relative DN where user objects are found, e.g. ou=people.
+	// It is appended to the search base.
+	// +optional
+	BaseDN string `json:"baseDn,omitempty"`
+
+	// Subtree enables searching users in structures below the base DN.
+	// +optional
+	Subtree bool `json:"subtree,omitempty"`
+
+	// ObjectClass is the LDAP class of user objects.
+	// +optional
+	// +kubebuilder:default=inetOrgPerson
+	ObjectClass string `json:"objectClass,omitempty"`
+
+	// LdapFilter is the LDAP search filter to limit user search.
+	// +optional
+	// +kubebuilder:example="(|(mail=*@example.com)(uid=dom*))"
+	LdapFilter string `json:"ldapFilter,omitempty"`
+
+	// IDAttribute is the attribute of the user ID.
+	// +optional
+	// +kubebuilder:default=uid
+	IDAttribute string `json:"idAttribute,omitempty"`
+
+	// RealNameAttribute is the attribute of the real name of the user.
+	// +optional
+	// +kubebuilder:default=cn
+	RealNameAttribute string `json:"realNameAttribute,omitempty"`
+
+	// EmailAddressAttribute is the attribute of the email address of the user.
+	// +optional
+	// +kubebuilder:default=mail
+	EmailAddressAttribute string `json:"emailAddressAttribute,omitempty"`
+
+	// PasswordAttribute is the attribute of the user password.
+	// If it is not set, users are authenticated with a bind to the LDAP server.
+	// +optional
+	PasswordAttribute string `json:"passwordAttribute,omitempty"`
+}
+
+// LdapGroupMapping defines how LDAP groups are mapped to nexus roles.
+// +kubebuilder:validation:XValidation:rule="self.type != 'static' || (has(self.objectClass) && has(self.idAttribute) && has(self.memberAttribute) && has(self.memberFormat))",message="objectClass, idAttribute, memberAttribute and memberFormat are required for static groups"
+// +kubebuilder:validation:XValidation:rule="self.type != 'dynamic' || has(self.userMemberOfAttribute)",message="userMemberOfAttribute is required for dynamic groups"
+type LdapGroupMapping struct {
+	// Type is the type of groups.
+	// static groups contain a list of users, dynamic groups are listed in the user object.
+	// +kubebuilder:validation:Enum=static;dynamic
+	// +required
+	Type string `json:"type"`
+
+	// BaseDN is the relative DN where group objects are found, e.g. ou=groups.
+	// It is appended to the search base.
+	// +optional
+	BaseDN string `json:"baseDn,omitempty"`
+
+	// Subtree enables searching groups in structures below the base DN.
+	// +optional
+	Subtree bool `json:"subtree,omitempty"`
+
+	// ObjectClass is the LDAP class of group objects. It is required for static groups.
+	// +optional
+	// +kubebuilder:example="groupOfNames"
+	ObjectClass string `json:"objectClass,omitempty"`
+
+	// IDAttribute is the attribute of the group ID. It is required for static groups.
+	// +optional
+	// +kubebuilder:example="cn"
+	IDAttribute string `json:"idAttribute,omitempty"`
+
+	// MemberAttribute is the attribute containing the users of the group. It is required for static groups.
+	// +optional
+	// +kubebuilder:example="member"
+	MemberAttribute string `json:"memberAttribute,omitempty"`
+
+	// MemberFormat is the format of the user ID stored in the member attribute. It is required for static groups.
+	// +optional
+	// +kubebuilder:example="uid=${username},ou=people,dc=example,dc=com"
+	MemberFormat string `json:"memberFormat,omitempty"`
+
+	// UserMemberOfAttribute is the attribute of the user object containing DNs of its groups.
+	// It is required for dynamic groups.
+	// +optional
+	// +kubebuilder:example="memberOf"
+	UserMemberOfAttribute string `json:"userMemberOfAttribute,omitempty"`
+}
+
+// NexusLdapServerStatus defines the observed state of NexusLdapServer.
+type NexusLdapServerStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
+	// SecretsVersion contains the resource version of the Secret with the bind password
+	// applied to nexus. It is used to update the password when the Secret changes.
+	// +optional
+	SecretsVersion string `json:"secretsVersion,omitempty"`
+
+	// Value is a status of the LDAP server.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Error is an error message if something went wrong.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Host",type="string",JSONPath=".spec.connection.host",description="Host of the LDAP server"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the LDAP server synced with nexus"
+// +kubebuilder:printcolumn:name="Connection Verified",type="string",JSONPath=".status.conditions[?(@.type==\"ConnectionVerified\")].status",description="Can the operator connect to the LDAP server"
+
+// NexusLdapServer is the Schema for the nexusldapservers API.
+type NexusLdapServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NexusLdapServerSpec   `json:"spec,omitempty"`
+	Status NexusLdapServerStatus `json:"status,omitempty"`
+}
+
+func (in *NexusLdapServer) GetNexusRef() common.NexusRef {
+	return in.Spec.NexusRef
+}
+
+// +kubebuilder:object:root=true
+
+// NexusLdapServerList contains a list of NexusLdapServer.
+type NexusLdapServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NexusLdapServer `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NexusLdapServer{}, &NexusLdapServerList{})
+}
@@ -0,0 +1,150 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+// PrivilegeType is a type of nexus privilege.
+// +kubebuilder:validation:Enum=application;wildcard;repository-view;repository-admin;repository-content-selector;script
+type PrivilegeType string
+
+const (
+	PrivilegeTypeApplication               PrivilegeType = "application"
+	PrivilegeTypeWildcard                  PrivilegeType = "wildcard"
+	PrivilegeTypeRepositoryView            PrivilegeType = "repository-view"
+	PrivilegeTypeRepositoryAdmin           PrivilegeType = "repository-admin"
+	PrivilegeTypeRepositoryContentSelector PrivilegeType = "repository-content-selector"
+	PrivilegeTypeScript                    PrivilegeType = "script"
+)
+
+// NexusPrivilegeSpec defines the desired state of NexusPrivilege.
+// +kubebuilder:validation:XValidation:rule="self.type != 'application' || (has(self.domain) && has(self.actions))",message="domain and actions are required for application privilege"
+// +kubebuilder:validation:XValidation:rule="self.type != 'wildcard' || has(self.pattern)",message="pattern is required for wildcard privilege"
+// +kubebuilder:validation:XValidation:rule="!self.type.startsWith('repository-') || (has(self.format) && has(self.repository) && has(self.actions))",message="format, repository and actions are required for repository privilege"
+// +kubebuilder:validation:XValidation:rule="self.type != 'repository-content-selector' || has(self.contentSelector)",message="contentSelector is required for repository-content-selector privilege"
+// +kubebuilder:validation:XValidation:rule="self.type != 'script' || has(self.scriptName)",message="scriptName is required for script privilege"
+type NexusPrivilegeSpec struct {
+	// Name is the name of the privilege.
+	// Name should be unique across all privileges.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +kubebuilder:validation:MaxLength=512
+	// +required
+	// +kubebuilder:example="nx-repository-view-maven2-maven-releases-browse"
+	Name string `json:"name"`
+
+	// Type is the type of the privilege.
+	// Nexus doesn't allow to change the type of the existing privilege.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +required
+	// +kubebuilder:example="repository-view"
+	Type PrivilegeType `json:"type"`
+
+	// Description of the privilege.
+	// +optional
+	// +kubebuilder:example="Browse maven-releases repository"
+	Description string `json:"description,omitempty"`
+
+	// Actions is a list of actions allowed by the privilege.
+	// It is required for all privilege types except wildcard.
+	// +kubebuilder:validation:items:Enum=ADD;READ;EDIT;DELETE;BROWSE;CREATE;UPDATE;RUN;ALL
+	// +optional
+	// +kubebuilder:example={BROWSE,READ}
+	Actions []string `json:"actions,omitempty"`
+
+	// Domain is the domain of the application privilege, e.g. users or blobstores.
+	// +optional
+	// +kubebuilder:example="users"
+	Domain string `json:"domain,omitempty"`
+
+	// Pattern is the pattern of the wildcard privilege.
+	// +optional
+	// +kubebuilder:example="nexus:repository-view:*:*:read"
+	Pattern string `json:"pattern,omitempty"`
+
+	// Format is the repository format of the repository privilege, e.g. maven2 or npm.
+	// Use * to allow all formats.
+	// +optional
+	// +kubebuilder:example="maven2"
+	Format string `json:"format,omitempty"`
+
+	// Repository is the name of the repository of the repository privilege.
+	// Use * to allow all repositories, *-<format> to allow all repositories of the format.
+	// +optional
+	// +kubebuilder:example="maven-releases"
+	Repository string `json:"repository,omitempty"`
+
+	// ContentSelector is the name of the content selector of the repository-content-selector privilege,
+	// e.g. the name of the content selector managed by NexusContentSelector.
+	// +optional
+	// +kubebuilder:example="team-a-artifacts"
+	ContentSelector string `json:"contentSelector,omitempty"`
+
+	// ScriptName is the name of the script of the script privilege.
+	// +optional
+	// +kubebuilder:example="cleanup"
+	ScriptName string `json:"scriptName,omitempty"`
+
+	// NexusRef is a reference to Nexus custom resource.
+	// +required
+	NexusRef common.NexusRef `json:"nexusRef"`
+
+	// DeletionPolicy defines what happens to the object in nexus when the resource is deleted.
+	// Delete removes the object from nexus, Orphan leaves it in nexus.
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the object if it already exists in nexus.
+	// Adopt takes over the object, FailIfExists fails if the object was not created by the resource,
+	// ObserveOnly only checks that the object exists and never changes or deletes it.
+	// The object can't be managed by two resources at the same time.
+	// +optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy common.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+// NexusPrivilegeStatus defines the observed state of NexusPrivilege.
+type NexusPrivilegeStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
+	// Value is a status of the privilege.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Error is an error message if something went wrong.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Type of the privilege"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the privilege synced with nexus"
+
+// NexusPrivilege is the Schema for the nexusprivileges API.
+type NexusPrivilege struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NexusPrivilegeSpec   `json:"spec,omitempty"`
+	Status NexusPrivilegeStatus `json:"status,omitempty"`
+}
+
+func (in *NexusPrivilege) GetNexusRef() common.NexusRef {
+	return in.Spec.NexusRef
+}
+
+// +kubebuilder:object:root=true
+
+// NexusPrivilegeList contains a list of NexusPrivilege.
+type NexusPrivilegeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NexusPrivilege `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NexusPrivilege{}, &NexusPrivilegeList{})
+}
@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+// NexusRealmsSpec defines the desired state of NexusRealms.
+type NexusRealmsSpec struct {
+	// ActiveRealms is the ordered list of ids of the active security realms,
+	// e.g. NexusAuthenticatingRealm, DockerToken, NpmToken, LdapRealm or SamlRealm.
+	// Nexus authenticates users with the realms in the given order.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=256
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y == x))",message="realms must be unique"
+	// +required
+	// +kubebuilder:example={NexusAuthenticatingRealm,DockerToken}
+	ActiveRealms []string `json:"activeRealms"`
+
+	// NexusRef is a reference to Nexus custom resource.
+	// Only one NexusRealms can manage realms of the Nexus instance.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +required
+	NexusRef common.NexusRef `json:"nexusRef"`
+}
+
+// NexusRealmsStatus defines the observed state of NexusRealms.
+type NexusRealmsStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
+	// ActiveRealms is the ordered list of ids of the realms that are active in nexus.
+	// +optional
+	ActiveRealms []string `json:"activeRealms,omitempty"`
+
+	// Value is a status of the realms.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Error is an error message if something went wrong.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nexusrealms,singular=nexusrealms
+// +kubebuilder:printcolumn:name="Active Realms",type="string",JSONPath=".status.activeRealms",description="Realms that are active in nexus"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Are the realms synced with nexus"
+
+// NexusRealms is the Schema for the nexusrealms API.
+// It manages the ordered list of active security realms of the Nexus instance.
+type NexusRealms struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NexusRealmsSpec   `json:"spec,omitempty"`
+	Status NexusRealmsStatus `json:"status,omitempty"`
+}
+
+func (in *NexusRealms) GetNexusRef() common.NexusRef {
+	return in.Spec.NexusRef
+}
+
+// +kubebuilder:object:root=true
+
+// NexusRealmsList contains a list of NexusRealms.
+type NexusRealmsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NexusRealms `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NexusRealms{}, &NexusRealmsList{})
+}
@@ -6,6 +6,17 @@ import (
 	"github.com/epam/edp-nexus-operator/api/common"
 )
 
+const (
+	// AllowRenameAnnotation allows changing the name of the repository.
+	// The repository is created in nexus with the new name, and the old one is left in nexus.
+	// The content of the repository is not moved.
+	AllowRenameAnnotation = "edp.epam.com/allow-rename"
+
+	// RenameDeleteOldAnnotation deletes the repository with the old name and all its content after rename,
+	// unless the deletion policy is Orphan.
+	RenameDeleteOldAnnotation = "edp.epam.com/rename-delete-old"
+)
+
 // NexusRepositorySpec defines the desired state of NexusRepository.
 // It should contain only one format of repository - go, maven, npm, etc. and only one type - proxy, hosted or group.
 type NexusRepositorySpec struct {
@@ -31,6 +42,20 @@ type NexusRepositorySpec struct {
 	// NexusRef is a reference to Nexus custom resource.
 	// +required
 	NexusRef common.NexusRef `json:"nexusRef"`
+
+	// DeletionPolicy defines what happens to the object in nexus when the resource is deleted.
+	// Delete removes the object from nexus, Orphan leaves it in nexus.
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the object if it already exists in nexus.
+	// Adopt takes over the object, FailIfExists fails if the object was not created by the resource,
+	// ObserveOnly only checks that the object exists and never changes or deletes it.
+	// The object can't be managed by two resources at the same time.
+	// +optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy common.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 type NpmSpec struct {
@@ -130,6 +155,8 @@ type YumSpec struct {
 
 // NexusRepositoryStatus defines the observed state of NexusRepository.
 type NexusRepositoryStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is a status of the repository.
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -137,10 +164,34 @@ type NexusRepositoryStatus struct {
 	// Error is an error message if something went wrong.
 	// +optional
 	Error string `json:"error,omitempty"`
+
+	// NexusName is the name of the repository that was applied to nexus.
+	// It is used to remove the old repository when the name is changed.
+	// +optional
+	NexusName string `json:"nexusName,omitempty"`
+
+	// SecretsVersion contains resource versions of the Secrets referenced by the repository
+	// that were applied to nexus. The repository is updated in nexus when the Secrets change.
+	// +optional
+	SecretsVersion string `json:"secretsVersion,omitempty"`
+
+	// Drift is the last detected change of the repository made in nexus outside the operator.
+	// +optional
+	Drift *RepositoryDrift `json:"drift,omitempty"`
+}
+
+// RepositoryDrift describes the repository fields that were changed in nexus and reverted by the operator.
+type RepositoryDrift struct {
+	// Fields is a list of paths of the fields that differed from the spec, e.g. storage.writePolicy.
+	Fields []string `json:"fields"`
+
+	// DetectedTime is the time when the drift was detected.
+	DetectedTime metav1.Time `json:"detectedTime"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the repository synced with nexus"
 
 // NexusRepository is the Schema for the nexusrepositories API.
 type NexusRepository struct {
@@ -6,6 +6,15 @@ import (
 	"github.com/epam/edp-nexus-operator/api/common"
 )
 
+// User sources of nexus.
+// Users and roles of the default source are stored in nexus, other sources come from external realms.
+const (
+	UserSourceDefault = "default"
+	UserSourceLdap    = "LDAP"
+	UserSourceSaml    = "SAML"
+	UserSourceCrowd   = "Crowd"
+)
+
 // NexusRoleSpec defines the desired state of NexusRole.
 type NexusRoleSpec struct {
 	// ID is the id of the role.
@@ -32,13 +41,45 @@ type NexusRoleSpec struct {
 	// +kubebuilder:example={nx-all}
 	Privileges []string `json:"privileges,omitempty"`
 
+	// Roles is a list of roles contained in the role. The role gets all their privileges.
+	// +nullable
+	// +optional
+	// +kubebuilder:example={nx-anonymous}
+	Roles []string `json:"roles,omitempty"`
+
+	// Source is the user source of the group mapped to the role.
+	// The default source defines an internal nexus role.
+	// For other sources, ID must be the ID of the external group, e.g. the LDAP group name,
+	// so users of the group get the role. The operator checks that the group exists in the source,
+	// except for SAML which doesn't list its groups.
+	// +optional
+	// +kubebuilder:validation:Enum=default;LDAP;SAML;Crowd
+	// +kubebuilder:default=default
+	Source string `json:"source,omitempty"`
+
 	// NexusRef is a reference to Nexus custom resource.
 	// +required
 	NexusRef common.NexusRef `json:"nexusRef"`
+
+	// DeletionPolicy defines what happens to the object in nexus when the resource is deleted.
+	// Delete removes the object from nexus, Orphan leaves it in nexus.
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the object if it already exists in nexus.
+	// Adopt takes over the object, FailIfExists fails if the object was not created by the resource,
+	// ObserveOnly only checks that the object exists and never changes or deletes it.
+	// The object can't be managed by two resources at the same time.
+	// +optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy common.ManagementPolicy `json:"managementPolicy,omitempty"`
 }
 
 // NexusRoleStatus defines the observed state of NexusRole.
 type NexusRoleStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is a status of the role.
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -50,6 +91,7 @@ type NexusRoleStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the role synced with nexus"
 
 // NexusRole is the Schema for the nexusroles API.
 type NexusRole struct {
@@ -0,0 +1,108 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+// RoutingRuleMode defines what happens to the requests that match the routing rule.
+// +kubebuilder:validation:Enum=ALLOW;BLOCK
+type RoutingRuleMode string
+
+const (
+	RoutingRuleModeAllow RoutingRuleMode = "ALLOW"
+	RoutingRuleModeBlock RoutingRuleMode = "BLOCK"
+)
+
+// NexusRoutingRuleSpec defines the desired state of NexusRoutingRule.
+type NexusRoutingRuleSpec struct {
+	// Name is the name of the routing rule.
+	// Repositories refer to the routing rule by this name.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9-][a-zA-Z0-9_.-]*$`
+	// +kubebuilder:validation:MaxLength=200
+	// +required
+	// +kubebuilder:example="go-proxy-routing-rule"
+	Name string `json:"name"`
+
+	// Description of the routing rule.
+	// +optional
+	// +kubebuilder:example="Allow only golang.org packages"
+	Description string `json:"description,omitempty"`
+
+	// Mode defines what happens to the requests that match the matchers.
+	// ALLOW allows only matching requests, BLOCK blocks matching requests.
+	// +optional
+	// +kubebuilder:default=BLOCK
+	Mode RoutingRuleMode `json:"mode,omitempty"`
+
+	// Matchers is a list of regular expressions used to identify request paths that are allowed or blocked.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	// +required
+	// +kubebuilder:example={"^/golang.org/.*"}
+	Matchers []string `json:"matchers"`
+
+	// NexusRef is a reference to Nexus custom resource.
+	// +required
+	NexusRef common.NexusRef `json:"nexusRef"`
+
+	// DeletionPolicy defines what happens to the object in nexus when the resource is deleted.
+	// Delete removes the object from nexus, Orphan leaves it in nexus.
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the object if it already exists in nexus.
+	// Adopt takes over the object, FailIfExists fails if the object was not created by the resource,
+	// ObserveOnly only checks that the object exists and never changes or deletes it.
+	// The object can't be managed by two resources at the same time.
+	// +optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy common.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+// NexusRoutingRuleStatus defines the observed state of NexusRoutingRule.
+type NexusRoutingRuleStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
+	// Value is a status of the routing rule.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Error is an error message if something went wrong.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=".spec.mode",description="Mode of the routing rule"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the routing rule synced with nexus"
+
+// NexusRoutingRule is the Schema for the nexusroutingrules API.
+type NexusRoutingRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NexusRoutingRuleSpec   `json:"spec,omitempty"`
+	Status NexusRoutingRuleStatus `json:"status,omitempty"`
+}
+
+func (in *NexusRoutingRule) GetNexusRef() common.NexusRef {
+	return in.Spec.NexusRef
+}
+
+// +kubebuilder:object:root=true
+
+// NexusRoutingRuleList contains a list of NexusRoutingRule.
+type NexusRoutingRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NexusRoutingRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NexusRoutingRule{}, &NexusRoutingRuleList{})
+}
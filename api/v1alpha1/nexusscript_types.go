@@ -34,10 +34,18 @@ type NexusScriptSpec struct {
 	// NexusRef is a reference to Nexus custom resource.
 	// +required
 	NexusRef common.NexusRef `json:"nexusRef"`
+
+	// DeletionPolicy defines what happens to the object in nexus when the resource is deleted.
+	// Delete removes the object from nexus, Orphan leaves it in nexus.
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // NexusScriptStatus defines the observed state of NexusScript.
 type NexusScriptStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is a status of the script.
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -52,6 +60,7 @@ type NexusScriptStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the script synced with nexus"
 
 // NexusScript is the Schema for the nexusscripts API.
 type NexusScript struct {
@@ -0,0 +1,186 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+// TaskInterval is a predefined schedule of nexus task.
+// +kubebuilder:validation:Enum=manual;once;hourly;daily;weekly;monthly
+type TaskInterval string
+
+const (
+	TaskIntervalManual  TaskInterval = "manual"
+	TaskIntervalOnce    TaskInterval = "once"
+	TaskIntervalHourly  TaskInterval = "hourly"
+	TaskIntervalDaily   TaskInterval = "daily"
+	TaskIntervalWeekly  TaskInterval = "weekly"
+	TaskIntervalMonthly TaskInterval = "monthly"
+)
+
+// TaskNotificationCondition defines when the alert email is sent.
+// +kubebuilder:validation:Enum=FAILURE;SUCCESS_FAILURE
+type TaskNotificationCondition string
+
+const (
+	TaskNotificationConditionFailure        TaskNotificationCondition = "FAILURE"
+	TaskNotificationConditionSuccessFailure TaskNotificationCondition = "SUCCESS_FAILURE"
+)
+
+// NexusTaskSpec defines the desired state of NexusTask.
+type NexusTaskSpec struct {
+	// Name is the name of the task.
+	// +kubebuilder:validation:MaxLength=512
+	// +required
+	// +kubebuilder:example="compact-default-blobstore"
+	Name string `json:"name"`
+
+	// Type is the type of the task, e.g. blobstore.compact, repository.cleanup,
+	// repository.docker.gc or repository.rebuild-index.
+	// Nexus doesn't allow to change the type of the existing task.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	// +kubebuilder:example="blobstore.compact"
+	Type string `json:"type"`
+
+	// Enabled defines if the task is scheduled.
+	// +optional
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled"`
+
+	// Properties are type-specific settings of the task, e.g. blobstoreName for blobstore.compact
+	// or repositoryName for repository.rebuild-index.
+	// +optional
+	// +kubebuilder:example={"blobstoreName":"default"}
+	Properties map[string]string `json:"properties,omitempty"`
+
+	// Schedule defines when the task runs.
+	// +optional
+	// +kubebuilder:default={"interval":"manual"}
+	Schedule TaskSchedule `json:"schedule,omitempty"`
+
+	// AlertEmail is the email address that receives notifications about the task runs.
+	// +optional
+	// +kubebuilder:example="admin@example.com"
+	AlertEmail string `json:"alertEmail,omitempty"`
+
+	// NotificationCondition defines when the alert email is sent.
+	// +optional
+	// +kubebuilder:default=FAILURE
+	NotificationCondition TaskNotificationCondition `json:"notificationCondition,omitempty"`
+
+	// NexusRef is a reference to Nexus custom resource.
+	// +required
+	NexusRef common.NexusRef `json:"nexusRef"`
+
+	// DeletionPolicy defines what happens to the object in nexus when the resource is deleted.
+	// Delete removes the object from nexus, Orphan leaves it in nexus.
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ManagementPolicy defines how the operator manages the task if a task with the same name and type
+	// already exists in nexus.
+	// Adopt takes over the task, FailIfExists fails if the task was not created by the resource,
+	// ObserveOnly only checks that the task exists and never changes or deletes it.
+	// The task can't be managed by two resources at the same time.
+	// +optional
+	// +kubebuilder:default=Adopt
+	ManagementPolicy common.ManagementPolicy `json:"managementPolicy,omitempty"`
+}
+
+// TaskSchedule defines when the task runs.
+// Either interval or cron must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.interval) != has(self.cron)",message="exactly one of interval or cron must be set"
+// +kubebuilder:validation:XValidation:rule="(has(self.interval) && (self.interval == 'weekly' || self.interval == 'monthly')) == has(self.recurringDays)",message="recurringDays must be set for weekly and monthly interval only"
+type TaskSchedule struct {
+	// Interval is a predefined schedule of the task.
+	// +optional
+	// +kubebuilder:example="daily"
+	Interval TaskInterval `json:"interval,omitempty"`
+
+	// Cron is a cron expression of the task schedule in the Quartz format.
+	// +optional
+	// +kubebuilder:example="0 0 1 * * ?"
+	Cron string `json:"cron,omitempty"`
+
+	// StartDate is the time of the first run for once, hourly, daily, weekly and monthly intervals.
+	// The creation time of the resource is used if it is not set.
+	// +optional
+	StartDate *metav1.Time `json:"startDate,omitempty"`
+
+	// RecurringDays are days of the week (1-7, Sunday is 1) for weekly interval
+	// or days of the month (1-31, 999 is the last day) for monthly interval.
+	// It is required for weekly and monthly interval.
+	// +optional
+	// +kubebuilder:example={1,4}
+	RecurringDays []int `json:"recurringDays,omitempty"`
+}
+
+// NexusTaskStatus defines the observed state of NexusTask.
+type NexusTaskStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
+	// TaskID is the id of the task in nexus.
+	// +optional
+	TaskID string `json:"taskId,omitempty"`
+
+	// CurrentState is the current state of the task in nexus, e.g. WAITING or RUNNING.
+	// +optional
+	CurrentState string `json:"currentState,omitempty"`
+
+	// LastRun is the time of the last run of the task.
+	// +optional
+	LastRun *metav1.Time `json:"lastRun,omitempty"`
+
+	// LastRunResult is the result of the last run of the task, e.g. OK or FAILED.
+	// +optional
+	LastRunResult string `json:"lastRunResult,omitempty"`
+
+	// NextRun is the time of the next scheduled run of the task.
+	// +optional
+	NextRun *metav1.Time `json:"nextRun,omitempty"`
+
+	// Value is a status of the task.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Error is an error message if something went wrong.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Type of the task"
+// +kubebuilder:printcolumn:name="Last Result",type="string",JSONPath=".status.lastRunResult",description="Result of the last run of the task"
+// +kubebuilder:printcolumn:name="Next Run",type="string",JSONPath=".status.nextRun",description="Time of the next run of the task"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the task synced with nexus"
+
+// NexusTask is the Schema for the nexustasks API.
+type NexusTask struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NexusTaskSpec   `json:"spec,omitempty"`
+	Status NexusTaskStatus `json:"status,omitempty"`
+}
+
+func (in *NexusTask) GetNexusRef() common.NexusRef {
+	return in.Spec.NexusRef
+}
+
+// +kubebuilder:object:root=true
+
+// NexusTaskList contains a list of NexusTask.
+type NexusTaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NexusTask `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NexusTask{}, &NexusTaskList{})
+}
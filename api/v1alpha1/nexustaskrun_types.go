@@ -0,0 +1,131 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+const (
+	// TaskRunStatusRunning is the status of NexusTaskRun while the task is running in nexus.
+	TaskRunStatusRunning = "running"
+	// TaskRunStatusSucceeded is the status of NexusTaskRun when the task finished successfully.
+	TaskRunStatusSucceeded = "succeeded"
+	// TaskRunStatusFailed is the status of NexusTaskRun when the task finished with an error.
+	TaskRunStatusFailed = "failed"
+)
+
+// NexusTaskRunSpec defines the desired state of NexusTaskRun.
+// The task is run once, so the spec can't be changed. Create a new NexusTaskRun to run the task again.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable, create a new NexusTaskRun to run the task again"
+// +kubebuilder:validation:XValidation:rule="has(self.taskId) != has(self.taskRef)",message="exactly one of taskId or taskRef must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.taskId) == has(self.nexusRef)",message="nexusRef must be set for taskId only, taskRef uses nexusRef of the NexusTask"
+type NexusTaskRunSpec struct {
+	// TaskID is the id of the task in nexus.
+	// +optional
+	// +kubebuilder:example="1b2f0b3c-6a1d-4d8e-9b1a-2c3d4e5f6a7b"
+	TaskID string `json:"taskId,omitempty"`
+
+	// TaskRef is a reference to NexusTask in the same namespace.
+	// +optional
+	TaskRef *TaskRef `json:"taskRef,omitempty"`
+
+	// NexusRef is a reference to Nexus custom resource.
+	// It is required for taskId.
+	// +optional
+	NexusRef *common.NexusRef `json:"nexusRef,omitempty"`
+
+	// Timeout is the maximum duration of the run counted from the creation of the resource,
+	// including the time of waiting for the task to be created in nexus.
+	// When it is over, the run is failed with the Timeout reason. The task itself is not stopped in nexus.
+	// +optional
+	// +kubebuilder:default="1h"
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="timeout must be positive"
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:example="30m"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// TaskRef is a reference to NexusTask.
+type TaskRef struct {
+	// Name is the name of NexusTask.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:example="compact-default-blobstore"
+	Name string `json:"name"`
+}
+
+// NexusTaskRunStatus defines the observed state of NexusTaskRun.
+type NexusTaskRunStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
+	// TaskID is the id of the task in nexus that was run.
+	// +optional
+	TaskID string `json:"taskId,omitempty"`
+
+	// StartTime is the time when the task was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time when the task was found finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Duration is the duration of the run, e.g. 1m30s.
+	// +optional
+	Duration string `json:"duration,omitempty"`
+
+	// Result is the result of the run reported by nexus, e.g. OK or FAILED.
+	// +optional
+	Result string `json:"result,omitempty"`
+
+	// PreviousRun is the time of the last run of the task before it was started by the resource.
+	// It is used to detect the end of the run.
+	// +optional
+	PreviousRun *metav1.Time `json:"previousRun,omitempty"`
+
+	// Value is a status of the run: running, succeeded, failed or error.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Error is an error message if something went wrong.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Status of the run"
+// +kubebuilder:printcolumn:name="Result",type="string",JSONPath=".status.result",description="Result of the run reported by nexus"
+// +kubebuilder:printcolumn:name="Duration",type="string",JSONPath=".status.duration",description="Duration of the run"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NexusTaskRun is the Schema for the nexustaskruns API.
+type NexusTaskRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NexusTaskRunSpec   `json:"spec,omitempty"`
+	Status NexusTaskRunStatus `json:"status,omitempty"`
+}
+
+func (in *NexusTaskRun) GetNexusRef() common.NexusRef {
+	if in.Spec.NexusRef == nil {
+		return common.NexusRef{}
+	}
+
+	return *in.Spec.NexusRef
+}
+
+// +kubebuilder:object:root=true
+
+// NexusTaskRunList contains a list of NexusTaskRun.
+type NexusTaskRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NexusTaskRun `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NexusTaskRun{}, &NexusTaskRunList{})
+}
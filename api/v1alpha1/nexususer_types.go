@@ -12,6 +12,8 @@ const (
 )
 
 // NexusUserSpec defines the desired state of NexusUser.
+// +kubebuilder:validation:XValidation:rule="self.source != 'default' || (has(self.firstName) && has(self.lastName) && has(self.email) && has(self.secret))",message="firstName, lastName, email and secret are required for users of the default source"
+// +kubebuilder:validation:XValidation:rule="!has(self.passwordGeneration) || !has(self.secret) || !(self.secret.endsWith(':username') || self.secret.endsWith(':npmAuthToken') || self.secret.endsWith(':.dockerconfigjson'))",message="secret key can't be username, npmAuthToken or .dockerconfigjson with passwordGeneration, these keys are derived from the generated password"
 type NexusUserSpec struct {
 	// ID is the username of the user.
 	// ID should be unique across all users.
@@ -21,28 +23,44 @@ type NexusUserSpec struct {
 	// +kubebuilder:example="new-user"
 	ID string `json:"id"`
 
-	// FirstName of the user.
-	// +required
+	// Source is the user source of the user.
+	// Users of the default source are created in nexus.
+	// Users of other sources, e.g. LDAP, must exist in the source, the operator manages only their roles.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +optional
+	// +kubebuilder:validation:Enum=default;LDAP;SAML;Crowd
+	// +kubebuilder:default=default
+	Source string `json:"source,omitempty"`
+
+	// FirstName of the user. It is required for users of the default source.
+	// +optional
 	// +kubebuilder:example="John"
-	FirstName string `json:"firstName"`
+	FirstName string `json:"firstName,omitempty"`
 
-	// LastName of the user.
-	// +required
+	// LastName of the user. It is required for users of the default source.
+	// +optional
 	// +kubebuilder:example="Doe"
-	LastName string `json:"lastName"`
+	LastName string `json:"lastName,omitempty"`
 
-	// Email is the email address of the user.
-	// +required
+	// Email is the email address of the user. It is required for users of the default source.
+	// +optional
 	// +kubebuilder:validation:MaxLength=254
 	// +kubebuilder:example="john.doe@example"
-	Email string `json:"email"`
+	Email string `json:"email,omitempty"`
 
 	// Secret is the reference of the k8s object Secret for the user password.
 	// Format: $secret-name:secret-key.
 	// After updating Secret user password will be updated.
-	// +required
+	// It is required for users of the default source and ignored for other sources.
+	// +optional
 	// +kubebuilder:example="$nexus-user-secret:secret-key"
-	Secret string `json:"secret"`
+	Secret string `json:"secret,omitempty"`
+
+	// PasswordGeneration enables generating the password into the Secret referenced by the secret field
+	// if the Secret doesn't exist. The generated Secret is owned by the NexusUser.
+	// A Secret that already exists and was not generated by the operator is used as is.
+	// +optional
+	PasswordGeneration *UserPasswordGeneration `json:"passwordGeneration,omitempty"`
 
 	// Status is a status of the user.
 	// +optional
@@ -60,14 +78,57 @@ type NexusUserSpec struct {
 	// NexusRef is a reference to Nexus custom resource.
 	// +required
 	NexusRef common.NexusRef `json:"nexusRef"`
+
+	// DeletionPolicy defines what happens to the object in nexus when the resource is deleted.
+	// Delete removes the object from nexus, Orphan leaves it in nexus.
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// UserPasswordGeneration defines how the user password is generated.
+type UserPasswordGeneration struct {
+	// Length is the length of the generated password.
+	// +optional
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=128
+	// +kubebuilder:default=32
+	Length int32 `json:"length,omitempty"`
+
+	// RotationPeriod is the period after which the generated password is replaced with a new one.
+	// If it is not set, the password is never rotated.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1h')",message="rotationPeriod must be at least 1h"
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:example="720h"
+	RotationPeriod *metav1.Duration `json:"rotationPeriod,omitempty"`
+
+	// DockerRegistries is a list of nexus docker registry hosts added to the .dockerconfigjson key of the Secret.
+	// If it is set when the Secret is created, the Secret has the kubernetes.io/dockerconfigjson type,
+	// so it can be used in imagePullSecrets, otherwise the Secret is Opaque.
+	// The type of the Secret can't be changed, delete the Secret to recreate it with the other type.
+	// +optional
+	// +kubebuilder:example={"docker.example.com"}
+	DockerRegistries []string `json:"dockerRegistries,omitempty"`
 }
 
 // NexusUserStatus defines the observed state of NexusUser.
 type NexusUserStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is a status of the user.
 	// +optional
 	Value string `json:"value,omitempty"`
 
+	// PasswordHash is the argon2id hash of the password applied to nexus.
+	// The password is changed in nexus only when the value in the Secret doesn't match it.
+	// +optional
+	PasswordHash string `json:"passwordHash,omitempty"`
+
+	// PasswordRotatedAt is the time when the password was last applied to nexus.
+	// +optional
+	PasswordRotatedAt *metav1.Time `json:"passwordRotatedAt,omitempty"`
+
 	// Error is an error message if something went wrong.
 	// +optional
 	Error string `json:"error,omitempty"`
@@ -75,6 +136,7 @@ type NexusUserStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the user synced with nexus"
 
 // NexusUser is the Schema for the nexususers API.
 type NexusUser struct {
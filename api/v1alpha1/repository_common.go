@@ -1,5 +1,7 @@
 package v1alpha1
 
+import "github.com/epam/edp-nexus-operator/api/common"
+
 type ProxySpec struct {
 	// A unique identifier for this repository.
 	// Only letters, digits, underscores(_), hyphens(-), and dots(.) are allowed and may not start with underscore or dot.
@@ -146,6 +148,8 @@ type HTTPClientConnection struct {
 }
 
 // HTTPClientAuthentication contains HTTP client authentication configuration data.
+// +kubebuilder:validation:XValidation:rule="has(self.username) || has(self.usernameRef)",message="username or usernameRef is required"
+// +kubebuilder:validation:XValidation:rule="has(self.password) || has(self.passwordRef)",message="password or passwordRef is required"
 type HTTPClientAuthentication struct {
 	NTLMDomain string `json:"ntlmDomain,omitempty"`
 	NTLMHost   string `json:"ntlmHost,omitempty"`
@@ -157,30 +161,68 @@ type HTTPClientAuthentication struct {
 	Type string `json:"type"`
 
 	// Password for authentication.
-	// +required
+	// Use PasswordRef to keep the password in a Secret.
+	// +optional
 	Password string `json:"password,omitempty"`
 
+	// PasswordRef is a reference to the password for authentication.
+	// It takes precedence over Password.
+	// +optional
+	PasswordRef *common.SourceRef `json:"passwordRef,omitempty"`
+
 	// Username for authentication.
-	// +required
+	// +optional
 	Username string `json:"username,omitempty"`
+
+	// UsernameRef is a reference to the username for authentication.
+	// It takes precedence over Username.
+	// +optional
+	UsernameRef *common.SourceRef `json:"usernameRef,omitempty"`
 }
 
 // HTTPClientAuthenticationWithPreemptive contains HTTP client authentication configuration data.
+// +kubebuilder:validation:XValidation:rule="has(self.username) || has(self.usernameRef)",message="username or usernameRef is required"
+// +kubebuilder:validation:XValidation:rule="has(self.password) || has(self.passwordRef)",message="password or passwordRef is required"
 type HTTPClientAuthenticationWithPreemptive struct {
 	NTLMDomain string `json:"ntlmDomain,omitempty"`
 	NTLMHost   string `json:"ntlmHost,omitempty"`
 	Password   string `json:"password,omitempty"`
 
+	// PasswordRef is a reference to the password for authentication.
+	// It takes precedence over Password.
+	// +optional
+	PasswordRef *common.SourceRef `json:"passwordRef,omitempty"`
+
 	// Type of authentication to use.
 	// +optional
 	// +kubebuilder:default=username
 	// +kubebuilder:validation:Enum=username;ntlm
 	Type     string `json:"type"`
 	Username string `json:"username,omitempty"`
+
+	// UsernameRef is a reference to the username for authentication.
+	// It takes precedence over Username.
+	// +optional
+	UsernameRef *common.SourceRef `json:"usernameRef,omitempty"`
+
 	// Whether to use pre-emptive authentication. Use with caution. Defaults to false.
 	Preemptive *bool `json:"preemptive,omitempty"`
 }
 
+// GetHTTPClientAuthentication returns authentication of the remote repository.
+func (in *ProxySpec) GetHTTPClientAuthentication() *HTTPClientAuthentication {
+	return in.HTTPClient.Authentication
+}
+
+// GetRoutingRule returns the name of the routing rule assigned to the repository.
+func (in *ProxySpec) GetRoutingRule() string {
+	if in.RoutingRule == nil {
+		return ""
+	}
+
+	return *in.RoutingRule
+}
+
 type Cleanup struct {
 	//  Components that match any of the applied policies will be deleted.
 	// +required
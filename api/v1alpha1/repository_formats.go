@@ -1,5 +1,7 @@
 package v1alpha1
 
+import "github.com/epam/edp-nexus-operator/api/common"
+
 type AptHostedRepository struct {
 	HostedSpec `json:",inline"`
 
@@ -34,11 +36,22 @@ type AptHosted struct {
 }
 
 // AptSigning contains signing data of hosted repositores of format Apt.
+// +kubebuilder:validation:XValidation:rule="has(self.keypair) || has(self.keypairRef)",message="keypair or keypairRef is required"
 type AptSigning struct {
 	// PGP signing key pair (armored private key e.g. gpg --export-secret-key --armor)
-	Keypair string `json:"keypair"`
+	// Use KeypairRef to keep the private key in a Secret.
+	// +optional
+	Keypair string `json:"keypair,omitempty"`
+	// KeypairRef is a reference to the PGP signing key pair in a Secret.
+	// It takes precedence over Keypair.
+	// +optional
+	KeypairRef *common.SecretKeySelector `json:"keypairRef,omitempty"`
 	// Passphrase to access PGP signing key
 	Passphrase *string `json:"passphrase,omitempty"`
+	// PassphraseRef is a reference to the passphrase of PGP signing key in a Secret.
+	// It takes precedence over Passphrase.
+	// +optional
+	PassphraseRef *common.SecretKeySelector `json:"passphraseRef,omitempty"`
 }
 
 type BowerGroupRepository struct {
@@ -223,6 +236,15 @@ type MavenProxyRepository struct {
 	Maven `json:"maven"`
 }
 
+// GetRoutingRule returns the name of the routing rule assigned to the repository.
+func (in *MavenProxyRepository) GetRoutingRule() string {
+	if in.RoutingRule == nil {
+		return ""
+	}
+
+	return *in.RoutingRule
+}
+
 // Maven contains additional data of maven repository.
 type Maven struct {
 	// VersionPolicy is a type of artifact that this repository stores.
@@ -388,7 +410,16 @@ type Yum struct {
 
 type YumSigning struct {
 	// PGP signing key pair (armored private key e.g. gpg --export-secret-key --armor)
+	// Use KeypairRef to keep the private key in a Secret.
 	Keypair *string `json:"keypair,omitempty"`
+	// KeypairRef is a reference to the PGP signing key pair in a Secret.
+	// It takes precedence over Keypair.
+	// +optional
+	KeypairRef *common.SecretKeySelector `json:"keypairRef,omitempty"`
 	// Passphrase to access PGP signing key
 	Passphrase *string `json:"passphrase,omitempty"`
+	// PassphraseRef is a reference to the passphrase of PGP signing key in a Secret.
+	// It takes precedence over Passphrase.
+	// +optional
+	PassphraseRef *common.SecretKeySelector `json:"passphraseRef,omitempty"`
 }
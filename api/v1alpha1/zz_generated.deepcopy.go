@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	"github.com/epam/edp-nexus-operator/api/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -77,11 +78,21 @@ func (in *AptProxyRepository) DeepCopy() *AptProxyRepository {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AptSigning) DeepCopyInto(out *AptSigning) {
 	*out = *in
+	if in.KeypairRef != nil {
+		in, out := &in.KeypairRef, &out.KeypairRef
+		*out = new(common.SecretKeySelector)
+		**out = **in
+	}
 	if in.Passphrase != nil {
 		in, out := &in.Passphrase, &out.Passphrase
 		*out = new(string)
 		**out = **in
 	}
+	if in.PassphraseRef != nil {
+		in, out := &in.PassphraseRef, &out.PassphraseRef
+		*out = new(common.SecretKeySelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AptSigning.
@@ -233,6 +244,90 @@ func (in *Cleanup) DeepCopy() *Cleanup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNexus) DeepCopyInto(out *ClusterNexus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNexus.
+func (in *ClusterNexus) DeepCopy() *ClusterNexus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNexus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterNexus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNexusList) DeepCopyInto(out *ClusterNexusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterNexus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNexusList.
+func (in *ClusterNexusList) DeepCopy() *ClusterNexusList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNexusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterNexusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNexusSpec) DeepCopyInto(out *ClusterNexusSpec) {
+	*out = *in
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(NexusTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNexusSpec.
+func (in *ClusterNexusSpec) DeepCopy() *ClusterNexusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNexusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CocoapodsProxyRepository) DeepCopyInto(out *CocoapodsProxyRepository) {
 	*out = *in
@@ -675,7 +770,7 @@ func (in *HTTPClient) DeepCopyInto(out *HTTPClient) {
 	if in.Authentication != nil {
 		in, out := &in.Authentication, &out.Authentication
 		*out = new(HTTPClientAuthentication)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Connection != nil {
 		in, out := &in.Connection, &out.Connection
@@ -697,6 +792,16 @@ func (in *HTTPClient) DeepCopy() *HTTPClient {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPClientAuthentication) DeepCopyInto(out *HTTPClientAuthentication) {
 	*out = *in
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(common.SourceRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UsernameRef != nil {
+		in, out := &in.UsernameRef, &out.UsernameRef
+		*out = new(common.SourceRef)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPClientAuthentication.
@@ -712,6 +817,16 @@ func (in *HTTPClientAuthentication) DeepCopy() *HTTPClientAuthentication {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPClientAuthenticationWithPreemptive) DeepCopyInto(out *HTTPClientAuthenticationWithPreemptive) {
 	*out = *in
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(common.SourceRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UsernameRef != nil {
+		in, out := &in.UsernameRef, &out.UsernameRef
+		*out = new(common.SourceRef)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Preemptive != nil {
 		in, out := &in.Preemptive, &out.Preemptive
 		*out = new(bool)
@@ -892,6 +1007,56 @@ func (in *HostedStorage) DeepCopy() *HostedStorage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LdapConnection) DeepCopyInto(out *LdapConnection) {
+	*out = *in
+	if in.AuthPasswordRef != nil {
+		in, out := &in.AuthPasswordRef, &out.AuthPasswordRef
+		*out = new(common.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LdapConnection.
+func (in *LdapConnection) DeepCopy() *LdapConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(LdapConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LdapGroupMapping) DeepCopyInto(out *LdapGroupMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LdapGroupMapping.
+func (in *LdapGroupMapping) DeepCopy() *LdapGroupMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(LdapGroupMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LdapUserMapping) DeepCopyInto(out *LdapUserMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LdapUserMapping.
+func (in *LdapUserMapping) DeepCopy() *LdapUserMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(LdapUserMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Maven) DeepCopyInto(out *Maven) {
 	*out = *in
@@ -1021,8 +1186,8 @@ func (in *Nexus) DeepCopyInto(out *Nexus) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Nexus.
@@ -1043,13 +1208,104 @@ func (in *Nexus) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusAnonymousAccess) DeepCopyInto(out *NexusAnonymousAccess) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusAnonymousAccess.
+func (in *NexusAnonymousAccess) DeepCopy() *NexusAnonymousAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusAnonymousAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusAnonymousAccess) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusAnonymousAccessList) DeepCopyInto(out *NexusAnonymousAccessList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NexusAnonymousAccess, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusAnonymousAccessList.
+func (in *NexusAnonymousAccessList) DeepCopy() *NexusAnonymousAccessList {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusAnonymousAccessList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusAnonymousAccessList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusAnonymousAccessSpec) DeepCopyInto(out *NexusAnonymousAccessSpec) {
+	*out = *in
+	out.NexusRef = in.NexusRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusAnonymousAccessSpec.
+func (in *NexusAnonymousAccessSpec) DeepCopy() *NexusAnonymousAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusAnonymousAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusAnonymousAccessStatus) DeepCopyInto(out *NexusAnonymousAccessStatus) {
+	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusAnonymousAccessStatus.
+func (in *NexusAnonymousAccessStatus) DeepCopy() *NexusAnonymousAccessStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusAnonymousAccessStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NexusBlobStore) DeepCopyInto(out *NexusBlobStore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusBlobStore.
@@ -1136,6 +1392,7 @@ func (in *NexusBlobStoreSpec) DeepCopy() *NexusBlobStoreSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NexusBlobStoreStatus) DeepCopyInto(out *NexusBlobStoreStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusBlobStoreStatus.
@@ -1154,7 +1411,7 @@ func (in *NexusCleanupPolicy) DeepCopyInto(out *NexusCleanupPolicy) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusCleanupPolicy.
@@ -1227,6 +1484,7 @@ func (in *NexusCleanupPolicySpec) DeepCopy() *NexusCleanupPolicySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NexusCleanupPolicyStatus) DeepCopyInto(out *NexusCleanupPolicyStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusCleanupPolicyStatus.
@@ -1240,31 +1498,58 @@ func (in *NexusCleanupPolicyStatus) DeepCopy() *NexusCleanupPolicyStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NexusList) DeepCopyInto(out *NexusList) {
+func (in *NexusContentSelector) DeepCopyInto(out *NexusContentSelector) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusContentSelector.
+func (in *NexusContentSelector) DeepCopy() *NexusContentSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusContentSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusContentSelector) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusContentSelectorList) DeepCopyInto(out *NexusContentSelectorList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Nexus, len(*in))
+		*out = make([]NexusContentSelector, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusList.
-func (in *NexusList) DeepCopy() *NexusList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusContentSelectorList.
+func (in *NexusContentSelectorList) DeepCopy() *NexusContentSelectorList {
 	if in == nil {
 		return nil
 	}
-	out := new(NexusList)
+	out := new(NexusContentSelectorList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NexusList) DeepCopyObject() runtime.Object {
+func (in *NexusContentSelectorList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1272,26 +1557,58 @@ func (in *NexusList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NexusRepository) DeepCopyInto(out *NexusRepository) {
+func (in *NexusContentSelectorSpec) DeepCopyInto(out *NexusContentSelectorSpec) {
+	*out = *in
+	out.NexusRef = in.NexusRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusContentSelectorSpec.
+func (in *NexusContentSelectorSpec) DeepCopy() *NexusContentSelectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusContentSelectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusContentSelectorStatus) DeepCopyInto(out *NexusContentSelectorStatus) {
+	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusContentSelectorStatus.
+func (in *NexusContentSelectorStatus) DeepCopy() *NexusContentSelectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusContentSelectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusLdapServer) DeepCopyInto(out *NexusLdapServer) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRepository.
-func (in *NexusRepository) DeepCopy() *NexusRepository {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusLdapServer.
+func (in *NexusLdapServer) DeepCopy() *NexusLdapServer {
 	if in == nil {
 		return nil
 	}
-	out := new(NexusRepository)
+	out := new(NexusLdapServer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NexusRepository) DeepCopyObject() runtime.Object {
+func (in *NexusLdapServer) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1299,31 +1616,31 @@ func (in *NexusRepository) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NexusRepositoryList) DeepCopyInto(out *NexusRepositoryList) {
+func (in *NexusLdapServerList) DeepCopyInto(out *NexusLdapServerList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NexusRepository, len(*in))
+		*out = make([]NexusLdapServer, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRepositoryList.
-func (in *NexusRepositoryList) DeepCopy() *NexusRepositoryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusLdapServerList.
+func (in *NexusLdapServerList) DeepCopy() *NexusLdapServerList {
 	if in == nil {
 		return nil
 	}
-	out := new(NexusRepositoryList)
+	out := new(NexusLdapServerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NexusRepositoryList) DeepCopyObject() runtime.Object {
+func (in *NexusLdapServerList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1331,14 +1648,341 @@ func (in *NexusRepositoryList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NexusRepositorySpec) DeepCopyInto(out *NexusRepositorySpec) {
+func (in *NexusLdapServerSpec) DeepCopyInto(out *NexusLdapServerSpec) {
 	*out = *in
-	if in.Apt != nil {
-		in, out := &in.Apt, &out.Apt
-		*out = new(AptSpec)
-		(*in).DeepCopyInto(*out)
+	in.Connection.DeepCopyInto(&out.Connection)
+	out.UserMapping = in.UserMapping
+	if in.GroupMapping != nil {
+		in, out := &in.GroupMapping, &out.GroupMapping
+		*out = new(LdapGroupMapping)
+		**out = **in
 	}
-	if in.Bower != nil {
+	out.NexusRef = in.NexusRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusLdapServerSpec.
+func (in *NexusLdapServerSpec) DeepCopy() *NexusLdapServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusLdapServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusLdapServerStatus) DeepCopyInto(out *NexusLdapServerStatus) {
+	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusLdapServerStatus.
+func (in *NexusLdapServerStatus) DeepCopy() *NexusLdapServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusLdapServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusList) DeepCopyInto(out *NexusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Nexus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusList.
+func (in *NexusList) DeepCopy() *NexusList {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusPrivilege) DeepCopyInto(out *NexusPrivilege) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusPrivilege.
+func (in *NexusPrivilege) DeepCopy() *NexusPrivilege {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusPrivilege)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusPrivilege) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusPrivilegeList) DeepCopyInto(out *NexusPrivilegeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NexusPrivilege, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusPrivilegeList.
+func (in *NexusPrivilegeList) DeepCopy() *NexusPrivilegeList {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusPrivilegeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusPrivilegeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusPrivilegeSpec) DeepCopyInto(out *NexusPrivilegeSpec) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.NexusRef = in.NexusRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusPrivilegeSpec.
+func (in *NexusPrivilegeSpec) DeepCopy() *NexusPrivilegeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusPrivilegeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusPrivilegeStatus) DeepCopyInto(out *NexusPrivilegeStatus) {
+	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusPrivilegeStatus.
+func (in *NexusPrivilegeStatus) DeepCopy() *NexusPrivilegeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusPrivilegeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusRealms) DeepCopyInto(out *NexusRealms) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRealms.
+func (in *NexusRealms) DeepCopy() *NexusRealms {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusRealms)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusRealms) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusRealmsList) DeepCopyInto(out *NexusRealmsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NexusRealms, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRealmsList.
+func (in *NexusRealmsList) DeepCopy() *NexusRealmsList {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusRealmsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusRealmsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusRealmsSpec) DeepCopyInto(out *NexusRealmsSpec) {
+	*out = *in
+	if in.ActiveRealms != nil {
+		in, out := &in.ActiveRealms, &out.ActiveRealms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.NexusRef = in.NexusRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRealmsSpec.
+func (in *NexusRealmsSpec) DeepCopy() *NexusRealmsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusRealmsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusRealmsStatus) DeepCopyInto(out *NexusRealmsStatus) {
+	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+	if in.ActiveRealms != nil {
+		in, out := &in.ActiveRealms, &out.ActiveRealms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRealmsStatus.
+func (in *NexusRealmsStatus) DeepCopy() *NexusRealmsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusRealmsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusRepository) DeepCopyInto(out *NexusRepository) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRepository.
+func (in *NexusRepository) DeepCopy() *NexusRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusRepository) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusRepositoryList) DeepCopyInto(out *NexusRepositoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NexusRepository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRepositoryList.
+func (in *NexusRepositoryList) DeepCopy() *NexusRepositoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusRepositoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusRepositoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusRepositorySpec) DeepCopyInto(out *NexusRepositorySpec) {
+	*out = *in
+	if in.Apt != nil {
+		in, out := &in.Apt, &out.Apt
+		*out = new(AptSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bower != nil {
 		in, out := &in.Bower, &out.Bower
 		*out = new(BowerSpec)
 		(*in).DeepCopyInto(*out)
@@ -1439,6 +2083,12 @@ func (in *NexusRepositorySpec) DeepCopy() *NexusRepositorySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NexusRepositoryStatus) DeepCopyInto(out *NexusRepositoryStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(RepositoryDrift)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRepositoryStatus.
@@ -1457,21 +2107,122 @@ func (in *NexusRole) DeepCopyInto(out *NexusRole) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRole.
+func (in *NexusRole) DeepCopy() *NexusRole {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusRoleList) DeepCopyInto(out *NexusRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NexusRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRoleList.
+func (in *NexusRoleList) DeepCopy() *NexusRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusRoleSpec) DeepCopyInto(out *NexusRoleSpec) {
+	*out = *in
+	if in.Privileges != nil {
+		in, out := &in.Privileges, &out.Privileges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.NexusRef = in.NexusRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRoleSpec.
+func (in *NexusRoleSpec) DeepCopy() *NexusRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusRoleStatus) DeepCopyInto(out *NexusRoleStatus) {
+	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRoleStatus.
+func (in *NexusRoleStatus) DeepCopy() *NexusRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusRoutingRule) DeepCopyInto(out *NexusRoutingRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRole.
-func (in *NexusRole) DeepCopy() *NexusRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRoutingRule.
+func (in *NexusRoutingRule) DeepCopy() *NexusRoutingRule {
 	if in == nil {
 		return nil
 	}
-	out := new(NexusRole)
+	out := new(NexusRoutingRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NexusRole) DeepCopyObject() runtime.Object {
+func (in *NexusRoutingRule) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1479,31 +2230,31 @@ func (in *NexusRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NexusRoleList) DeepCopyInto(out *NexusRoleList) {
+func (in *NexusRoutingRuleList) DeepCopyInto(out *NexusRoutingRuleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NexusRole, len(*in))
+		*out = make([]NexusRoutingRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRoleList.
-func (in *NexusRoleList) DeepCopy() *NexusRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRoutingRuleList.
+func (in *NexusRoutingRuleList) DeepCopy() *NexusRoutingRuleList {
 	if in == nil {
 		return nil
 	}
-	out := new(NexusRoleList)
+	out := new(NexusRoutingRuleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NexusRoleList) DeepCopyObject() runtime.Object {
+func (in *NexusRoutingRuleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1511,37 +2262,38 @@ func (in *NexusRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NexusRoleSpec) DeepCopyInto(out *NexusRoleSpec) {
+func (in *NexusRoutingRuleSpec) DeepCopyInto(out *NexusRoutingRuleSpec) {
 	*out = *in
-	if in.Privileges != nil {
-		in, out := &in.Privileges, &out.Privileges
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	out.NexusRef = in.NexusRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRoleSpec.
-func (in *NexusRoleSpec) DeepCopy() *NexusRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRoutingRuleSpec.
+func (in *NexusRoutingRuleSpec) DeepCopy() *NexusRoutingRuleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NexusRoleSpec)
+	out := new(NexusRoutingRuleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NexusRoleStatus) DeepCopyInto(out *NexusRoleStatus) {
+func (in *NexusRoutingRuleStatus) DeepCopyInto(out *NexusRoutingRuleStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRoleStatus.
-func (in *NexusRoleStatus) DeepCopy() *NexusRoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusRoutingRuleStatus.
+func (in *NexusRoutingRuleStatus) DeepCopy() *NexusRoutingRuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NexusRoleStatus)
+	out := new(NexusRoutingRuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1552,7 +2304,7 @@ func (in *NexusScript) DeepCopyInto(out *NexusScript) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusScript.
@@ -1624,6 +2376,7 @@ func (in *NexusScriptSpec) DeepCopy() *NexusScriptSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NexusScriptStatus) DeepCopyInto(out *NexusScriptStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusScriptStatus.
@@ -1639,6 +2392,11 @@ func (in *NexusScriptStatus) DeepCopy() *NexusScriptStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NexusSpec) DeepCopyInto(out *NexusSpec) {
 	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(NexusTLS)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusSpec.
@@ -1654,6 +2412,13 @@ func (in *NexusSpec) DeepCopy() *NexusSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NexusStatus) DeepCopyInto(out *NexusStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusStatus.
@@ -1666,13 +2431,257 @@ func (in *NexusStatus) DeepCopy() *NexusStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusTLS) DeepCopyInto(out *NexusTLS) {
+	*out = *in
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(common.SourceRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusTLS.
+func (in *NexusTLS) DeepCopy() *NexusTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusTask) DeepCopyInto(out *NexusTask) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusTask.
+func (in *NexusTask) DeepCopy() *NexusTask {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusTask) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusTaskList) DeepCopyInto(out *NexusTaskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NexusTask, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusTaskList.
+func (in *NexusTaskList) DeepCopy() *NexusTaskList {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusTaskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusTaskList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusTaskRun) DeepCopyInto(out *NexusTaskRun) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusTaskRun.
+func (in *NexusTaskRun) DeepCopy() *NexusTaskRun {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusTaskRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusTaskRun) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusTaskRunList) DeepCopyInto(out *NexusTaskRunList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NexusTaskRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusTaskRunList.
+func (in *NexusTaskRunList) DeepCopy() *NexusTaskRunList {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusTaskRunList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NexusTaskRunList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusTaskRunSpec) DeepCopyInto(out *NexusTaskRunSpec) {
+	*out = *in
+	if in.TaskRef != nil {
+		in, out := &in.TaskRef, &out.TaskRef
+		*out = new(TaskRef)
+		**out = **in
+	}
+	if in.NexusRef != nil {
+		in, out := &in.NexusRef, &out.NexusRef
+		*out = new(common.NexusRef)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusTaskRunSpec.
+func (in *NexusTaskRunSpec) DeepCopy() *NexusTaskRunSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusTaskRunSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusTaskRunStatus) DeepCopyInto(out *NexusTaskRunStatus) {
+	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviousRun != nil {
+		in, out := &in.PreviousRun, &out.PreviousRun
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusTaskRunStatus.
+func (in *NexusTaskRunStatus) DeepCopy() *NexusTaskRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusTaskRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusTaskSpec) DeepCopyInto(out *NexusTaskSpec) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Schedule.DeepCopyInto(&out.Schedule)
+	out.NexusRef = in.NexusRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusTaskSpec.
+func (in *NexusTaskSpec) DeepCopy() *NexusTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NexusTaskStatus) DeepCopyInto(out *NexusTaskStatus) {
+	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+	if in.LastRun != nil {
+		in, out := &in.LastRun, &out.LastRun
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRun != nil {
+		in, out := &in.NextRun, &out.NextRun
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusTaskStatus.
+func (in *NexusTaskStatus) DeepCopy() *NexusTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NexusTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NexusUser) DeepCopyInto(out *NexusUser) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusUser.
@@ -1728,6 +2737,11 @@ func (in *NexusUserList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NexusUserSpec) DeepCopyInto(out *NexusUserSpec) {
 	*out = *in
+	if in.PasswordGeneration != nil {
+		in, out := &in.PasswordGeneration, &out.PasswordGeneration
+		*out = new(UserPasswordGeneration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Roles != nil {
 		in, out := &in.Roles, &out.Roles
 		*out = make([]string, len(*in))
@@ -1749,6 +2763,11 @@ func (in *NexusUserSpec) DeepCopy() *NexusUserSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NexusUserStatus) DeepCopyInto(out *NexusUserStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+	if in.PasswordRotatedAt != nil {
+		in, out := &in.PasswordRotatedAt, &out.PasswordRotatedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NexusUserStatus.
@@ -2302,6 +3321,27 @@ func (in *RawSpec) DeepCopy() *RawSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryDrift) DeepCopyInto(out *RepositoryDrift) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.DetectedTime.DeepCopyInto(&out.DetectedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryDrift.
+func (in *RepositoryDrift) DeepCopy() *RepositoryDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RubyGemsGroupRepository) DeepCopyInto(out *RubyGemsGroupRepository) {
 	*out = *in
@@ -2508,6 +3548,70 @@ func (in *Storage) DeepCopy() *Storage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRef) DeepCopyInto(out *TaskRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRef.
+func (in *TaskRef) DeepCopy() *TaskRef {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskSchedule) DeepCopyInto(out *TaskSchedule) {
+	*out = *in
+	if in.StartDate != nil {
+		in, out := &in.StartDate, &out.StartDate
+		*out = (*in).DeepCopy()
+	}
+	if in.RecurringDays != nil {
+		in, out := &in.RecurringDays, &out.RecurringDays
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskSchedule.
+func (in *TaskSchedule) DeepCopy() *TaskSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPasswordGeneration) DeepCopyInto(out *UserPasswordGeneration) {
+	*out = *in
+	if in.RotationPeriod != nil {
+		in, out := &in.RotationPeriod, &out.RotationPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DockerRegistries != nil {
+		in, out := &in.DockerRegistries, &out.DockerRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPasswordGeneration.
+func (in *UserPasswordGeneration) DeepCopy() *UserPasswordGeneration {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPasswordGeneration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Yum) DeepCopyInto(out *Yum) {
 	*out = *in
@@ -2595,11 +3699,21 @@ func (in *YumSigning) DeepCopyInto(out *YumSigning) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.KeypairRef != nil {
+		in, out := &in.KeypairRef, &out.KeypairRef
+		*out = new(common.SecretKeySelector)
+		**out = **in
+	}
 	if in.Passphrase != nil {
 		in, out := &in.Passphrase, &out.Passphrase
 		*out = new(string)
 		**out = **in
 	}
+	if in.PassphraseRef != nil {
+		in, out := &in.PassphraseRef, &out.PassphraseRef
+		*out = new(common.SecretKeySelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YumSigning.
@@ -5,6 +5,7 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"time"
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
@@ -22,15 +23,26 @@ import (
 
 	buildInfo "github.com/epam/edp-common/pkg/config"
 	nexusApiV1Alpha1 "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/internal/controllers/anonymousaccess"
 	"github.com/epam/edp-nexus-operator/internal/controllers/blobstore"
 	"github.com/epam/edp-nexus-operator/internal/controllers/cleanuppolicy"
+	"github.com/epam/edp-nexus-operator/internal/controllers/clusternexus"
+	"github.com/epam/edp-nexus-operator/internal/controllers/contentselector"
+	"github.com/epam/edp-nexus-operator/internal/controllers/ldapserver"
 	"github.com/epam/edp-nexus-operator/internal/controllers/nexus"
+	"github.com/epam/edp-nexus-operator/internal/controllers/privilege"
+	"github.com/epam/edp-nexus-operator/internal/controllers/realms"
 	"github.com/epam/edp-nexus-operator/internal/controllers/repository"
 	"github.com/epam/edp-nexus-operator/internal/controllers/role"
+	"github.com/epam/edp-nexus-operator/internal/controllers/routingrule"
 	"github.com/epam/edp-nexus-operator/internal/controllers/script"
+	"github.com/epam/edp-nexus-operator/internal/controllers/task"
+	"github.com/epam/edp-nexus-operator/internal/controllers/taskrun"
 	"github.com/epam/edp-nexus-operator/internal/controllers/user"
 	nexusclient "github.com/epam/edp-nexus-operator/pkg/client/nexus"
 	"github.com/epam/edp-nexus-operator/pkg/helper"
+	"github.com/epam/edp-nexus-operator/pkg/ldap"
 	"github.com/epam/edp-nexus-operator/pkg/webhook"
 )
 
@@ -57,6 +69,16 @@ func main() {
 		secureMetrics                                    bool
 		enableHTTP2                                      bool
 		tlsOpts                                          []func(*tls.Config)
+		roleResyncInterval, userResyncInterval           time.Duration
+		repositoryResyncInterval, scriptResyncInterval   time.Duration
+		blobStoreResyncInterval, cleanupResyncInterval   time.Duration
+		privilegeResyncInterval                          time.Duration
+		contentSelectorResyncInterval                    time.Duration
+		routingRuleResyncInterval                        time.Duration
+		taskResyncInterval                               time.Duration
+		realmsResyncInterval                             time.Duration
+		anonymousAccessResyncInterval                    time.Duration
+		ldapServerResyncInterval                         time.Duration
 	)
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
@@ -76,6 +98,33 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.DurationVar(&roleResyncInterval, "nexusrole-resync-interval", controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusRole resources. Use 0 to disable.")
+	flag.DurationVar(&userResyncInterval, "nexususer-resync-interval", controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusUser resources. Use 0 to disable.")
+	flag.DurationVar(&repositoryResyncInterval, "nexusrepository-resync-interval", controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusRepository resources. Use 0 to disable.")
+	flag.DurationVar(&scriptResyncInterval, "nexusscript-resync-interval", controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusScript resources. Use 0 to disable.")
+	flag.DurationVar(&blobStoreResyncInterval, "nexusblobstore-resync-interval", controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusBlobStore resources. Use 0 to disable.")
+	flag.DurationVar(&cleanupResyncInterval, "nexuscleanuppolicy-resync-interval", controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusCleanupPolicy resources. Use 0 to disable.")
+	flag.DurationVar(&privilegeResyncInterval, "nexusprivilege-resync-interval", controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusPrivilege resources. Use 0 to disable.")
+	flag.DurationVar(&contentSelectorResyncInterval, "nexuscontentselector-resync-interval", controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusContentSelector resources. Use 0 to disable.")
+	flag.DurationVar(&routingRuleResyncInterval, "nexusroutingrule-resync-interval", controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusRoutingRule resources. Use 0 to disable.")
+	flag.DurationVar(&taskResyncInterval, "nexustask-resync-interval", controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusTask resources. Use 0 to disable.")
+	flag.DurationVar(&realmsResyncInterval, "nexusrealms-resync-interval", controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusRealms resources. Use 0 to disable.")
+	flag.DurationVar(&anonymousAccessResyncInterval, "nexusanonymousaccess-resync-interval",
+		controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusAnonymousAccess resources. Use 0 to disable.")
+	flag.DurationVar(&ldapServerResyncInterval, "nexusldapserver-resync-interval", controllers.DefaultResyncInterval,
+		"The interval of the periodic reconciliation of NexusLdapServer resources. Use 0 to disable.")
 
 	opts := zap.Options{
 		Development: true,
@@ -195,6 +244,12 @@ func main() {
 	ns := helper.GetWatchNamespace()
 	cfg := ctrl.GetConfigOrDie()
 
+	// The operator watches all namespaces if WATCH_NAMESPACE is empty.
+	cacheOptions := cache.Options{}
+	if ns != "" {
+		cacheOptions.DefaultNamespaces = map[string]cache.Config{ns: {}}
+	}
+
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                metricsServerOptions,
@@ -202,9 +257,7 @@ func main() {
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       nexusOperatorLock,
-		Cache: cache.Options{
-			DefaultNamespaces: map[string]cache.Config{ns: {}},
-		},
+		Cache:                  cacheOptions,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -222,10 +275,20 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = clusternexus.NewClusterNexusReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		apiClientProvider,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterNexus")
+		os.Exit(1)
+	}
+
 	if err = role.NewNexusRoleReconciler(
 		mgr.GetClient(),
 		mgr.GetScheme(),
 		apiClientProvider,
+		roleResyncInterval,
 	).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "role")
 		os.Exit(1)
@@ -235,6 +298,7 @@ func main() {
 		mgr.GetClient(),
 		mgr.GetScheme(),
 		apiClientProvider,
+		userResyncInterval,
 	).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "user")
 		os.Exit(1)
@@ -243,6 +307,7 @@ func main() {
 	if err = repository.NewNexusRepositoryReconciler(
 		mgr.GetClient(),
 		apiClientProvider,
+		repositoryResyncInterval,
 	).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "repository")
 		os.Exit(1)
@@ -251,6 +316,7 @@ func main() {
 	if err = script.NewNexusScriptReconciler(
 		mgr.GetClient(),
 		apiClientProvider,
+		scriptResyncInterval,
 	).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "NexusScript")
 		os.Exit(1)
@@ -259,6 +325,7 @@ func main() {
 	if err = blobstore.NewNexusBlobStoreReconciler(
 		mgr.GetClient(),
 		apiClientProvider,
+		blobStoreResyncInterval,
 	).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "NexusBlobStore")
 		os.Exit(1)
@@ -267,16 +334,95 @@ func main() {
 	if err = cleanuppolicy.NewNexusCleanupPolicyReconciler(
 		mgr.GetClient(),
 		apiClientProvider,
+		cleanupResyncInterval,
 	).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "NexusCleanupPolicy")
 		os.Exit(1)
 	}
+
+	if err = privilege.NewNexusPrivilegeReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		apiClientProvider,
+		privilegeResyncInterval,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NexusPrivilege")
+		os.Exit(1)
+	}
+
+	if err = contentselector.NewNexusContentSelectorReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		apiClientProvider,
+		contentSelectorResyncInterval,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NexusContentSelector")
+		os.Exit(1)
+	}
+
+	if err = routingrule.NewNexusRoutingRuleReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		apiClientProvider,
+		routingRuleResyncInterval,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NexusRoutingRule")
+		os.Exit(1)
+	}
+
+	if err = task.NewNexusTaskReconciler(
+		mgr.GetClient(),
+		apiClientProvider,
+		taskResyncInterval,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NexusTask")
+		os.Exit(1)
+	}
+
+	if err = taskrun.NewNexusTaskRunReconciler(
+		mgr.GetClient(),
+		apiClientProvider,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NexusTaskRun")
+		os.Exit(1)
+	}
+
+	if err = realms.NewNexusRealmsReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		apiClientProvider,
+		realmsResyncInterval,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NexusRealms")
+		os.Exit(1)
+	}
+
+	if err = anonymousaccess.NewNexusAnonymousAccessReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		apiClientProvider,
+		anonymousAccessResyncInterval,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NexusAnonymousAccess")
+		os.Exit(1)
+	}
+
+	if err = ldapserver.NewNexusLdapServerReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		apiClientProvider,
+		ldap.NewVerifier(),
+		ldapServerResyncInterval,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NexusLdapServer")
+		os.Exit(1)
+	}
 	// +kubebuilder:scaffold:builder
 
 	ctx := ctrl.SetupSignalHandler()
 
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
-		if err = webhook.RegisterValidationWebHook(ctx, mgr, ns); err != nil {
+		if err = webhook.RegisterValidationWebHook(ctx, mgr, helper.GetOperatorNamespace(), apiClientProvider); err != nil {
 			setupLog.Error(err, "failed to create webhook", "webhook", "NexusRepository")
 			os.Exit(1)
 		}
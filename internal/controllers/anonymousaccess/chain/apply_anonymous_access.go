@@ -0,0 +1,79 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+const (
+	anonymousAccessKind = "NexusAnonymousAccess"
+	// AnonymousAccessObjectName is the name of the anonymous access settings in the ownership record.
+	// Nexus has a single anonymous access configuration, so only one NexusAnonymousAccess can manage it.
+	AnonymousAccessObjectName = "anonymous-access"
+)
+
+// ApplyAnonymousAccess is a handler for applying anonymous access settings.
+type ApplyAnonymousAccess struct {
+	nexusAnonymousApiClient nexus.AnonymousAccess
+	ownership               *controllers.OwnershipRecord
+}
+
+// NewApplyAnonymousAccess creates an instance of ApplyAnonymousAccess handler.
+func NewApplyAnonymousAccess(
+	nexusAnonymousApiClient nexus.AnonymousAccess,
+	ownership *controllers.OwnershipRecord,
+) *ApplyAnonymousAccess {
+	return &ApplyAnonymousAccess{nexusAnonymousApiClient: nexusAnonymousApiClient, ownership: ownership}
+}
+
+// ServeRequest implements the logic of applying anonymous access settings.
+func (c ApplyAnonymousAccess) ServeRequest(ctx context.Context, access *nexusApi.NexusAnonymousAccess) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("enabled", access.Spec.Enabled)
+	log.Info("Start applying anonymous access")
+
+	_, err := c.ownership.Acquire(ctx, access, common.ManagementPolicyAdopt, AnonymousAccessObjectName, true)
+	if err != nil {
+		return err
+	}
+
+	current, err := c.nexusAnonymousApiClient.Read()
+	if err != nil {
+		return fmt.Errorf("failed to get anonymous access: %w", err)
+	}
+
+	settings := specToAnonymousAccess(&access.Spec)
+
+	if *current != settings {
+		controllers.ReportDrift(ctx, anonymousAccessKind, access, &access.Status.ReconcileStatus,
+			"Anonymous access was changed in nexus, reverting changes",
+			"enabled", current.Enabled, "userId", current.UserID, "realmName", current.RealmName)
+
+		log.Info("Updating anonymous access")
+
+		if err = c.nexusAnonymousApiClient.Update(settings); err != nil {
+			return fmt.Errorf("failed to update anonymous access: %w", err)
+		}
+
+		log.Info("Anonymous access has been updated")
+	}
+
+	access.Status.Enabled = settings.Enabled
+
+	return nil
+}
+
+func specToAnonymousAccess(spec *nexusApi.NexusAnonymousAccessSpec) security.AnonymousAccessSettings {
+	return security.AnonymousAccessSettings{
+		Enabled:   spec.Enabled,
+		UserID:    spec.UserID,
+		RealmName: spec.RealmName,
+	}
+}
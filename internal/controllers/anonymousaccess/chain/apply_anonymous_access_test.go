@@ -0,0 +1,156 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/internal/controllers/testutils"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestApplyAnonymousAccess_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	newAccess := func(name string, enabled bool) *nexusApi.NexusAnonymousAccess {
+		return &nexusApi.NexusAnonymousAccess{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				UID:       types.UID("uid-" + name),
+			},
+			Spec: nexusApi.NexusAnonymousAccessSpec{
+				Enabled:   enabled,
+				UserID:    "anonymous",
+				RealmName: "NexusAuthorizingRealm",
+				NexusRef:  common.NexusRef{Name: "nexus"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		access         *nexusApi.NexusAnonymousAccess
+		existing       []client.Object
+		nexusApiClient func(t *testing.T) nexus.AnonymousAccess
+		wantErr        require.ErrorAssertionFunc
+		wantEnabled    bool
+	}{
+		{
+			name:   "anonymous access is up to date",
+			access: newAccess("anonymous", true),
+			nexusApiClient: func(t *testing.T) nexus.AnonymousAccess {
+				m := mocks.NewMockAnonymousAccess(t)
+
+				m.On("Read").Return(&security.AnonymousAccessSettings{
+					Enabled:   true,
+					UserID:    "anonymous",
+					RealmName: "NexusAuthorizingRealm",
+				}, nil)
+
+				return m
+			},
+			wantErr:     require.NoError,
+			wantEnabled: true,
+		},
+		{
+			name:   "anonymous access is changed",
+			access: newAccess("anonymous", false),
+			nexusApiClient: func(t *testing.T) nexus.AnonymousAccess {
+				m := mocks.NewMockAnonymousAccess(t)
+
+				m.On("Read").Return(&security.AnonymousAccessSettings{
+					Enabled:   true,
+					UserID:    "guest",
+					RealmName: "NexusAuthorizingRealm",
+				}, nil)
+				m.On("Update", security.AnonymousAccessSettings{
+					Enabled:   false,
+					UserID:    "anonymous",
+					RealmName: "NexusAuthorizingRealm",
+				}).Return(nil)
+
+				return m
+			},
+			wantErr:     require.NoError,
+			wantEnabled: false,
+		},
+		{
+			name:   "failed to update anonymous access",
+			access: newAccess("anonymous", true),
+			nexusApiClient: func(t *testing.T) nexus.AnonymousAccess {
+				m := mocks.NewMockAnonymousAccess(t)
+
+				m.On("Read").Return(&security.AnonymousAccessSettings{}, nil)
+				m.On("Update", security.AnonymousAccessSettings{
+					Enabled:   true,
+					UserID:    "anonymous",
+					RealmName: "NexusAuthorizingRealm",
+				}).Return(errors.New("user not found"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to update anonymous access")
+			},
+		},
+		{
+			name:   "failed to get anonymous access",
+			access: newAccess("anonymous", true),
+			nexusApiClient: func(t *testing.T) nexus.AnonymousAccess {
+				m := mocks.NewMockAnonymousAccess(t)
+
+				m.On("Read").Return(nil, errors.New("connection refused"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to get anonymous access")
+			},
+		},
+		{
+			name:     "anonymous access is managed by another resource",
+			access:   newAccess("anonymous", true),
+			existing: []client.Object{newAccess("other-anonymous", false)},
+			nexusApiClient: func(t *testing.T) nexus.AnonymousAccess {
+				return mocks.NewMockAnonymousAccess(t)
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, controllers.ErrAlreadyManaged)
+				require.ErrorContains(t, err, "anonymous-access is already managed by default/other-anonymous")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := ctrl.LoggerInto(context.Background(), logr.Discard())
+			ownership := testutils.NewOwnershipRecord(t, tt.existing...)
+
+			for _, obj := range tt.existing {
+				_, err := ownership.Acquire(ctx, obj, common.ManagementPolicyAdopt, AnonymousAccessObjectName, true)
+				require.NoError(t, err)
+			}
+
+			err := NewApplyAnonymousAccess(tt.nexusApiClient(t), ownership).ServeRequest(ctx, tt.access)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantEnabled, tt.access.Status.Enabled)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package anonymousaccess
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/internal/controllers/anonymousaccess/chain"
+)
+
+// NexusAnonymousAccessReconciler reconciles a NexusAnonymousAccess object.
+type NexusAnonymousAccessReconciler struct {
+	client            client.Client
+	scheme            *runtime.Scheme
+	apiClientProvider controllers.ApiClientProvider
+	resyncInterval    time.Duration
+}
+
+func NewNexusAnonymousAccessReconciler(
+	k8sClient client.Client,
+	scheme *runtime.Scheme,
+	apiClientProvider controllers.ApiClientProvider,
+	resyncInterval time.Duration,
+) *NexusAnonymousAccessReconciler {
+	return &NexusAnonymousAccessReconciler{
+		client:            k8sClient,
+		scheme:            scheme,
+		apiClientProvider: apiClientProvider,
+		resyncInterval:    resyncInterval,
+	}
+}
+
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusanonymousaccesses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusanonymousaccesses/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusanonymousaccesses/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *NexusAnonymousAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling NexusAnonymousAccess")
+
+	access := &nexusApi.NexusAnonymousAccess{}
+	if err := r.client.Get(ctx, req.NamespacedName, access); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get NexusAnonymousAccess: %w", err)
+	}
+
+	oldStatus := access.Status.DeepCopy()
+	ownership := controllers.NewOwnershipRecord(r.client, access.Namespace, access.Spec.NexusRef)
+
+	// Nexus always has anonymous access settings, so they are left in nexus when the resource is deleted.
+	if access.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(access, controllers.NexusOperatorFinalizer) {
+			log.Info("Leaving anonymous access settings in nexus")
+
+			if err := ownership.Release(ctx, access, chain.AnonymousAccessObjectName); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			controllerutil.RemoveFinalizer(access, controllers.NexusOperatorFinalizer)
+
+			if err := r.client.Update(ctx, access); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to update NexusAnonymousAccess: %w", err)
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, access.Namespace, access)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&access.Status.ReconcileStatus, access.Generation, err)
+
+		if statusErr := r.updateNexusAnonymousAccessStatus(ctx, access, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
+	if controllerutil.AddFinalizer(access, controllers.NexusOperatorFinalizer) {
+		err = r.client.Update(ctx, access)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update NexusAnonymousAccess: %w", err)
+		}
+	}
+
+	err = chain.NewApplyAnonymousAccess(nexusApiClient.Security.Anonymous, ownership).ServeRequest(ctx, access)
+	if err != nil {
+		log.Error(err, "An error has occurred while handling NexusAnonymousAccess")
+
+		access.Status.Value = common.StatusError
+		access.Status.Error = err.Error()
+		controllers.SetSyncFailed(&access.Status.ReconcileStatus, access.Generation, err)
+
+		if statusErr := r.updateNexusAnonymousAccessStatus(ctx, access, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return controllers.RequeueOnError(err), nil
+	}
+
+	access.Status.Value = common.StatusCreated
+	access.Status.Error = ""
+	controllers.SetSynced(&access.Status.ReconcileStatus, access.Generation)
+
+	if err = r.updateNexusAnonymousAccessStatus(ctx, access, oldStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return controllers.ResyncResult(ctx, access, r.resyncInterval), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NexusAnonymousAccessReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&nexusApi.NexusAnonymousAccess{}).
+		Complete(r)
+	if err != nil {
+		return fmt.Errorf("failed to create controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *NexusAnonymousAccessReconciler) updateNexusAnonymousAccessStatus(
+	ctx context.Context,
+	access *nexusApi.NexusAnonymousAccess,
+	oldStatus *nexusApi.NexusAnonymousAccessStatus,
+) error {
+	if equality.Semantic.DeepEqual(&access.Status, oldStatus) {
+		return nil
+	}
+
+	if err := r.client.Status().Update(ctx, access); err != nil {
+		return fmt.Errorf("failed to update NexusAnonymousAccess status: %w", err)
+	}
+
+	return nil
+}
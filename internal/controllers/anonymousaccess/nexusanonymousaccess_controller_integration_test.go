@@ -0,0 +1,76 @@
+package anonymousaccess
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+var _ = Describe("NexusAnonymousAccess controller", func() {
+	anonymousAccessCRName := "anonymous-access"
+	It("Should create NexusAnonymousAccess object", func() {
+		By("By creating a new NexusAnonymousAccess object")
+		newAccess := &nexusApi.NexusAnonymousAccess{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      anonymousAccessCRName,
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusAnonymousAccessSpec{
+				Enabled: true,
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newAccess)).Should(Succeed())
+		Eventually(func() bool {
+			createdAccess := &nexusApi.NexusAnonymousAccess{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: anonymousAccessCRName, Namespace: namespace}, createdAccess)
+			if err != nil {
+				return false
+			}
+
+			return createdAccess.Status.Value == common.StatusCreated && createdAccess.Status.Enabled
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should update NexusAnonymousAccess object", func() {
+		By("Getting NexusAnonymousAccess object")
+		createdAccess := &nexusApi.NexusAnonymousAccess{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: anonymousAccessCRName, Namespace: namespace}, createdAccess)).
+			Should(Succeed())
+
+		By("Updating NexusAnonymousAccess object")
+		createdAccess.Spec.Enabled = false
+
+		Expect(k8sClient.Update(ctx, createdAccess)).Should(Succeed())
+		Eventually(func() bool {
+			updatedAccess := &nexusApi.NexusAnonymousAccess{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: anonymousAccessCRName, Namespace: namespace}, updatedAccess)
+			if err != nil {
+				return false
+			}
+
+			return updatedAccess.Status.Value == common.StatusCreated &&
+				updatedAccess.Status.ObservedGeneration == updatedAccess.Generation &&
+				!updatedAccess.Status.Enabled
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should delete NexusAnonymousAccess object", func() {
+		By("Deleting NexusAnonymousAccess object")
+		createdAccess := &nexusApi.NexusAnonymousAccess{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: anonymousAccessCRName, Namespace: namespace}, createdAccess)).
+			Should(Succeed())
+		Expect(k8sClient.Delete(ctx, createdAccess)).Should(Succeed())
+		Eventually(func() bool {
+			createdAccess := &nexusApi.NexusAnonymousAccess{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: anonymousAccessCRName, Namespace: namespace}, createdAccess)
+			return k8sErrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
@@ -13,20 +14,27 @@ type CreateBlobStore struct {
 	nexusS3BlobStoreApiClient   nexus.S3BlobStore
 	nexusFileBlobStoreApiClient nexus.FileBlobStore
 	k8sClient                   client.Client
+	ownership                   *controllers.OwnershipRecord
 }
 
 func NewCreateBlobStore(
 	nexusS3BlobStoreApiClient nexus.S3BlobStore,
 	nexusFileBlobStoreApiClient nexus.FileBlobStore,
 	k8sClient client.Client,
+	ownership *controllers.OwnershipRecord,
 ) *CreateBlobStore {
-	return &CreateBlobStore{nexusS3BlobStoreApiClient: nexusS3BlobStoreApiClient, nexusFileBlobStoreApiClient: nexusFileBlobStoreApiClient, k8sClient: k8sClient}
+	return &CreateBlobStore{
+		nexusS3BlobStoreApiClient:   nexusS3BlobStoreApiClient,
+		nexusFileBlobStoreApiClient: nexusFileBlobStoreApiClient,
+		k8sClient:                   k8sClient,
+		ownership:                   ownership,
+	}
 }
 
 func (c *CreateBlobStore) ServeRequest(ctx context.Context, blobStore *nexusApi.NexusBlobStore) error {
 	if blobStore.Spec.File != nil {
-		return NewCreateFileBlobStore(c.nexusFileBlobStoreApiClient).ServeRequest(ctx, blobStore)
+		return NewCreateFileBlobStore(c.nexusFileBlobStoreApiClient, c.ownership).ServeRequest(ctx, blobStore)
 	}
 
-	return NewCreateS3BlobStore(c.nexusS3BlobStoreApiClient, c.k8sClient).ServeRequest(ctx, blobStore)
+	return NewCreateS3BlobStore(c.nexusS3BlobStoreApiClient, c.k8sClient, c.ownership).ServeRequest(ctx, blobStore)
 }
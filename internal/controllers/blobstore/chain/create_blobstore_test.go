@@ -2,7 +2,6 @@ package chain
 
 import (
 	"context"
-	"errors"
 	"testing"
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
@@ -12,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers/testutils"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
 )
@@ -43,7 +43,7 @@ func TestCreateBlobStore_ServeRequest(t *testing.T) {
 				m := mocks.NewMockFileBlobStore(t)
 
 				m.On("Get", "test-blobstore").
-					Return(nil, errors.New("not found"))
+					Return(nil, nexus.ErrNotFound)
 
 				m.On("Create", &blobstore.File{
 					Name: "test-blobstore",
@@ -64,7 +64,12 @@ func TestCreateBlobStore_ServeRequest(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			c := NewCreateBlobStore(mocks.NewMockS3BlobStore(t), tt.nexusBlobStoreApiClient(t), fake.NewClientBuilder().Build())
+			c := NewCreateBlobStore(
+				mocks.NewMockS3BlobStore(t),
+				tt.nexusBlobStoreApiClient(t),
+				fake.NewClientBuilder().Build(),
+				testutils.NewOwnershipRecord(t),
+			)
 
 			err := c.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.blobStore)
 			tt.wantErr(t, err)
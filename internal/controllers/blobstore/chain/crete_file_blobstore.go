@@ -8,15 +8,22 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
+const blobStoreKind = "NexusBlobStore"
+
 type CreateFileBlobStore struct {
 	nexusFileBlobStoreApiClient nexus.FileBlobStore
+	ownership                   *controllers.OwnershipRecord
 }
 
-func NewCreateFileBlobStore(nexusFileBlobStoreApiClient nexus.FileBlobStore) *CreateFileBlobStore {
-	return &CreateFileBlobStore{nexusFileBlobStoreApiClient: nexusFileBlobStoreApiClient}
+func NewCreateFileBlobStore(
+	nexusFileBlobStoreApiClient nexus.FileBlobStore,
+	ownership *controllers.OwnershipRecord,
+) *CreateFileBlobStore {
+	return &CreateFileBlobStore{nexusFileBlobStoreApiClient: nexusFileBlobStoreApiClient, ownership: ownership}
 }
 
 func (c *CreateFileBlobStore) ServeRequest(ctx context.Context, blobStore *nexusApi.NexusBlobStore) error {
@@ -28,12 +35,26 @@ func (c *CreateFileBlobStore) ServeRequest(ctx context.Context, blobStore *nexus
 	log.Info("Start creating file blobstore")
 
 	nexusBlobStore, err := c.nexusFileBlobStoreApiClient.Get(blobStore.Spec.Name)
+	if err != nil && !nexus.IsErrNotFound(err) {
+		return fmt.Errorf("failed to get blobstore: %w", err)
+	}
+
+	exists := err == nil
+
+	manage, err := c.ownership.Acquire(ctx, blobStore, blobStore.Spec.ManagementPolicy, blobStore.Spec.Name, exists)
 	if err != nil {
-		if !nexus.IsErrNotFound(err) {
-			return fmt.Errorf("failed to get blobstore: %w", err)
-		}
+		return err
+	}
 
-		log.Info("Blobstore doesn't exist, creating new one")
+	if !manage {
+		return nil
+	}
+
+	if !exists {
+		if !controllers.ReportDrift(ctx, blobStoreKind, blobStore, &blobStore.Status.ReconcileStatus,
+			"Blobstore was deleted in nexus, recreating it", "blobstore_name", blobStore.Spec.Name) {
+			log.Info("Blobstore doesn't exist, creating new one")
+		}
 
 		if err = c.nexusFileBlobStoreApiClient.Create(specToFileBlobstore(&blobStore.Spec)); err != nil {
 			return fmt.Errorf("failed to create blobstore: %w", err)
@@ -47,6 +68,9 @@ func (c *CreateFileBlobStore) ServeRequest(ctx context.Context, blobStore *nexus
 	newNexusBlobStore := specToFileBlobstore(&blobStore.Spec)
 
 	if fileBlobstoreChanged(newNexusBlobStore, nexusBlobStore) {
+		controllers.ReportDrift(ctx, blobStoreKind, blobStore, &blobStore.Status.ReconcileStatus,
+			"Blobstore was changed in nexus, reverting changes", "blobstore_name", blobStore.Spec.Name)
+
 		log.Info("Updating blobstore")
 
 		if err = c.nexusFileBlobStoreApiClient.Update(blobStore.Spec.Name, newNexusBlobStore); err != nil {
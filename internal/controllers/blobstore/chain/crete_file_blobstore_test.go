@@ -11,6 +11,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers/testutils"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
 )
@@ -42,7 +43,7 @@ func TestCreateFileBlobStore_ServeRequest(t *testing.T) {
 				m := mocks.NewMockFileBlobStore(t)
 
 				m.On("Get", "test-blobstore").
-					Return(nil, errors.New("not found"))
+					Return(nil, nexus.ErrNotFound)
 
 				m.On("Create", &blobstore.File{
 					Name: "test-blobstore",
@@ -181,7 +182,7 @@ func TestCreateFileBlobStore_ServeRequest(t *testing.T) {
 				m := mocks.NewMockFileBlobStore(t)
 
 				m.On("Get", "test-blobstore").
-					Return(nil, errors.New("not found"))
+					Return(nil, nexus.ErrNotFound)
 
 				m.On("Create", &blobstore.File{
 					Name: "test-blobstore",
@@ -228,7 +229,7 @@ func TestCreateFileBlobStore_ServeRequest(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			c := NewCreateFileBlobStore(tt.nexusBlobStoreApiClient(t))
+			c := NewCreateFileBlobStore(tt.nexusBlobStoreApiClient(t), testutils.NewOwnershipRecord(t))
 
 			err := c.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.blobStore)
 			tt.wantErr(t, err)
@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 	"github.com/epam/edp-nexus-operator/pkg/helper"
 )
@@ -16,10 +17,19 @@ import (
 type CreateS3BlobStore struct {
 	nexusS3BlobStoreApiClient nexus.S3BlobStore
 	k8sClient                 client.Client
+	ownership                 *controllers.OwnershipRecord
 }
 
-func NewCreateS3BlobStore(nexusS3BlobStoreApiClient nexus.S3BlobStore, k8sClient client.Client) *CreateS3BlobStore {
-	return &CreateS3BlobStore{nexusS3BlobStoreApiClient: nexusS3BlobStoreApiClient, k8sClient: k8sClient}
+func NewCreateS3BlobStore(
+	nexusS3BlobStoreApiClient nexus.S3BlobStore,
+	k8sClient client.Client,
+	ownership *controllers.OwnershipRecord,
+) *CreateS3BlobStore {
+	return &CreateS3BlobStore{
+		nexusS3BlobStoreApiClient: nexusS3BlobStoreApiClient,
+		k8sClient:                 k8sClient,
+		ownership:                 ownership,
+	}
 }
 
 func (c *CreateS3BlobStore) ServeRequest(ctx context.Context, blobStore *nexusApi.NexusBlobStore) error {
@@ -36,12 +46,26 @@ func (c *CreateS3BlobStore) ServeRequest(ctx context.Context, blobStore *nexusAp
 	}
 
 	_, err = c.nexusS3BlobStoreApiClient.Get(blobStore.Spec.Name)
+	if err != nil && !nexus.IsErrNotFound(err) {
+		return fmt.Errorf("failed to get blobstore: %w", err)
+	}
+
+	exists := err == nil
+
+	manage, err := c.ownership.Acquire(ctx, blobStore, blobStore.Spec.ManagementPolicy, blobStore.Spec.Name, exists)
 	if err != nil {
-		if !nexus.IsErrNotFound(err) {
-			return fmt.Errorf("failed to get blobstore: %w", err)
-		}
+		return err
+	}
 
-		log.Info("Blobstore doesn't exist, creating new one")
+	if !manage {
+		return nil
+	}
+
+	if !exists {
+		if !controllers.ReportDrift(ctx, blobStoreKind, blobStore, &blobStore.Status.ReconcileStatus,
+			"Blobstore was deleted in nexus, recreating it", "blobstore_name", blobStore.Spec.Name) {
+			log.Info("Blobstore doesn't exist, creating new one")
+		}
 
 		if err = c.nexusS3BlobStoreApiClient.Create(nexusBlobStore); err != nil {
 			return fmt.Errorf("failed to create blobstore: %w", err)
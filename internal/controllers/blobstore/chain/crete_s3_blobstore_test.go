@@ -19,6 +19,7 @@ import (
 
 	"github.com/epam/edp-nexus-operator/api/common"
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers/testutils"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
 )
@@ -100,7 +101,7 @@ func TestCreateS3BlobStore_ServeRequest(t *testing.T) {
 				m := mocks.NewMockS3BlobStore(t)
 
 				m.On("Get", "test-blobstore").
-					Return(nil, errors.New("not found"))
+					Return(nil, nexus.ErrNotFound)
 
 				m.On("Create", &blobstore.S3{
 					Name: "test-blobstore",
@@ -298,7 +299,7 @@ func TestCreateS3BlobStore_ServeRequest(t *testing.T) {
 				m := mocks.NewMockS3BlobStore(t)
 
 				m.On("Get", "test-blobstore").
-					Return(nil, errors.New("not found"))
+					Return(nil, nexus.ErrNotFound)
 
 				m.On("Create", mock.Anything).Return(errors.New("failed to create blobstore"))
 
@@ -346,7 +347,7 @@ func TestCreateS3BlobStore_ServeRequest(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			c := NewCreateS3BlobStore(tt.nexusBlobStoreApiClient(t), tt.k8sClient(t))
+			c := NewCreateS3BlobStore(tt.nexusBlobStoreApiClient(t), tt.k8sClient(t), testutils.NewOwnershipRecord(t))
 
 			err := c.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.blobStore)
 			tt.wantErr(t, err)
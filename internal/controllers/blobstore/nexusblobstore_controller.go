@@ -3,8 +3,11 @@ package blobstore
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -19,15 +22,27 @@ import (
 type NexusBlobStoreReconciler struct {
 	client            client.Client
 	apiClientProvider controllers.ApiClientProvider
+	resyncInterval    time.Duration
+	recorder          record.EventRecorder
 }
 
-func NewNexusBlobStoreReconciler(k8sClient client.Client, apiClientProvider controllers.ApiClientProvider) *NexusBlobStoreReconciler {
-	return &NexusBlobStoreReconciler{client: k8sClient, apiClientProvider: apiClientProvider}
+func NewNexusBlobStoreReconciler(
+	k8sClient client.Client,
+	apiClientProvider controllers.ApiClientProvider,
+	resyncInterval time.Duration,
+) *NexusBlobStoreReconciler {
+	return &NexusBlobStoreReconciler{
+		client:            k8sClient,
+		apiClientProvider: apiClientProvider,
+		resyncInterval:    resyncInterval,
+	}
 }
 
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusblobstores,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusblobstores/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusblobstores/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -44,23 +59,44 @@ func (r *NexusBlobStoreReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, fmt.Errorf("failed to get NexusBlobStore: %w", err)
 	}
 
-	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, store.Namespace, store)
-	if err != nil {
-		log.Error(err, "An error has occurred while getting nexus api client")
+	oldStatus := store.Status.DeepCopy()
 
-		return ctrl.Result{
-			RequeueAfter: controllers.ErrorRequeueTime,
-		}, nil
-	}
+	ownership := controllers.NewOwnershipRecord(r.client, store.Namespace, store.Spec.NexusRef)
 
 	if store.GetDeletionTimestamp() != nil {
 		if controllerutil.ContainsFinalizer(store, controllers.NexusOperatorFinalizer) {
-			if err = chain.NewRemoveBlobstore(nexusApiClient.BlobStore.File).ServeRequest(ctx, store); err != nil {
-				log.Error(err, "An error has occurred while deleting NexusBlobStore")
+			deleteFromNexus, err := ownership.CanDelete(ctx, store, store.Spec.ManagementPolicy, store.Spec.Name)
+			if err != nil {
+				log.Error(err, "An error has occurred while checking ownership of NexusBlobStore")
+
+				return controllers.RequeueOnError(err), nil
+			}
+
+			if deleteFromNexus && controllers.ShouldDeleteFromNexus(ctx, r.recorder, store, store.Spec.DeletionPolicy) {
+				nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, store.Namespace, store)
+				if err != nil {
+					log.Error(err, "An error has occurred while getting nexus api client")
+
+					controllers.SetDependenciesNotResolved(&store.Status.ReconcileStatus, store.Generation, err)
+
+					if statusErr := r.updateNexusBlobStoreStatus(ctx, store, oldStatus); statusErr != nil {
+						return ctrl.Result{}, statusErr
+					}
+
+					return ctrl.Result{
+						RequeueAfter: controllers.ErrorRequeueTime,
+					}, nil
+				}
+
+				if err = chain.NewRemoveBlobstore(nexusApiClient.BlobStore.File).ServeRequest(ctx, store); err != nil {
+					log.Error(err, "An error has occurred while deleting NexusBlobStore")
+
+					return controllers.RequeueOnError(err), nil
+				}
+			}
 
-				return ctrl.Result{
-					RequeueAfter: controllers.ErrorRequeueTime,
-				}, nil
+			if err = ownership.Release(ctx, store, store.Spec.Name); err != nil {
+				return ctrl.Result{}, err
 			}
 
 			controllerutil.RemoveFinalizer(store, controllers.NexusOperatorFinalizer)
@@ -73,6 +109,21 @@ func (r *NexusBlobStoreReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, nil
 	}
 
+	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, store.Namespace, store)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&store.Status.ReconcileStatus, store.Generation, err)
+
+		if statusErr := r.updateNexusBlobStoreStatus(ctx, store, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
 	if controllerutil.AddFinalizer(store, controllers.NexusOperatorFinalizer) {
 		err = r.client.Update(ctx, store)
 		if err != nil {
@@ -80,39 +131,40 @@ func (r *NexusBlobStoreReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
-	oldStatus := store.Status
-
 	if err = chain.NewCreateBlobStore(
 		nexusApiClient.BlobStore.S3,
 		nexusApiClient.BlobStore.File,
 		r.client,
+		ownership,
 	).ServeRequest(ctx, store); err != nil {
 		log.Error(err, "An error has occurred while handling NexusBlobStore")
 
 		store.Status.Value = common.StatusError
 		store.Status.Error = err.Error()
+		controllers.SetSyncFailed(&store.Status.ReconcileStatus, store.Generation, err)
 
-		if err = r.updateNexusBlobStoreStatus(ctx, store, oldStatus); err != nil {
-			return ctrl.Result{}, err
+		if statusErr := r.updateNexusBlobStoreStatus(ctx, store, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
 		}
 
-		return ctrl.Result{
-			RequeueAfter: controllers.ErrorRequeueTime,
-		}, nil
+		return controllers.RequeueOnError(err), nil
 	}
 
 	store.Status.Value = common.StatusCreated
 	store.Status.Error = ""
+	controllers.SetSynced(&store.Status.ReconcileStatus, store.Generation)
 
 	if err = r.updateNexusBlobStoreStatus(ctx, store, oldStatus); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return controllers.ResyncResult(ctx, store, r.resyncInterval), nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *NexusBlobStoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor(controllers.EventRecorderName)
+
 	err := ctrl.NewControllerManagedBy(mgr).
 		For(&nexusApi.NexusBlobStore{}).
 		Complete(r)
@@ -127,9 +179,9 @@ func (r *NexusBlobStoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
 func (r *NexusBlobStoreReconciler) updateNexusBlobStoreStatus(
 	ctx context.Context,
 	store *nexusApi.NexusBlobStore,
-	oldStatus nexusApi.NexusBlobStoreStatus,
+	oldStatus *nexusApi.NexusBlobStoreStatus,
 ) error {
-	if store.Status == oldStatus {
+	if equality.Semantic.DeepEqual(&store.Status, oldStatus) {
 		return nil
 	}
 
@@ -8,9 +8,12 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
+const cleanupPolicyKind = "NexusCleanupPolicy"
+
 type CreateNexusCleanupPolicy struct {
 	apiClient nexus.NexusCleanupPolicyManager
 }
@@ -29,7 +32,10 @@ func (c *CreateNexusCleanupPolicy) ServeRequest(ctx context.Context, policy *nex
 			return fmt.Errorf("failed to get cleanup policy: %w", err)
 		}
 
-		log.Info("Cleanup policy doesn't exist, creating new one")
+		if !controllers.ReportDrift(ctx, cleanupPolicyKind, policy, &policy.Status.ReconcileStatus,
+			"Cleanup policy was deleted in nexus, recreating it", "name", policy.Spec.Name) {
+			log.Info("Cleanup policy doesn't exist, creating new one")
+		}
 
 		if err = c.apiClient.Create(ctx, specToCleanupPolicy(&policy.Spec)); err != nil {
 			return fmt.Errorf("failed to create cleanup policy: %w", err)
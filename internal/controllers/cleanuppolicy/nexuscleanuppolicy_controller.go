@@ -3,8 +3,11 @@ package cleanuppolicy
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -28,15 +31,26 @@ type apiClientProvider interface {
 type NexusCleanupPolicyReconciler struct {
 	client            client.Client
 	apiClientProvider apiClientProvider
+	resyncInterval    time.Duration
+	recorder          record.EventRecorder
 }
 
-func NewNexusCleanupPolicyReconciler(k8sClient client.Client, apiClientProvider apiClientProvider) *NexusCleanupPolicyReconciler {
-	return &NexusCleanupPolicyReconciler{client: k8sClient, apiClientProvider: apiClientProvider}
+func NewNexusCleanupPolicyReconciler(
+	k8sClient client.Client,
+	apiClientProvider apiClientProvider,
+	resyncInterval time.Duration,
+) *NexusCleanupPolicyReconciler {
+	return &NexusCleanupPolicyReconciler{
+		client:            k8sClient,
+		apiClientProvider: apiClientProvider,
+		resyncInterval:    resyncInterval,
+	}
 }
 
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexuscleanuppolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexuscleanuppolicies/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexuscleanuppolicies/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -53,28 +67,36 @@ func (r *NexusCleanupPolicyReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return ctrl.Result{}, fmt.Errorf("failed to get NexusCleanupPolicy: %w", err)
 	}
 
-	nexusApiClient, err := r.apiClientProvider.GetNexusNexusCleanupPolicyClientFromNexusRef(ctx, policy.Namespace, policy)
-	if err != nil {
-		log.Error(err, "An error has occurred while getting nexus api client")
-
-		return ctrl.Result{
-			RequeueAfter: controllers.ErrorRequeueTime,
-		}, nil
-	}
+	oldStatus := policy.Status.DeepCopy()
 
 	if policy.GetDeletionTimestamp() != nil {
 		if controllerutil.ContainsFinalizer(policy, controllers.NexusOperatorFinalizer) {
-			if err = chain.NewRemoveCleanupPolicy(nexusApiClient).ServeRequest(ctx, policy); err != nil {
-				log.Error(err, "An error has occurred while deleting NexusCleanupPolicy")
+			if controllers.ShouldDeleteFromNexus(ctx, r.recorder, policy, policy.Spec.DeletionPolicy) {
+				nexusApiClient, err := r.apiClientProvider.GetNexusNexusCleanupPolicyClientFromNexusRef(ctx, policy.Namespace, policy)
+				if err != nil {
+					log.Error(err, "An error has occurred while getting nexus api client")
+
+					controllers.SetDependenciesNotResolved(&policy.Status.ReconcileStatus, policy.Generation, err)
+
+					if statusErr := r.updateNexusCleanupPolicyStatus(ctx, policy, oldStatus); statusErr != nil {
+						return ctrl.Result{}, statusErr
+					}
+
+					return ctrl.Result{
+						RequeueAfter: controllers.ErrorRequeueTime,
+					}, nil
+				}
 
-				return ctrl.Result{
-					RequeueAfter: controllers.ErrorRequeueTime,
-				}, nil
+				if err = chain.NewRemoveCleanupPolicy(nexusApiClient).ServeRequest(ctx, policy); err != nil {
+					log.Error(err, "An error has occurred while deleting NexusCleanupPolicy")
+
+					return controllers.RequeueOnError(err), nil
+				}
 			}
 
 			controllerutil.RemoveFinalizer(policy, controllers.NexusOperatorFinalizer)
 
-			if err = r.client.Update(ctx, policy); err != nil {
+			if err := r.client.Update(ctx, policy); err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to update NexusCleanupPolicy: %w", err)
 			}
 		}
@@ -82,6 +104,21 @@ func (r *NexusCleanupPolicyReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return ctrl.Result{}, nil
 	}
 
+	nexusApiClient, err := r.apiClientProvider.GetNexusNexusCleanupPolicyClientFromNexusRef(ctx, policy.Namespace, policy)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&policy.Status.ReconcileStatus, policy.Generation, err)
+
+		if statusErr := r.updateNexusCleanupPolicyStatus(ctx, policy, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
 	if controllerutil.AddFinalizer(policy, controllers.NexusOperatorFinalizer) {
 		err = r.client.Update(ctx, policy)
 		if err != nil {
@@ -89,35 +126,35 @@ func (r *NexusCleanupPolicyReconciler) Reconcile(ctx context.Context, req ctrl.R
 		}
 	}
 
-	oldStatus := policy.Status
-
 	if err = chain.NewCreateNexusCleanupPolicy(nexusApiClient).ServeRequest(ctx, policy); err != nil {
 		log.Error(err, "An error has occurred while handling NexusCleanupPolicy")
 
 		policy.Status.Value = common.StatusError
 		policy.Status.Error = err.Error()
+		controllers.SetSyncFailed(&policy.Status.ReconcileStatus, policy.Generation, err)
 
-		if err = r.updateNexusCleanupPolicyStatus(ctx, policy, oldStatus); err != nil {
-			return ctrl.Result{}, err
+		if statusErr := r.updateNexusCleanupPolicyStatus(ctx, policy, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
 		}
 
-		return ctrl.Result{
-			RequeueAfter: controllers.ErrorRequeueTime,
-		}, nil
+		return controllers.RequeueOnError(err), nil
 	}
 
 	policy.Status.Value = common.StatusCreated
 	policy.Status.Error = ""
+	controllers.SetSynced(&policy.Status.ReconcileStatus, policy.Generation)
 
 	if err = r.updateNexusCleanupPolicyStatus(ctx, policy, oldStatus); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return controllers.ResyncResult(ctx, policy, r.resyncInterval), nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *NexusCleanupPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor(controllers.EventRecorderName)
+
 	err := ctrl.NewControllerManagedBy(mgr).
 		For(&nexusApi.NexusCleanupPolicy{}).
 		Complete(r)
@@ -132,9 +169,9 @@ func (r *NexusCleanupPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error
 func (r *NexusCleanupPolicyReconciler) updateNexusCleanupPolicyStatus(
 	ctx context.Context,
 	policy *nexusApi.NexusCleanupPolicy,
-	oldStatus nexusApi.NexusCleanupPolicyStatus,
+	oldStatus *nexusApi.NexusCleanupPolicyStatus,
 ) error {
-	if policy.Status == oldStatus {
+	if equality.Semantic.DeepEqual(&policy.Status, oldStatus) {
 		return nil
 	}
 
@@ -0,0 +1,154 @@
+package clusternexus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/datadrivers/go-nexus-client/nexus3"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers/nexus/chain"
+	nexusclient "github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+const (
+	defaultRequeueTime = time.Second * 30
+	successRequeueTime = time.Minute * 10
+)
+
+type apiClientProvider interface {
+	GetNexusApiClientFromClusterNexus(ctx context.Context, clusterNexus *nexusApi.ClusterNexus) (*nexus3.NexusClient, error)
+	GetNexusStatusClientFromClusterNexus(ctx context.Context, clusterNexus *nexusApi.ClusterNexus) (*nexusclient.StatusClient, error)
+	EvictClusterNexusClients(name string)
+}
+
+func NewClusterNexusReconciler(c client.Client, scheme *runtime.Scheme, nexusApiProvider apiClientProvider) *ClusterNexusReconciler {
+	return &ClusterNexusReconciler{
+		client:            c,
+		scheme:            scheme,
+		apiClientProvider: nexusApiProvider,
+	}
+}
+
+// ClusterNexusReconciler reconciles a ClusterNexus object.
+type ClusterNexusReconciler struct {
+	client            client.Client
+	scheme            *runtime.Scheme
+	apiClientProvider apiClientProvider
+}
+
+// +kubebuilder:rbac:groups=edp.epam.com,resources=clusternexuses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=edp.epam.com,resources=clusternexuses/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=edp.epam.com,resources=clusternexuses/finalizers,verbs=update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *ClusterNexusReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling ClusterNexus")
+
+	clusterNexus := &nexusApi.ClusterNexus{}
+	if err := r.client.Get(ctx, req.NamespacedName, clusterNexus); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			// the resource is deleted, so its cached clients are not used anymore
+			r.apiClientProvider.EvictClusterNexusClients(req.Name)
+
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("failed to get ClusterNexus instance from k8s: %w", err)
+	}
+
+	oldStatus := clusterNexus.Status.DeepCopy()
+
+	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromClusterNexus(ctx, clusterNexus)
+	if err != nil {
+		clusterNexus.Status.Error = err.Error()
+		clusterNexus.Status.Connected = false
+
+		if statusErr := r.updateClusterNexusStatus(ctx, clusterNexus, oldStatus); statusErr != nil {
+			return reconcile.Result{}, statusErr
+		}
+
+		return reconcile.Result{RequeueAfter: defaultRequeueTime}, fmt.Errorf("failed to get nexus api client: %w", err)
+	}
+
+	if err = chain.NewCheckConnection(nexusclient.NewUserClient(nexusApiClient.Security.User)).ServeRequest(ctx, &clusterNexus.Status); err != nil {
+		clusterNexus.Status.Error = err.Error()
+		clusterNexus.Status.Connected = false
+
+		if statusErr := r.updateClusterNexusStatus(ctx, clusterNexus, oldStatus); statusErr != nil {
+			return reconcile.Result{}, statusErr
+		}
+
+		return reconcile.Result{RequeueAfter: defaultRequeueTime}, fmt.Errorf("failed to serve request: %w", err)
+	}
+
+	clusterNexus.Status.Connected = true
+
+	statusClient, err := r.apiClientProvider.GetNexusStatusClientFromClusterNexus(ctx, clusterNexus)
+	if err != nil {
+		clusterNexus.Status.Error = err.Error()
+
+		if statusErr := r.updateClusterNexusStatus(ctx, clusterNexus, oldStatus); statusErr != nil {
+			return reconcile.Result{}, statusErr
+		}
+
+		return reconcile.Result{RequeueAfter: defaultRequeueTime}, fmt.Errorf("failed to get nexus status client: %w", err)
+	}
+
+	if err = chain.NewCheckHealth(statusClient).ServeRequest(ctx, &clusterNexus.Status); err != nil {
+		clusterNexus.Status.Error = err.Error()
+
+		if statusErr := r.updateClusterNexusStatus(ctx, clusterNexus, oldStatus); statusErr != nil {
+			return reconcile.Result{}, statusErr
+		}
+
+		return reconcile.Result{RequeueAfter: defaultRequeueTime}, fmt.Errorf("failed to check nexus health: %w", err)
+	}
+
+	clusterNexus.Status.Error = ""
+
+	if err = r.updateClusterNexusStatus(ctx, clusterNexus, oldStatus); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Reconciling ClusterNexus is finished")
+
+	return reconcile.Result{
+		RequeueAfter: successRequeueTime,
+	}, nil
+}
+
+func (r *ClusterNexusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&nexusApi.ClusterNexus{}).
+		Complete(r); err != nil {
+		return fmt.Errorf("failed to create controller manager: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ClusterNexusReconciler) updateClusterNexusStatus(
+	ctx context.Context,
+	clusterNexus *nexusApi.ClusterNexus,
+	oldStatus *nexusApi.NexusStatus,
+) error {
+	if equality.Semantic.DeepEqual(&clusterNexus.Status, oldStatus) {
+		return nil
+	}
+
+	if err := r.client.Status().Update(ctx, clusterNexus); err != nil {
+		return fmt.Errorf("failed to update ClusterNexus status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package clusternexus
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+var _ = Describe("ClusterNexus controller", func() {
+	const (
+		clusterNexusName = "test-cluster-nexus"
+
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+	)
+
+	It("Should create ClusterNexus object with secret auth", func() {
+		By("By creating a secret in the operator namespace")
+		secret := &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster-nexus-auth-secret",
+				Namespace: operatorNamespace,
+			},
+			Data: map[string][]byte{
+				"user":     []byte(nexusUser),
+				"password": []byte(nexusPassword),
+			},
+		}
+		Expect(k8sClient.Create(ctx, secret)).Should(Succeed())
+		By("By creating a new ClusterNexus object")
+		newClusterNexus := &nexusApi.ClusterNexus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterNexusName,
+			},
+			Spec: nexusApi.ClusterNexusSpec{
+				Url:    nexusUrl,
+				Secret: secret.Name,
+			},
+		}
+		Expect(k8sClient.Create(ctx, newClusterNexus)).Should(Succeed())
+		Eventually(func() bool {
+			createdClusterNexus := &nexusApi.ClusterNexus{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: clusterNexusName}, createdClusterNexus)
+			if err != nil {
+				return false
+			}
+
+			return createdClusterNexus.Status.Connected && createdClusterNexus.Status.Error == ""
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should fail if secret is missing", func() {
+		By("By creating a new ClusterNexus object")
+		newClusterNexus := &nexusApi.ClusterNexus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster-nexus-without-secret",
+			},
+			Spec: nexusApi.ClusterNexusSpec{
+				Url:    nexusUrl,
+				Secret: "non-existent-secret",
+			},
+		}
+		Expect(k8sClient.Create(ctx, newClusterNexus)).Should(Succeed())
+		Eventually(func(g Gomega) {
+			createdClusterNexus := &nexusApi.ClusterNexus{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: "cluster-nexus-without-secret"}, createdClusterNexus)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(createdClusterNexus.Status.Connected).Should(BeFalse(), "ClusterNexus should not be connected")
+			g.Expect(createdClusterNexus.Status.Error).Should(ContainSubstring("failed to get nexus secret"))
+		}).WithPolling(time.Second).WithTimeout(timeout).Should(Succeed())
+	})
+})
@@ -0,0 +1,109 @@
+package clusternexus
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	nexusclient "github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/helper"
+	"github.com/epam/edp-nexus-operator/pkg/testutils"
+)
+
+const operatorNamespace = "default"
+
+var (
+	cfg           *rest.Config
+	k8sClient     client.Client
+	testEnv       *envtest.Environment
+	ctx           context.Context
+	cancel        context.CancelFunc
+	nexusUrl      string
+	nexusUser     string
+	nexusPassword string
+)
+
+func TestClusterNexus(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	if os.Getenv("TEST_NEXUS_URL") == "" {
+		t.Skip("TEST_NEXUS_URL is not set")
+	}
+
+	RunSpecs(t, "ClusterNexus Controller Suite")
+}
+
+var _ = BeforeSuite(func() {
+	logf.SetLogger(zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)))
+
+	ctx, cancel = context.WithCancel(context.Background())
+	nexusUrl = os.Getenv("TEST_NEXUS_URL")
+	nexusUser = os.Getenv("TEST_NEXUS_USER")
+	nexusPassword = os.Getenv("TEST_NEXUS_PASSWORD")
+
+	Expect(os.Setenv(helper.OperatorNamespaceEnvVar, operatorNamespace)).To(Succeed())
+
+	By("bootstrapping test environment")
+	testEnv = &envtest.Environment{
+		CRDDirectoryPaths:     testutils.GetCRDDirectoryPaths(),
+		ErrorIfCRDPathMissing: true,
+		BinaryAssetsDirectory: testutils.GetFirstFoundEnvTestBinaryDir(),
+	}
+
+	var err error
+	cfg, err = testEnv.Start()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cfg).NotTo(BeNil())
+
+	scheme := runtime.NewScheme()
+	Expect(nexusApi.AddToScheme(scheme)).NotTo(HaveOccurred())
+	Expect(corev1.AddToScheme(scheme)).NotTo(HaveOccurred())
+
+	k8sClient, err = client.New(cfg, client.Options{Scheme: scheme})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(k8sClient).NotTo(BeNil())
+
+	k8sManager, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme: scheme,
+		Metrics: metricsserver.Options{
+			BindAddress: "0",
+		},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	err = NewClusterNexusReconciler(
+		k8sManager.GetClient(),
+		k8sManager.GetScheme(),
+		nexusclient.NewApiClientProvider(k8sManager.GetClient()),
+	).
+		SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	go func() {
+		defer GinkgoRecover()
+		err = k8sManager.Start(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to run manager")
+	}()
+
+})
+
+var _ = AfterSuite(func() {
+	cancel()
+	By("tearing down the test environment")
+	err := testEnv.Stop()
+	Expect(err).NotTo(HaveOccurred())
+})
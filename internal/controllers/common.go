@@ -2,18 +2,73 @@ package controllers
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"time"
 
 	"github.com/datadrivers/go-nexus-client/nexus3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/epam/edp-nexus-operator/api/common"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
 const (
 	NexusOperatorFinalizer = "edp.epam.com/finalizer"
 	ErrorRequeueTime       = time.Second * 30
+	// TransientErrorRequeueTime is used when nexus is temporarily unavailable.
+	TransientErrorRequeueTime = time.Second * 5
+	// UnauthorizedRequeueTime is used when nexus rejects the credentials.
+	// It requires fixing the credentials, so there is no need to requeue often.
+	UnauthorizedRequeueTime = time.Minute * 5
 )
 
 type ApiClientProvider interface {
 	GetNexusApiClientFromNexusRef(ctx context.Context, namespace string, ref common.HasNexusRef) (*nexus3.NexusClient, error)
 }
+
+// RequeueOnError returns the reconciliation result for the error returned by nexus.
+// Transient errors are requeued quickly, authorization errors are requeued slowly.
+// Validation errors are not requeued because they can be fixed only by changing the resource,
+// which triggers the reconciliation anyway.
+func RequeueOnError(err error) ctrl.Result {
+	switch {
+	case nexus.IsErrPermanent(err):
+		return ctrl.Result{}
+	case nexus.IsErrTransient(err):
+		return ctrl.Result{RequeueAfter: TransientErrorRequeueTime}
+	case errors.Is(err, nexus.ErrUnauthorized):
+		return ctrl.Result{RequeueAfter: UnauthorizedRequeueTime}
+	default:
+		return ctrl.Result{RequeueAfter: ErrorRequeueTime}
+	}
+}
+
+// ToMetaTime converts the time returned by nexus to the status time.
+// The time is truncated to seconds as it is stored in the status to avoid redundant status updates.
+func ToMetaTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+
+	mt := metav1.NewTime(t.Truncate(time.Second))
+
+	return &mt
+}
+
+// EqualUnordered checks that the lists contain the same elements regardless of their order.
+// Nexus doesn't keep the order of roles and privileges, so they are compared as sets.
+func EqualUnordered(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = slices.Clone(a)
+	b = slices.Clone(b)
+
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
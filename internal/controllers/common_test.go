@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+func TestRequeueOnError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want ctrl.Result
+	}{
+		{
+			name: "validation error is not requeued",
+			err:  fmt.Errorf("failed to create role: %w", nexus.NewAPIError(http.StatusBadRequest, nil)),
+			want: ctrl.Result{},
+		},
+		{
+			name: "server error is requeued quickly",
+			err:  nexus.NewAPIError(http.StatusServiceUnavailable, nil),
+			want: ctrl.Result{RequeueAfter: TransientErrorRequeueTime},
+		},
+		{
+			name: "transport error is requeued quickly",
+			err:  &nexus.TransportError{Err: errors.New("connection refused")},
+			want: ctrl.Result{RequeueAfter: TransientErrorRequeueTime},
+		},
+		{
+			name: "unauthorized error is requeued slowly",
+			err:  nexus.NewAPIError(http.StatusForbidden, nil),
+			want: ctrl.Result{RequeueAfter: UnauthorizedRequeueTime},
+		},
+		{
+			name: "unknown error",
+			err:  errors.New("some error"),
+			want: ctrl.Result{RequeueAfter: ErrorRequeueTime},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, RequeueOnError(tt.err))
+		})
+	}
+}
+
+func TestToMetaTime(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, ToMetaTime(nil))
+
+	nexusTime := time.Date(2026, 1, 2, 3, 4, 5, 678000000, time.UTC)
+
+	got := ToMetaTime(&nexusTime)
+
+	assert.True(t, got.Time.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)))
+}
+
+func TestEqualUnordered(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want bool
+	}{
+		{
+			name: "same order",
+			a:    []string{"nx-admin", "nx-anonymous"},
+			b:    []string{"nx-admin", "nx-anonymous"},
+			want: true,
+		},
+		{
+			name: "different order",
+			a:    []string{"nx-admin", "nx-anonymous"},
+			b:    []string{"nx-anonymous", "nx-admin"},
+			want: true,
+		},
+		{
+			name: "nil and empty",
+			a:    nil,
+			b:    []string{},
+			want: true,
+		},
+		{
+			name: "different elements",
+			a:    []string{"nx-admin", "nx-anonymous"},
+			b:    []string{"nx-admin", "nx-developer"},
+			want: false,
+		},
+		{
+			name: "different length",
+			a:    []string{"nx-admin"},
+			b:    []string{"nx-admin", "nx-admin"},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := slices.Clone(tt.a)
+
+			assert.Equal(t, tt.want, EqualUnordered(tt.a, tt.b))
+			assert.Equal(t, a, tt.a, "arguments should not be changed")
+		})
+	}
+}
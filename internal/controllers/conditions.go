@@ -0,0 +1,171 @@
+package controllers
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+const (
+	// ReasonSynced is the reason of the conditions when the resource is successfully applied to nexus.
+	ReasonSynced = "Synced"
+	// ReasonSyncFailed is the reason of the conditions when nexus rejected the resource or is unavailable.
+	ReasonSyncFailed = "SyncFailed"
+	// ReasonResolved is the reason of the DependenciesResolved condition when all dependencies are available.
+	ReasonResolved = "Resolved"
+	// ReasonNexusUnavailable is the reason of the conditions when the api client for the referenced Nexus can't be created.
+	ReasonNexusUnavailable = "NexusUnavailable"
+	// ReasonDependencyNotFound is the reason of the conditions when an object the resource depends on
+	// doesn't exist in nexus yet, e.g. the routing rule of the repository.
+	ReasonDependencyNotFound = "DependencyNotFound"
+	// ReasonConnected is the reason of the ConnectionVerified condition when the operator connected to the server.
+	ReasonConnected = "Connected"
+	// ReasonConnectionFailed is the reason of the ConnectionVerified condition when the connection failed.
+	ReasonConnectionFailed = "ConnectionFailed"
+	// ReasonVerificationSkipped is the reason of the ConnectionVerified condition
+	// when the operator can't verify the connection, e.g. for unsupported authentication schemes.
+	ReasonVerificationSkipped = "VerificationSkipped"
+
+	// LastSyncedTimeUpdateInterval is the minimal interval between updates of LastSyncedTime of the same generation.
+	LastSyncedTimeUpdateInterval = time.Minute
+)
+
+// SetSynced marks the resource generation as successfully applied to nexus.
+// LastSyncedTime is updated on every successful sync when the state of the resource changes
+// or the previous time is older than LastSyncedTimeUpdateInterval,
+// so frequent reconciliations of the same generation don't update the status every time.
+func SetSynced(status *common.ReconcileStatus, generation int64) {
+	changed := status.ObservedGeneration != generation ||
+		!meta.IsStatusConditionTrue(status.Conditions, common.ConditionReady)
+
+	setStatus(status, generation, metav1.ConditionTrue, ReasonResolved, metav1.ConditionTrue, ReasonSynced, "")
+
+	if changed || status.LastSyncedTime == nil || time.Since(status.LastSyncedTime.Time) >= LastSyncedTimeUpdateInterval {
+		now := metav1.Now()
+		status.LastSyncedTime = &now
+	}
+}
+
+// SetSyncFailed marks the resource generation as failed to apply to nexus.
+func SetSyncFailed(status *common.ReconcileStatus, generation int64, err error) {
+	setStatus(
+		status,
+		generation,
+		metav1.ConditionTrue,
+		ReasonResolved,
+		metav1.ConditionFalse,
+		ReasonSyncFailed,
+		err.Error(),
+	)
+}
+
+// SetDependenciesNotResolved marks the resource as not synced because the referenced Nexus is not available.
+func SetDependenciesNotResolved(status *common.ReconcileStatus, generation int64, err error) {
+	setStatus(
+		status,
+		generation,
+		metav1.ConditionFalse,
+		ReasonNexusUnavailable,
+		metav1.ConditionFalse,
+		ReasonNexusUnavailable,
+		err.Error(),
+	)
+}
+
+// SetDependencyNotFound marks the resource as not synced because an object it depends on doesn't exist in nexus.
+func SetDependencyNotFound(status *common.ReconcileStatus, generation int64, err error) {
+	setStatus(
+		status,
+		generation,
+		metav1.ConditionFalse,
+		ReasonDependencyNotFound,
+		metav1.ConditionFalse,
+		ReasonDependencyNotFound,
+		err.Error(),
+	)
+}
+
+// SetCompleted marks the one-shot operation, like NexusTaskRun, as finished.
+// Succeeded or Failed condition is set depending on the outcome, so it can be awaited with kubectl wait.
+func SetCompleted(status *common.ReconcileStatus, generation int64, succeeded bool, reason, message string) {
+	conditionType := common.ConditionFailed
+	if succeeded {
+		conditionType = common.ConditionSucceeded
+	}
+
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
+
+// IsCompleted checks if the one-shot operation is finished.
+func IsCompleted(status *common.ReconcileStatus) bool {
+	return meta.IsStatusConditionTrue(status.Conditions, common.ConditionSucceeded) ||
+		meta.IsStatusConditionTrue(status.Conditions, common.ConditionFailed)
+}
+
+// SetConnectionVerified sets the ConnectionVerified condition from the result of the connection verification.
+func SetConnectionVerified(status *common.ReconcileStatus, generation int64, err error) {
+	condition := metav1.Condition{
+		Type:               common.ConditionConnectionVerified,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonConnected,
+		ObservedGeneration: generation,
+	}
+
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonConnectionFailed
+		condition.Message = err.Error()
+	}
+
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// SetConnectionNotVerified marks the connection as not verified by the operator.
+func SetConnectionNotVerified(status *common.ReconcileStatus, generation int64, message string) {
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               common.ConditionConnectionVerified,
+		Status:             metav1.ConditionUnknown,
+		Reason:             ReasonVerificationSkipped,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
+
+// setStatus sets the observed generation and the conditions of the resource.
+// Ready condition follows Synced condition, the message is set for the failed conditions only.
+func setStatus(
+	status *common.ReconcileStatus,
+	generation int64,
+	dependencies metav1.ConditionStatus,
+	dependenciesReason string,
+	synced metav1.ConditionStatus,
+	syncedReason string,
+	message string,
+) {
+	status.ObservedGeneration = generation
+
+	conditions := []metav1.Condition{
+		{Type: common.ConditionDependenciesResolved, Status: dependencies, Reason: dependenciesReason},
+		{Type: common.ConditionSynced, Status: synced, Reason: syncedReason},
+		{Type: common.ConditionReady, Status: synced, Reason: syncedReason},
+	}
+
+	for _, c := range conditions {
+		c.ObservedGeneration = generation
+
+		if c.Status == metav1.ConditionFalse {
+			c.Message = message
+		}
+
+		meta.SetStatusCondition(&status.Conditions, c)
+	}
+}
@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+func TestSetSynced(t *testing.T) {
+	t.Parallel()
+
+	status := &common.ReconcileStatus{}
+
+	SetSyncFailed(status, 1, errors.New("validation failed"))
+
+	assert.Equal(t, int64(1), status.ObservedGeneration)
+	assert.Nil(t, status.LastSyncedTime)
+	assert.True(t, meta.IsStatusConditionTrue(status.Conditions, common.ConditionDependenciesResolved))
+
+	ready := meta.FindStatusCondition(status.Conditions, common.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, ReasonSyncFailed, ready.Reason)
+	assert.Equal(t, "validation failed", ready.Message)
+
+	SetSynced(status, 2)
+
+	assert.Equal(t, int64(2), status.ObservedGeneration)
+	require.NotNil(t, status.LastSyncedTime)
+
+	for _, conditionType := range []string{
+		common.ConditionReady,
+		common.ConditionSynced,
+		common.ConditionDependenciesResolved,
+	} {
+		c := meta.FindStatusCondition(status.Conditions, conditionType)
+		require.NotNil(t, c, conditionType)
+		assert.Equal(t, metav1.ConditionTrue, c.Status, conditionType)
+		assert.Equal(t, int64(2), c.ObservedGeneration, conditionType)
+		assert.Empty(t, c.Message, conditionType)
+	}
+
+	syncedTime := status.LastSyncedTime.DeepCopy()
+	syncedTime.Time = syncedTime.Add(-1)
+	status.LastSyncedTime = syncedTime.DeepCopy()
+
+	SetSynced(status, 2)
+
+	assert.Equal(t, syncedTime, status.LastSyncedTime,
+		"LastSyncedTime should not change for the same generation within the update interval")
+
+	staleTime := metav1.NewTime(time.Now().Add(-LastSyncedTimeUpdateInterval))
+	status.LastSyncedTime = staleTime.DeepCopy()
+
+	SetSynced(status, 2)
+
+	require.NotNil(t, status.LastSyncedTime)
+	assert.True(t, status.LastSyncedTime.After(staleTime.Time),
+		"LastSyncedTime should be updated when it is older than the update interval")
+}
+
+func TestSetDependenciesNotResolved(t *testing.T) {
+	t.Parallel()
+
+	status := &common.ReconcileStatus{}
+
+	SetSynced(status, 1)
+	SetDependenciesNotResolved(status, 1, errors.New("nexus not found"))
+
+	for _, conditionType := range []string{
+		common.ConditionReady,
+		common.ConditionSynced,
+		common.ConditionDependenciesResolved,
+	} {
+		c := meta.FindStatusCondition(status.Conditions, conditionType)
+		require.NotNil(t, c, conditionType)
+		assert.Equal(t, metav1.ConditionFalse, c.Status, conditionType)
+		assert.Equal(t, ReasonNexusUnavailable, c.Reason, conditionType)
+		assert.Equal(t, "nexus not found", c.Message, conditionType)
+	}
+}
+
+func TestSetDependencyNotFound(t *testing.T) {
+	t.Parallel()
+
+	status := &common.ReconcileStatus{}
+
+	SetSynced(status, 1)
+	SetDependencyNotFound(status, 2, errors.New("routing rule doesn't exist in nexus"))
+
+	assert.Equal(t, int64(2), status.ObservedGeneration)
+
+	for _, conditionType := range []string{
+		common.ConditionReady,
+		common.ConditionSynced,
+		common.ConditionDependenciesResolved,
+	} {
+		c := meta.FindStatusCondition(status.Conditions, conditionType)
+		require.NotNil(t, c, conditionType)
+		assert.Equal(t, metav1.ConditionFalse, c.Status, conditionType)
+		assert.Equal(t, ReasonDependencyNotFound, c.Reason, conditionType)
+		assert.Equal(t, "routing rule doesn't exist in nexus", c.Message, conditionType)
+	}
+}
+
+func TestSetCompleted(t *testing.T) {
+	t.Parallel()
+
+	status := &common.ReconcileStatus{}
+
+	assert.False(t, IsCompleted(status))
+
+	SetSynced(status, 1)
+	assert.False(t, IsCompleted(status))
+
+	SetCompleted(status, 1, false, "FAILED", "task failed")
+
+	assert.True(t, IsCompleted(status))
+	assert.Nil(t, meta.FindStatusCondition(status.Conditions, common.ConditionSucceeded))
+
+	failed := meta.FindStatusCondition(status.Conditions, common.ConditionFailed)
+	require.NotNil(t, failed)
+	assert.Equal(t, metav1.ConditionTrue, failed.Status)
+	assert.Equal(t, "FAILED", failed.Reason)
+	assert.Equal(t, "task failed", failed.Message)
+
+	status = &common.ReconcileStatus{}
+
+	SetCompleted(status, 1, true, "OK", "")
+
+	assert.True(t, IsCompleted(status))
+	assert.True(t, meta.IsStatusConditionTrue(status.Conditions, common.ConditionSucceeded))
+}
+
+func TestSetConnectionVerified(t *testing.T) {
+	t.Parallel()
+
+	status := &common.ReconcileStatus{}
+
+	SetSynced(status, 1)
+	SetConnectionVerified(status, 1, errors.New("connection refused"))
+
+	verified := meta.FindStatusCondition(status.Conditions, common.ConditionConnectionVerified)
+	require.NotNil(t, verified)
+	assert.Equal(t, metav1.ConditionFalse, verified.Status)
+	assert.Equal(t, ReasonConnectionFailed, verified.Reason)
+	assert.Equal(t, "connection refused", verified.Message)
+	assert.True(t, meta.IsStatusConditionTrue(status.Conditions, common.ConditionReady))
+
+	SetConnectionVerified(status, 1, nil)
+	assert.True(t, meta.IsStatusConditionTrue(status.Conditions, common.ConditionConnectionVerified))
+
+	SetConnectionNotVerified(status, 2, "not supported")
+
+	verified = meta.FindStatusCondition(status.Conditions, common.ConditionConnectionVerified)
+	require.NotNil(t, verified)
+	assert.Equal(t, metav1.ConditionUnknown, verified.Status)
+	assert.Equal(t, ReasonVerificationSkipped, verified.Reason)
+	assert.Equal(t, int64(2), verified.ObservedGeneration)
+}
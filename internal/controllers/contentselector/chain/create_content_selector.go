@@ -0,0 +1,104 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+const contentSelectorKind = "NexusContentSelector"
+
+// CreateContentSelector is a handler for creating content selector.
+type CreateContentSelector struct {
+	nexusContentSelectorApiClient nexus.ContentSelector
+	ownership                     *controllers.OwnershipRecord
+}
+
+// NewCreateContentSelector creates an instance of CreateContentSelector handler.
+func NewCreateContentSelector(
+	nexusContentSelectorApiClient nexus.ContentSelector,
+	ownership *controllers.OwnershipRecord,
+) *CreateContentSelector {
+	return &CreateContentSelector{nexusContentSelectorApiClient: nexusContentSelectorApiClient, ownership: ownership}
+}
+
+// ServeRequest implements the logic of creating content selector.
+func (c CreateContentSelector) ServeRequest(ctx context.Context, contentSelector *nexusApi.NexusContentSelector) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", contentSelector.Spec.Name)
+	log.Info("Start creating content selector")
+
+	// go-nexus-client returns nil without error if the content selector doesn't exist
+	nexusContentSelector, err := c.nexusContentSelectorApiClient.Get(contentSelector.Spec.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get content selector: %w", err)
+	}
+
+	exists := nexusContentSelector != nil
+
+	manage, err := c.ownership.Acquire(
+		ctx,
+		contentSelector,
+		contentSelector.Spec.ManagementPolicy,
+		contentSelector.Spec.Name,
+		exists,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !manage {
+		return nil
+	}
+
+	if !exists {
+		if !controllers.ReportDrift(ctx, contentSelectorKind, contentSelector, &contentSelector.Status.ReconcileStatus,
+			"Content selector was deleted in nexus, recreating it", "name", contentSelector.Spec.Name) {
+			log.Info("Content selector doesn't exist, creating new one")
+		}
+
+		if err = c.nexusContentSelectorApiClient.Create(specToContentSelector(&contentSelector.Spec)); err != nil {
+			return fmt.Errorf("failed to create content selector: %w", err)
+		}
+
+		log.Info("Content selector has been created")
+
+		return nil
+	}
+
+	if contentSelectorChanged(&contentSelector.Spec, nexusContentSelector) {
+		controllers.ReportDrift(ctx, contentSelectorKind, contentSelector, &contentSelector.Status.ReconcileStatus,
+			"Content selector was changed in nexus, reverting changes", "name", contentSelector.Spec.Name)
+
+		log.Info("Updating content selector")
+
+		if err = c.nexusContentSelectorApiClient.Update(
+			contentSelector.Spec.Name,
+			specToContentSelector(&contentSelector.Spec),
+		); err != nil {
+			return fmt.Errorf("failed to update content selector: %w", err)
+		}
+
+		log.Info("Content selector has been updated")
+	}
+
+	return nil
+}
+
+func contentSelectorChanged(spec *nexusApi.NexusContentSelectorSpec, nexusContentSelector *security.ContentSelector) bool {
+	return spec.Description != nexusContentSelector.Description ||
+		spec.Expression != nexusContentSelector.Expression
+}
+
+func specToContentSelector(spec *nexusApi.NexusContentSelectorSpec) security.ContentSelector {
+	return security.ContentSelector{
+		Name:        spec.Name,
+		Description: spec.Description,
+		Expression:  spec.Expression,
+	}
+}
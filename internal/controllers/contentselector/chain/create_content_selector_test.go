@@ -0,0 +1,161 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers/testutils"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestCreateContentSelector_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	contentSelector := func(expression string) *nexusApi.NexusContentSelector {
+		return &nexusApi.NexusContentSelector{
+			Spec: nexusApi.NexusContentSelectorSpec{
+				Name:        "team-a-artifacts",
+				Description: "Artifacts of team A",
+				Expression:  expression,
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		contentSelector *nexusApi.NexusContentSelector
+		nexusApiClient  func(t *testing.T) nexus.ContentSelector
+		wantErr         require.ErrorAssertionFunc
+	}{
+		{
+			name:            "content selector doesn't exist, creating new one",
+			contentSelector: contentSelector(`path =^ "/com/team-a/"`),
+			nexusApiClient: func(t *testing.T) nexus.ContentSelector {
+				m := mocks.NewMockContentSelector(t)
+
+				m.On("Get", "team-a-artifacts").Return(nil, nil)
+
+				m.On("Create", security.ContentSelector{
+					Name:        "team-a-artifacts",
+					Description: "Artifacts of team A",
+					Expression:  `path =^ "/com/team-a/"`,
+				}).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:            "content selector exists, updating it",
+			contentSelector: contentSelector(`path =^ "/com/team-a/" and format == "maven2"`),
+			nexusApiClient: func(t *testing.T) nexus.ContentSelector {
+				m := mocks.NewMockContentSelector(t)
+
+				m.On("Get", "team-a-artifacts").Return(&security.ContentSelector{
+					Name:        "team-a-artifacts",
+					Description: "Artifacts of team A",
+					Expression:  `path =^ "/com/team-a/"`,
+				}, nil)
+
+				m.On("Update", "team-a-artifacts", security.ContentSelector{
+					Name:        "team-a-artifacts",
+					Description: "Artifacts of team A",
+					Expression:  `path =^ "/com/team-a/" and format == "maven2"`,
+				}).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:            "content selector is up to date",
+			contentSelector: contentSelector(`path =^ "/com/team-a/"`),
+			nexusApiClient: func(t *testing.T) nexus.ContentSelector {
+				m := mocks.NewMockContentSelector(t)
+
+				m.On("Get", "team-a-artifacts").Return(&security.ContentSelector{
+					Name:        "team-a-artifacts",
+					Description: "Artifacts of team A",
+					Expression:  `path =^ "/com/team-a/"`,
+				}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:            "failed to get content selector",
+			contentSelector: contentSelector(`path =^ "/com/team-a/"`),
+			nexusApiClient: func(t *testing.T) nexus.ContentSelector {
+				m := mocks.NewMockContentSelector(t)
+
+				m.On("Get", "team-a-artifacts").Return(nil, errors.New("connection refused"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to get content selector")
+			},
+		},
+		{
+			name:            "failed to create content selector",
+			contentSelector: contentSelector(`path =^ "/com/team-a/"`),
+			nexusApiClient: func(t *testing.T) nexus.ContentSelector {
+				m := mocks.NewMockContentSelector(t)
+
+				m.On("Get", "team-a-artifacts").Return(nil, nil)
+				m.On("Create", security.ContentSelector{
+					Name:        "team-a-artifacts",
+					Description: "Artifacts of team A",
+					Expression:  `path =^ "/com/team-a/"`,
+				}).Return(errors.New("invalid expression"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to create content selector")
+			},
+		},
+		{
+			name:            "failed to update content selector",
+			contentSelector: contentSelector(`format == "npm"`),
+			nexusApiClient: func(t *testing.T) nexus.ContentSelector {
+				m := mocks.NewMockContentSelector(t)
+
+				m.On("Get", "team-a-artifacts").Return(&security.ContentSelector{
+					Name:       "team-a-artifacts",
+					Expression: `format == "maven2"`,
+				}, nil)
+				m.On("Update", "team-a-artifacts", security.ContentSelector{
+					Name:        "team-a-artifacts",
+					Description: "Artifacts of team A",
+					Expression:  `format == "npm"`,
+				}).Return(errors.New("internal error"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to update content selector")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewCreateContentSelector(tt.nexusApiClient(t), testutils.NewOwnershipRecord(t))
+			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.contentSelector)
+
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+// RemoveContentSelector is a handler for removing content selector.
+type RemoveContentSelector struct {
+	nexusContentSelectorApiClient nexus.ContentSelector
+}
+
+// NewRemoveContentSelector creates an instance of RemoveContentSelector handler.
+func NewRemoveContentSelector(nexusContentSelectorApiClient nexus.ContentSelector) *RemoveContentSelector {
+	return &RemoveContentSelector{nexusContentSelectorApiClient: nexusContentSelectorApiClient}
+}
+
+// ServeRequest implements the logic of removing content selector.
+func (c RemoveContentSelector) ServeRequest(ctx context.Context, contentSelector *nexusApi.NexusContentSelector) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", contentSelector.Spec.Name)
+	log.Info("Start removing content selector")
+
+	if err := c.nexusContentSelectorApiClient.Delete(contentSelector.Spec.Name); err != nil {
+		if !nexus.IsErrNotFound(err) {
+			return fmt.Errorf("failed to delete content selector: %w", err)
+		}
+
+		log.Info("Content selector doesn't exist, skipping removal")
+	}
+
+	log.Info("Content selector has been removed")
+
+	return nil
+}
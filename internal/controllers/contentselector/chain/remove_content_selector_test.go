@@ -0,0 +1,92 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestRemoveContentSelector_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		contentSelector *nexusApi.NexusContentSelector
+		nexusApiClient  func(t *testing.T) nexus.ContentSelector
+		wantErr         require.ErrorAssertionFunc
+	}{
+		{
+			name: "removing content selector successfully",
+			contentSelector: &nexusApi.NexusContentSelector{
+				Spec: nexusApi.NexusContentSelectorSpec{
+					Name: "content-selector-name",
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.ContentSelector {
+				m := mocks.NewMockContentSelector(t)
+
+				m.On("Delete", "content-selector-name").
+					Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "content selector doesn't exist, skipping removal",
+			contentSelector: &nexusApi.NexusContentSelector{
+				Spec: nexusApi.NexusContentSelectorSpec{
+					Name: "content-selector-name",
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.ContentSelector {
+				m := mocks.NewMockContentSelector(t)
+
+				m.On("Delete", "content-selector-name").
+					Return(nexus.ErrNotFound)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "failed to remove content selector",
+			contentSelector: &nexusApi.NexusContentSelector{
+				Spec: nexusApi.NexusContentSelectorSpec{
+					Name: "content-selector-name",
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.ContentSelector {
+				m := mocks.NewMockContentSelector(t)
+
+				m.On("Delete", "content-selector-name").
+					Return(errors.New("failed to remove content selector"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to remove content selector")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewRemoveContentSelector(tt.nexusApiClient(t))
+			err := c.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.contentSelector)
+
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package contentselector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/internal/controllers/contentselector/chain"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+// NexusContentSelectorReconciler reconciles a NexusContentSelector object.
+type NexusContentSelectorReconciler struct {
+	client            client.Client
+	scheme            *runtime.Scheme
+	apiClientProvider controllers.ApiClientProvider
+	resyncInterval    time.Duration
+	recorder          record.EventRecorder
+}
+
+func NewNexusContentSelectorReconciler(
+	k8sClient client.Client,
+	scheme *runtime.Scheme,
+	apiClientProvider controllers.ApiClientProvider,
+	resyncInterval time.Duration,
+) *NexusContentSelectorReconciler {
+	return &NexusContentSelectorReconciler{
+		client:            k8sClient,
+		scheme:            scheme,
+		apiClientProvider: apiClientProvider,
+		resyncInterval:    resyncInterval,
+	}
+}
+
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexuscontentselectors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexuscontentselectors/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexuscontentselectors/finalizers,verbs=update
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusprivileges,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *NexusContentSelectorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling NexusContentSelector")
+
+	contentSelector := &nexusApi.NexusContentSelector{}
+	if err := r.client.Get(ctx, req.NamespacedName, contentSelector); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get NexusContentSelector: %w", err)
+	}
+
+	oldStatus := contentSelector.Status.DeepCopy()
+
+	ownership := controllers.NewOwnershipRecord(r.client, contentSelector.Namespace, contentSelector.Spec.NexusRef)
+
+	if contentSelector.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(contentSelector, controllers.NexusOperatorFinalizer) {
+			return r.deleteContentSelector(ctx, contentSelector, ownership, oldStatus)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, contentSelector.Namespace, contentSelector)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&contentSelector.Status.ReconcileStatus, contentSelector.Generation, err)
+
+		if statusErr := r.updateNexusContentSelectorStatus(ctx, contentSelector, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
+	contentSelectorApiClient := nexusApiClient.Security.ContentSelector
+
+	if controllerutil.AddFinalizer(contentSelector, controllers.NexusOperatorFinalizer) {
+		err = r.client.Update(ctx, contentSelector)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update NexusContentSelector: %w", err)
+		}
+	}
+
+	if err = chain.NewCreateContentSelector(contentSelectorApiClient, ownership).
+		ServeRequest(ctx, contentSelector); err != nil {
+		log.Error(err, "An error has occurred while handling NexusContentSelector")
+
+		contentSelector.Status.Value = common.StatusError
+		contentSelector.Status.Error = err.Error()
+		controllers.SetSyncFailed(&contentSelector.Status.ReconcileStatus, contentSelector.Generation, err)
+
+		if statusErr := r.updateNexusContentSelectorStatus(ctx, contentSelector, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return controllers.RequeueOnError(err), nil
+	}
+
+	contentSelector.Status.Value = common.StatusCreated
+	contentSelector.Status.Error = ""
+	controllers.SetSynced(&contentSelector.Status.ReconcileStatus, contentSelector.Generation)
+
+	if err = r.updateNexusContentSelectorStatus(ctx, contentSelector, oldStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return controllers.ResyncResult(ctx, contentSelector, r.resyncInterval), nil
+}
+
+// deleteContentSelector removes the content selector from nexus and removes the finalizer.
+// The nexus client is only required when the content selector is actually deleted from nexus.
+// The deletion is blocked while privileges in nexus still use the content selector,
+// because nexus doesn't allow to delete content selectors used by privileges.
+func (r *NexusContentSelectorReconciler) deleteContentSelector(
+	ctx context.Context,
+	contentSelector *nexusApi.NexusContentSelector,
+	ownership *controllers.OwnershipRecord,
+	oldStatus *nexusApi.NexusContentSelectorStatus,
+) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	deleteFromNexus, err := ownership.CanDelete(
+		ctx,
+		contentSelector,
+		contentSelector.Spec.ManagementPolicy,
+		contentSelector.Spec.Name,
+	)
+	if err != nil {
+		log.Error(err, "An error has occurred while checking ownership of NexusContentSelector")
+
+		return controllers.RequeueOnError(err), nil
+	}
+
+	if deleteFromNexus && controllers.ShouldDeleteFromNexus(ctx, r.recorder, contentSelector, contentSelector.Spec.DeletionPolicy) {
+		nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, contentSelector.Namespace, contentSelector)
+		if err != nil {
+			log.Error(err, "An error has occurred while getting nexus api client")
+
+			controllers.SetDependenciesNotResolved(&contentSelector.Status.ReconcileStatus, contentSelector.Generation, err)
+
+			if statusErr := r.updateNexusContentSelectorStatus(ctx, contentSelector, oldStatus); statusErr != nil {
+				return ctrl.Result{}, statusErr
+			}
+
+			return ctrl.Result{
+				RequeueAfter: controllers.ErrorRequeueTime,
+			}, nil
+		}
+
+		// Privileges are checked in nexus, because they can be managed by NexusPrivileges
+		// from other namespaces when the content selector belongs to ClusterNexus, or created manually.
+		privileges, err := nexus.NewPrivilegeClient(nexusApiClient.Security.Privilege).
+			ListByContentSelector(contentSelector.Spec.Name)
+		if err != nil {
+			log.Error(err, "An error has occurred while getting privileges using NexusContentSelector")
+
+			return controllers.RequeueOnError(err), nil
+		}
+
+		if len(privileges) > 0 {
+			err = fmt.Errorf("content selector is used by privileges %s", strings.Join(privileges, ", "))
+			log.Info("Deletion of NexusContentSelector is blocked", "reason", err.Error())
+
+			r.recorder.Event(contentSelector, corev1.EventTypeWarning, controllers.ReasonDeletionBlocked, err.Error())
+
+			contentSelector.Status.Value = common.StatusError
+			contentSelector.Status.Error = err.Error()
+			controllers.SetSyncFailed(&contentSelector.Status.ReconcileStatus, contentSelector.Generation, err)
+
+			if statusErr := r.updateNexusContentSelectorStatus(ctx, contentSelector, oldStatus); statusErr != nil {
+				return ctrl.Result{}, statusErr
+			}
+
+			return ctrl.Result{RequeueAfter: controllers.ErrorRequeueTime}, nil
+		}
+
+		if err = chain.NewRemoveContentSelector(nexusApiClient.Security.ContentSelector).ServeRequest(ctx, contentSelector); err != nil {
+			log.Error(err, "An error has occurred while deleting NexusContentSelector")
+
+			return controllers.RequeueOnError(err), nil
+		}
+	}
+
+	if err = ownership.Release(ctx, contentSelector, contentSelector.Spec.Name); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(contentSelector, controllers.NexusOperatorFinalizer)
+
+	if err = r.client.Update(ctx, contentSelector); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update NexusContentSelector: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// mapPrivilegeToNexusContentSelector returns the deleted NexusContentSelector used by the privilege,
+// so its deletion is retried as soon as the privilege stops using it.
+func (r *NexusContentSelectorReconciler) mapPrivilegeToNexusContentSelector(
+	ctx context.Context,
+	obj client.Object,
+) []reconcile.Request {
+	privilege, ok := obj.(*nexusApi.NexusPrivilege)
+	if !ok || privilege.Spec.ContentSelector == "" {
+		return nil
+	}
+
+	log := ctrl.LoggerFrom(ctx).WithValues("privilege", privilege.Name)
+	contentSelectorList := &nexusApi.NexusContentSelectorList{}
+
+	if err := r.client.List(ctx, contentSelectorList, client.InNamespace(privilege.Namespace)); err != nil {
+		log.Error(err, "Failed to get NexusContentSelector list")
+
+		return nil
+	}
+
+	var requests []reconcile.Request
+
+	for i := range contentSelectorList.Items {
+		contentSelector := &contentSelectorList.Items[i]
+
+		if contentSelector.GetDeletionTimestamp() != nil &&
+			contentSelector.Spec.Name == privilege.Spec.ContentSelector &&
+			sameNexus(contentSelector.Spec.NexusRef, privilege.Spec.NexusRef) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(contentSelector)})
+		}
+	}
+
+	return requests
+}
+
+func sameNexus(a, b common.NexusRef) bool {
+	kind := func(ref common.NexusRef) string {
+		if ref.Kind == "" {
+			return common.NexusKind
+		}
+
+		return ref.Kind
+	}
+
+	return a.Name == b.Name && kind(a) == kind(b)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NexusContentSelectorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor(controllers.EventRecorderName)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&nexusApi.NexusContentSelector{}).
+		Watches(
+			// Watch for NexusPrivileges to continue deletion of the content selector they stop using.
+			&nexusApi.NexusPrivilege{},
+			handler.EnqueueRequestsFromMapFunc(r.mapPrivilegeToNexusContentSelector),
+		).
+		Complete(r)
+	if err != nil {
+		return fmt.Errorf("failed to create controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *NexusContentSelectorReconciler) updateNexusContentSelectorStatus(
+	ctx context.Context,
+	contentSelector *nexusApi.NexusContentSelector,
+	oldStatus *nexusApi.NexusContentSelectorStatus,
+) error {
+	if equality.Semantic.DeepEqual(&contentSelector.Status, oldStatus) {
+		return nil
+	}
+
+	if err := r.client.Status().Update(ctx, contentSelector); err != nil {
+		return fmt.Errorf("failed to update NexusContentSelector status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package contentselector
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+var _ = Describe("NexusContentSelector controller", func() {
+	nexusContentSelectorCRName := "nexus-content-selector"
+	nexusPrivilegeCRName := "nexus-content-selector-privilege"
+	It("Should create NexusContentSelector object", func() {
+		By("By creating a new NexusContentSelector object")
+		newNexusContentSelector := &nexusApi.NexusContentSelector{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nexusContentSelectorCRName,
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusContentSelectorSpec{
+				Name:        "test-content-selector",
+				Description: "test content selector",
+				Expression:  `path =^ "/com/test/"`,
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusContentSelector)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusContentSelector := &nexusApi.NexusContentSelector{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusContentSelectorCRName, Namespace: namespace}, createdNexusContentSelector)
+			if err != nil {
+				return false
+			}
+
+			return createdNexusContentSelector.Status.Value == common.StatusCreated && createdNexusContentSelector.Status.Error == ""
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should update NexusContentSelector object", func() {
+		By("Getting NexusContentSelector object")
+		createdNexusContentSelector := &nexusApi.NexusContentSelector{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nexusContentSelectorCRName, Namespace: namespace}, createdNexusContentSelector)).
+			Should(Succeed())
+
+		By("Updating NexusContentSelector object")
+		createdNexusContentSelector.Spec.Description = "updated description"
+		createdNexusContentSelector.Spec.Expression = `path =^ "/com/test/" and format == "maven2"`
+
+		Expect(k8sClient.Update(ctx, createdNexusContentSelector)).Should(Succeed())
+		Consistently(func() bool {
+			createdNexusContentSelector := &nexusApi.NexusContentSelector{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusContentSelectorCRName, Namespace: namespace}, createdNexusContentSelector)
+			if err != nil {
+				return false
+			}
+
+			return createdNexusContentSelector.Status.Value == common.StatusCreated && createdNexusContentSelector.Status.Error == ""
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should block deletion of NexusContentSelector used by NexusPrivilege", func() {
+		By("Creating NexusPrivilege object that uses the content selector")
+		newNexusPrivilege := &nexusApi.NexusPrivilege{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nexusPrivilegeCRName,
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusPrivilegeSpec{
+				Name:            "test-content-selector-privilege",
+				Type:            nexusApi.PrivilegeTypeRepositoryContentSelector,
+				Actions:         []string{"READ"},
+				Format:          "maven2",
+				Repository:      "maven-releases",
+				ContentSelector: "test-content-selector",
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusPrivilege)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusPrivilege := &nexusApi.NexusPrivilege{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusPrivilegeCRName, Namespace: namespace}, createdNexusPrivilege)
+			if err != nil {
+				return false
+			}
+
+			return createdNexusPrivilege.Status.Value == common.StatusCreated
+		}, timeout, interval).Should(BeTrue())
+
+		By("Deleting NexusContentSelector object")
+		createdNexusContentSelector := &nexusApi.NexusContentSelector{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nexusContentSelectorCRName, Namespace: namespace}, createdNexusContentSelector)).
+			Should(Succeed())
+		Expect(k8sClient.Delete(ctx, createdNexusContentSelector)).Should(Succeed())
+		Eventually(func(g Gomega) {
+			deletedNexusContentSelector := &nexusApi.NexusContentSelector{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusContentSelectorCRName, Namespace: namespace}, deletedNexusContentSelector)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(deletedNexusContentSelector.Status.Value).Should(Equal(common.StatusError))
+			g.Expect(deletedNexusContentSelector.Status.Error).Should(ContainSubstring("test-content-selector-privilege"))
+		}).WithPolling(time.Second).WithTimeout(timeout).Should(Succeed())
+
+		By("Deleting NexusPrivilege object")
+		Expect(k8sClient.Delete(ctx, newNexusPrivilege)).Should(Succeed())
+		Eventually(func() bool {
+			deletedNexusContentSelector := &nexusApi.NexusContentSelector{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusContentSelectorCRName, Namespace: namespace}, deletedNexusContentSelector)
+			return k8sErrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+})
@@ -0,0 +1,44 @@
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+const (
+	// EventRecorderName is the name of the component that records events of the operator.
+	EventRecorderName = "nexus-operator"
+	// ReasonOrphaned is the reason of the event recorded when the object is left in nexus.
+	ReasonOrphaned = "Orphaned"
+	// ReasonDeletionBlocked is the reason of the event recorded when the object can't be deleted from nexus
+	// because other resources still use it.
+	ReasonDeletionBlocked = "DeletionBlocked"
+)
+
+// ShouldDeleteFromNexus checks the deletion policy of the deleted resource.
+// If the policy is Orphan, it records an event that the object is left in nexus and returns false.
+// It doesn't need nexus, so reconcilers build the nexus client only when it returns true,
+// and orphaned resources can be deleted even if the referenced Nexus no longer exists.
+func ShouldDeleteFromNexus(
+	ctx context.Context,
+	recorder record.EventRecorder,
+	obj runtime.Object,
+	policy common.DeletionPolicy,
+) bool {
+	if policy != common.DeletionPolicyOrphan {
+		return true
+	}
+
+	ctrl.LoggerFrom(ctx).Info("Deletion policy is Orphan, leaving the object in nexus")
+
+	recorder.Event(obj, corev1.EventTypeNormal, ReasonOrphaned,
+		"The object was left in nexus because the deletion policy is Orphan")
+
+	return false
+}
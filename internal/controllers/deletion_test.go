@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+func TestShouldDeleteFromNexus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		policy    common.DeletionPolicy
+		want      bool
+		wantEvent bool
+	}{
+		{
+			name:   "default policy",
+			policy: "",
+			want:   true,
+		},
+		{
+			name:   "delete policy",
+			policy: common.DeletionPolicyDelete,
+			want:   true,
+		},
+		{
+			name:      "orphan policy",
+			policy:    common.DeletionPolicyOrphan,
+			want:      false,
+			wantEvent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := record.NewFakeRecorder(1)
+
+			got := ShouldDeleteFromNexus(context.Background(), recorder, &nexusApi.NexusRole{}, tt.policy)
+			assert.Equal(t, tt.want, got)
+
+			if !tt.wantEvent {
+				require.Empty(t, recorder.Events)
+
+				return
+			}
+
+			require.Len(t, recorder.Events, 1)
+			assert.Contains(t, <-recorder.Events, ReasonOrphaned)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+type ldapServerHandler interface {
+	ServeRequest(ctx context.Context, server *nexusApi.NexusLdapServer) error
+}
+
+type Chain struct {
+	handlers []ldapServerHandler
+}
+
+func (ch *Chain) Use(handlers ...ldapServerHandler) {
+	ch.handlers = append(ch.handlers, handlers...)
+}
+
+func (ch *Chain) ServeRequest(ctx context.Context, server *nexusApi.NexusLdapServer) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", server.Spec.Name)
+
+	log.Info("Starting LDAP server chain")
+
+	for _, h := range ch.handlers {
+		if err := h.ServeRequest(ctx, server); err != nil {
+			log.Info("LDAP server chain finished with error")
+
+			return fmt.Errorf("failed to serve handler: %w", err)
+		}
+	}
+
+	log.Info("Handling of LDAP server has been finished")
+
+	return nil
+}
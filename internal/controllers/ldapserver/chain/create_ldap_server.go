@@ -0,0 +1,161 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+const ldapServerKind = "NexusLdapServer"
+
+// CreateLdapServer is a handler for creating LDAP server.
+type CreateLdapServer struct {
+	nexusLdapApiClient nexus.Ldap
+	k8sClient          client.Client
+	ownership          *controllers.OwnershipRecord
+}
+
+// NewCreateLdapServer creates an instance of CreateLdapServer handler.
+func NewCreateLdapServer(
+	nexusLdapApiClient nexus.Ldap,
+	k8sClient client.Client,
+	ownership *controllers.OwnershipRecord,
+) *CreateLdapServer {
+	return &CreateLdapServer{nexusLdapApiClient: nexusLdapApiClient, k8sClient: k8sClient, ownership: ownership}
+}
+
+// ServeRequest implements the logic of creating LDAP server.
+// Nexus doesn't return the bind password, so it is updated only when the Secret with the password changes.
+func (c CreateLdapServer) ServeRequest(ctx context.Context, server *nexusApi.NexusLdapServer) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", server.Spec.Name)
+	log.Info("Start creating LDAP server")
+
+	nexusServer, err := c.nexusLdapApiClient.Get(server.Spec.Name)
+	if err != nil && !nexus.IsErrNotFound(err) {
+		return fmt.Errorf("failed to get LDAP server: %w", err)
+	}
+
+	exists := err == nil
+
+	manage, err := c.ownership.Acquire(ctx, server, server.Spec.ManagementPolicy, server.Spec.Name, exists)
+	if err != nil {
+		return err
+	}
+
+	if !manage {
+		return nil
+	}
+
+	password, secretsVersion, err := bindPassword(ctx, c.k8sClient, server)
+	if err != nil {
+		return fmt.Errorf("failed to get bind password: %w", err)
+	}
+
+	ldapServer := specToLdapServer(&server.Spec, password)
+
+	if !exists {
+		if !controllers.ReportDrift(ctx, ldapServerKind, server, &server.Status.ReconcileStatus,
+			"LDAP server was deleted in nexus, recreating it", "name", server.Spec.Name) {
+			log.Info("LDAP server doesn't exist, creating new one")
+		}
+
+		if err = c.nexusLdapApiClient.Create(ldapServer); err != nil {
+			return fmt.Errorf("failed to create LDAP server: %w", err)
+		}
+
+		server.Status.SecretsVersion = secretsVersion
+
+		log.Info("LDAP server has been created")
+
+		return nil
+	}
+
+	ldapServer.ID = nexusServer.ID
+
+	changed := ldapServerChanged(&ldapServer, nexusServer)
+	if !changed && server.Status.SecretsVersion == secretsVersion {
+		return nil
+	}
+
+	if changed {
+		controllers.ReportDrift(ctx, ldapServerKind, server, &server.Status.ReconcileStatus,
+			"LDAP server was changed in nexus, reverting changes", "name", server.Spec.Name)
+	} else {
+		log.Info("Bind password was changed")
+	}
+
+	log.Info("Updating LDAP server")
+
+	if err = c.nexusLdapApiClient.Update(server.Spec.Name, ldapServer); err != nil {
+		return fmt.Errorf("failed to update LDAP server: %w", err)
+	}
+
+	server.Status.SecretsVersion = secretsVersion
+
+	log.Info("LDAP server has been updated")
+
+	return nil
+}
+
+// ldapServerChanged compares the LDAP server with the one in nexus ignoring the bind password.
+func ldapServerChanged(ldapServer, nexusServer *security.LDAP) bool {
+	expected := *ldapServer
+	actual := *nexusServer
+
+	expected.AuthPassword = ""
+	actual.AuthPassword = ""
+
+	return expected != actual
+}
+
+func specToLdapServer(spec *nexusApi.NexusLdapServerSpec, password string) security.LDAP {
+	conn := spec.Connection
+	users := spec.UserMapping
+
+	ldapServer := security.LDAP{
+		Name:                        spec.Name,
+		Protocol:                    conn.Protocol,
+		Host:                        conn.Host,
+		Port:                        conn.Port,
+		SearchBase:                  conn.SearchBase,
+		UseTrustStore:               conn.UseTrustStore,
+		AuthSchema:                  conn.AuthScheme,
+		AuthRealm:                   conn.AuthRealm,
+		AuthUserName:                conn.AuthUsername,
+		AuthPassword:                password,
+		ConnectionTimeoutSeconds:    conn.ConnectionTimeoutSeconds,
+		ConnectionRetryDelaySeconds: conn.ConnectionRetryDelaySeconds,
+		MaxIncidentCount:            conn.MaxIncidentsCount,
+		UserBaseDN:                  users.BaseDN,
+		UserSubtree:                 users.Subtree,
+		UserObjectClass:             users.ObjectClass,
+		UserLDAPFilter:              users.LdapFilter,
+		UserIDAttribute:             users.IDAttribute,
+		UserRealNameAttribute:       users.RealNameAttribute,
+		UserEmailAddressAttribute:   users.EmailAddressAttribute,
+		UserPasswordAttribute:       users.PasswordAttribute,
+		// nexus requires the group type even if LDAP groups are not used as roles
+		GroupType: nexusApi.LdapGroupTypeStatic,
+	}
+
+	if groups := spec.GroupMapping; groups != nil {
+		ldapServer.LDAPGroupsAsRoles = true
+		ldapServer.GroupType = groups.Type
+		ldapServer.GroupBaseDn = groups.BaseDN
+		ldapServer.GroupSubtree = groups.Subtree
+		ldapServer.GroupObjectClass = groups.ObjectClass
+		ldapServer.GroupIDAttribute = groups.IDAttribute
+		ldapServer.GroupMemberAttribute = groups.MemberAttribute
+		ldapServer.GroupMemberFormat = groups.MemberFormat
+		ldapServer.UserMemberOfAttribute = groups.UserMemberOfAttribute
+	}
+
+	return ldapServer
+}
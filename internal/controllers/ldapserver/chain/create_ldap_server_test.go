@@ -0,0 +1,338 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestCreateLdapServer_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	bindSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ldap-bind", Namespace: "default", ResourceVersion: "2"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+
+	expectedLdapServer := func() security.LDAP {
+		return security.LDAP{
+			Name:                        "corporate-ldap",
+			Protocol:                    "ldap",
+			Host:                        "ldap.example.com",
+			Port:                        389,
+			SearchBase:                  "dc=example,dc=com",
+			AuthSchema:                  nexusApi.LdapAuthSchemeSimple,
+			AuthUserName:                "cn=nexus,dc=example,dc=com",
+			AuthPassword:                "secret",
+			ConnectionTimeoutSeconds:    30,
+			ConnectionRetryDelaySeconds: 300,
+			MaxIncidentCount:            3,
+			UserBaseDN:                  "ou=people",
+			UserObjectClass:             "inetOrgPerson",
+			UserIDAttribute:             "uid",
+			UserRealNameAttribute:       "cn",
+			UserEmailAddressAttribute:   "mail",
+			LDAPGroupsAsRoles:           true,
+			GroupType:                   nexusApi.LdapGroupTypeDynamic,
+			UserMemberOfAttribute:       "memberOf",
+		}
+	}
+
+	nexusLdapServer := func() *security.LDAP {
+		s := expectedLdapServer()
+		s.ID = "ldap-id"
+		s.AuthPassword = ""
+
+		return &s
+	}
+
+	tests := []struct {
+		name               string
+		server             *nexusApi.NexusLdapServer
+		objects            []client.Object
+		nexusApiClient     func(t *testing.T) nexus.Ldap
+		wantErr            require.ErrorAssertionFunc
+		wantSecretsVersion string
+	}{
+		{
+			name:    "LDAP server doesn't exist, creating new one",
+			server:  newTestLdapServer(),
+			objects: []client.Object{bindSecret},
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("Get", "corporate-ldap").Return(nil, nexus.ErrNotFound)
+				m.On("Create", expectedLdapServer()).Return(nil)
+
+				return m
+			},
+			wantErr:            require.NoError,
+			wantSecretsVersion: "ldap-bind=2",
+		},
+		{
+			name: "LDAP server is up to date",
+			server: func() *nexusApi.NexusLdapServer {
+				s := newTestLdapServer()
+				s.Status.SecretsVersion = "ldap-bind=2"
+
+				return s
+			}(),
+			objects: []client.Object{bindSecret},
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("Get", "corporate-ldap").Return(nexusLdapServer(), nil)
+
+				return m
+			},
+			wantErr:            require.NoError,
+			wantSecretsVersion: "ldap-bind=2",
+		},
+		{
+			name: "bind password is changed",
+			server: func() *nexusApi.NexusLdapServer {
+				s := newTestLdapServer()
+				s.Status.SecretsVersion = "ldap-bind=1"
+
+				return s
+			}(),
+			objects: []client.Object{bindSecret},
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("Get", "corporate-ldap").Return(nexusLdapServer(), nil)
+
+				expected := expectedLdapServer()
+				expected.ID = "ldap-id"
+
+				m.On("Update", "corporate-ldap", expected).Return(nil)
+
+				return m
+			},
+			wantErr:            require.NoError,
+			wantSecretsVersion: "ldap-bind=2",
+		},
+		{
+			name: "LDAP server is changed in nexus",
+			server: func() *nexusApi.NexusLdapServer {
+				s := newTestLdapServer()
+				s.Status.SecretsVersion = "ldap-bind=2"
+
+				return s
+			}(),
+			objects: []client.Object{bindSecret},
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				changed := nexusLdapServer()
+				changed.Host = "old-ldap.example.com"
+
+				m.On("Get", "corporate-ldap").Return(changed, nil)
+
+				expected := expectedLdapServer()
+				expected.ID = "ldap-id"
+
+				m.On("Update", "corporate-ldap", expected).Return(nil)
+
+				return m
+			},
+			wantErr:            require.NoError,
+			wantSecretsVersion: "ldap-bind=2",
+		},
+		{
+			name: "LDAP server without groups and authentication",
+			server: func() *nexusApi.NexusLdapServer {
+				s := newTestLdapServer()
+				s.Spec.Connection.AuthScheme = nexusApi.LdapAuthSchemeNone
+				s.Spec.Connection.AuthUsername = ""
+				s.Spec.Connection.AuthPasswordRef = nil
+				s.Spec.GroupMapping = nil
+
+				return s
+			}(),
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				expected := expectedLdapServer()
+				expected.AuthSchema = nexusApi.LdapAuthSchemeNone
+				expected.AuthUserName = ""
+				expected.AuthPassword = ""
+				expected.LDAPGroupsAsRoles = false
+				expected.GroupType = nexusApi.LdapGroupTypeStatic
+				expected.UserMemberOfAttribute = ""
+
+				m.On("Get", "corporate-ldap").Return(nil, nexus.ErrNotFound)
+				m.On("Create", expected).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:   "secret with bind password doesn't exist",
+			server: newTestLdapServer(),
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("Get", "corporate-ldap").Return(nil, nexus.ErrNotFound)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to get bind password")
+				require.ErrorContains(t, err, "failed to get secret ldap-bind")
+			},
+		},
+		{
+			name:   "secret doesn't contain the key",
+			server: newTestLdapServer(),
+			objects: []client.Object{&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ldap-bind", Namespace: "default"},
+				Data:       map[string][]byte{"pass": []byte("secret")},
+			}},
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("Get", "corporate-ldap").Return(nil, nexus.ErrNotFound)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "key password not found in secret ldap-bind")
+			},
+		},
+		{
+			name:   "failed to get LDAP server",
+			server: newTestLdapServer(),
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("Get", "corporate-ldap").Return(nil, errors.New("connection refused"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to get LDAP server")
+			},
+		},
+		{
+			name:    "failed to create LDAP server",
+			server:  newTestLdapServer(),
+			objects: []client.Object{bindSecret},
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("Get", "corporate-ldap").Return(nil, nexus.ErrNotFound)
+				m.On("Create", expectedLdapServer()).Return(errors.New("invalid host"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to create LDAP server")
+			},
+		},
+		{
+			name: "observe only LDAP server exists",
+			server: func() *nexusApi.NexusLdapServer {
+				s := newTestLdapServer()
+				s.Spec.ManagementPolicy = common.ManagementPolicyObserveOnly
+
+				return s
+			}(),
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				changed := nexusLdapServer()
+				changed.Host = "old-ldap.example.com"
+
+				m.On("Get", "corporate-ldap").Return(changed, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			k8sClient := newTestClient(t, tt.objects...)
+			ownership := controllers.NewOwnershipRecord(k8sClient, "default", common.NexusRef{Name: "nexus"})
+
+			err := NewCreateLdapServer(tt.nexusApiClient(t), k8sClient, ownership).
+				ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.server)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantSecretsVersion, tt.server.Status.SecretsVersion)
+		})
+	}
+}
+
+func newTestLdapServer() *nexusApi.NexusLdapServer {
+	return &nexusApi.NexusLdapServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "corporate-ldap",
+			Namespace: "default",
+			UID:       "ldap-uid",
+		},
+		Spec: nexusApi.NexusLdapServerSpec{
+			Name: "corporate-ldap",
+			Connection: nexusApi.LdapConnection{
+				Protocol:     "ldap",
+				Host:         "ldap.example.com",
+				Port:         389,
+				SearchBase:   "dc=example,dc=com",
+				AuthScheme:   nexusApi.LdapAuthSchemeSimple,
+				AuthUsername: "cn=nexus,dc=example,dc=com",
+				AuthPasswordRef: &common.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "ldap-bind"},
+					Key:                  "password",
+				},
+				ConnectionTimeoutSeconds:    30,
+				ConnectionRetryDelaySeconds: 300,
+				MaxIncidentsCount:           3,
+			},
+			UserMapping: nexusApi.LdapUserMapping{
+				BaseDN:                "ou=people",
+				ObjectClass:           "inetOrgPerson",
+				IDAttribute:           "uid",
+				RealNameAttribute:     "cn",
+				EmailAddressAttribute: "mail",
+			},
+			GroupMapping: &nexusApi.LdapGroupMapping{
+				Type:                  nexusApi.LdapGroupTypeDynamic,
+				UserMemberOfAttribute: "memberOf",
+			},
+			NexusRef:         common.NexusRef{Name: "nexus"},
+			ManagementPolicy: common.ManagementPolicyAdopt,
+		},
+	}
+}
+
+func newTestClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, nexusApi.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
@@ -0,0 +1,25 @@
+package chain
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+func CreateChain(
+	nexusLdapApiClient nexus.Ldap,
+	k8sClient client.Client,
+	ownership *controllers.OwnershipRecord,
+	verifier ConnectionVerifier,
+) *Chain {
+	ch := &Chain{}
+
+	ch.Use(
+		NewCreateLdapServer(nexusLdapApiClient, k8sClient, ownership),
+		NewOrderLdapServers(nexusLdapApiClient, k8sClient),
+		NewVerifyLdapServer(k8sClient, verifier),
+	)
+
+	return ch
+}
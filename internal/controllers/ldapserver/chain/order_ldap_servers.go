@@ -0,0 +1,102 @@
+package chain
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+// OrderLdapServers is a handler for ordering LDAP servers in nexus.
+type OrderLdapServers struct {
+	nexusLdapApiClient nexus.Ldap
+	k8sClient          client.Client
+}
+
+// NewOrderLdapServers creates an instance of OrderLdapServers handler.
+func NewOrderLdapServers(nexusLdapApiClient nexus.Ldap, k8sClient client.Client) *OrderLdapServers {
+	return &OrderLdapServers{nexusLdapApiClient: nexusLdapApiClient, k8sClient: k8sClient}
+}
+
+// ServeRequest sorts the LDAP servers managed by NexusLdapServer resources of the same Nexus in the namespace
+// by their order. The managed servers are sorted within the positions they take in nexus,
+// so servers that are not managed by the resources keep their positions.
+func (c OrderLdapServers) ServeRequest(ctx context.Context, server *nexusApi.NexusLdapServer) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", server.Spec.Name)
+
+	servers := &nexusApi.NexusLdapServerList{}
+	if err := c.k8sClient.List(ctx, servers, client.InNamespace(server.Namespace)); err != nil {
+		return fmt.Errorf("failed to get NexusLdapServer list: %w", err)
+	}
+
+	orders := make(map[string]int32, len(servers.Items))
+
+	for i := range servers.Items {
+		s := &servers.Items[i]
+
+		if s.Spec.NexusRef != server.Spec.NexusRef ||
+			s.GetDeletionTimestamp() != nil ||
+			s.Spec.ManagementPolicy == common.ManagementPolicyObserveOnly {
+			continue
+		}
+
+		orders[s.Spec.Name] = s.Spec.Order
+	}
+
+	nexusServers, err := c.nexusLdapApiClient.List()
+	if err != nil {
+		return fmt.Errorf("failed to get LDAP servers: %w", err)
+	}
+
+	current := make([]string, 0, len(nexusServers))
+	for i := range nexusServers {
+		current = append(current, nexusServers[i].Name)
+	}
+
+	desired := sortLdapServers(current, orders)
+	if slices.Equal(current, desired) {
+		return nil
+	}
+
+	log.Info("Changing order of LDAP servers", "order", desired)
+
+	if err = c.nexusLdapApiClient.ChangeOrder(desired); err != nil {
+		return fmt.Errorf("failed to change order of LDAP servers: %w", err)
+	}
+
+	return nil
+}
+
+// sortLdapServers sorts the managed servers by order and name within the positions they take in the list.
+func sortLdapServers(current []string, orders map[string]int32) []string {
+	managed := make([]string, 0, len(orders))
+
+	for _, name := range current {
+		if _, ok := orders[name]; ok {
+			managed = append(managed, name)
+		}
+	}
+
+	slices.SortFunc(managed, func(a, b string) int {
+		return cmp.Or(cmp.Compare(orders[a], orders[b]), cmp.Compare(a, b))
+	})
+
+	result := slices.Clone(current)
+	i := 0
+
+	for pos, name := range current {
+		if _, ok := orders[name]; ok {
+			result[pos] = managed[i]
+			i++
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,200 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestOrderLdapServers_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	ldapServer := func(name string, order int32, nexusName string) *nexusApi.NexusLdapServer {
+		return &nexusApi.NexusLdapServer{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: nexusApi.NexusLdapServerSpec{
+				Name:             name,
+				Order:            order,
+				NexusRef:         common.NexusRef{Name: nexusName},
+				ManagementPolicy: common.ManagementPolicyAdopt,
+			},
+		}
+	}
+
+	nexusServers := func(names ...string) []security.LDAP {
+		servers := make([]security.LDAP, 0, len(names))
+		for _, name := range names {
+			servers = append(servers, security.LDAP{Name: name})
+		}
+
+		return servers
+	}
+
+	tests := []struct {
+		name           string
+		objects        []client.Object
+		nexusApiClient func(t *testing.T) nexus.Ldap
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name: "changing order of LDAP servers",
+			objects: []client.Object{
+				ldapServer("ldap-a", 1, "nexus"),
+				ldapServer("ldap-b", 0, "nexus"),
+				ldapServer("ldap-c", 0, "other-nexus"),
+			},
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("List").Return(nexusServers("ldap-a", "manual", "ldap-b", "ldap-c"), nil)
+				m.On("ChangeOrder", []string{"ldap-b", "manual", "ldap-a", "ldap-c"}).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "LDAP servers are already ordered",
+			objects: []client.Object{
+				ldapServer("ldap-a", 1, "nexus"),
+				ldapServer("ldap-b", 0, "nexus"),
+			},
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("List").Return(nexusServers("ldap-b", "ldap-a"), nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "observe only LDAP server is not ordered",
+			objects: []client.Object{
+				ldapServer("ldap-a", 0, "nexus"),
+				func() client.Object {
+					s := ldapServer("ldap-b", 0, "nexus")
+					s.Spec.ManagementPolicy = common.ManagementPolicyObserveOnly
+
+					return s
+				}(),
+			},
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("List").Return(nexusServers("ldap-b", "ldap-a"), nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "failed to get LDAP servers",
+			objects: []client.Object{ldapServer("ldap-a", 0, "nexus")},
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("List").Return(nil, nexus.ErrNotFound)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to get LDAP servers")
+			},
+		},
+		{
+			name: "failed to change order of LDAP servers",
+			objects: []client.Object{
+				ldapServer("ldap-a", 1, "nexus"),
+				ldapServer("ldap-b", 0, "nexus"),
+			},
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("List").Return(nexusServers("ldap-a", "ldap-b"), nil)
+				m.On("ChangeOrder", []string{"ldap-b", "ldap-a"}).Return(nexus.ErrNotFound)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to change order of LDAP servers")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewOrderLdapServers(tt.nexusApiClient(t), newTestClient(t, tt.objects...))
+			err := c.ServeRequest(
+				ctrl.LoggerInto(context.Background(), logr.Discard()),
+				ldapServer("ldap-a", 1, "nexus"),
+			)
+
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestSortLdapServers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		current []string
+		orders  map[string]int32
+		want    []string
+	}{
+		{
+			name:    "no managed servers",
+			current: []string{"b", "a"},
+			orders:  map[string]int32{},
+			want:    []string{"b", "a"},
+		},
+		{
+			name:    "sorted by order",
+			current: []string{"a", "b", "c"},
+			orders:  map[string]int32{"a": 2, "b": 1, "c": 0},
+			want:    []string{"c", "b", "a"},
+		},
+		{
+			name:    "same order sorted by name",
+			current: []string{"c", "a", "b"},
+			orders:  map[string]int32{"a": 0, "b": 0, "c": 0},
+			want:    []string{"a", "b", "c"},
+		},
+		{
+			name:    "unmanaged servers keep their positions",
+			current: []string{"x", "b", "y", "a", "z"},
+			orders:  map[string]int32{"a": 0, "b": 1},
+			want:    []string{"x", "a", "y", "b", "z"},
+		},
+		{
+			name:    "managed server missing in nexus",
+			current: []string{"b", "x"},
+			orders:  map[string]int32{"a": 0, "b": 1},
+			want:    []string{"b", "x"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, sortLdapServers(tt.current, tt.orders))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+// RemoveLdapServer is a handler for removing LDAP server.
+type RemoveLdapServer struct {
+	nexusLdapApiClient nexus.Ldap
+}
+
+// NewRemoveLdapServer creates an instance of RemoveLdapServer handler.
+func NewRemoveLdapServer(nexusLdapApiClient nexus.Ldap) *RemoveLdapServer {
+	return &RemoveLdapServer{nexusLdapApiClient: nexusLdapApiClient}
+}
+
+// ServeRequest implements the logic of removing LDAP server.
+func (c RemoveLdapServer) ServeRequest(ctx context.Context, server *nexusApi.NexusLdapServer) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", server.Spec.Name)
+	log.Info("Start removing LDAP server")
+
+	if err := c.nexusLdapApiClient.Delete(server.Spec.Name); err != nil {
+		if !nexus.IsErrNotFound(err) {
+			return fmt.Errorf("failed to delete LDAP server: %w", err)
+		}
+
+		log.Info("LDAP server doesn't exist, skipping removal")
+	}
+
+	log.Info("LDAP server has been removed")
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestRemoveLdapServer_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		nexusApiClient func(t *testing.T) nexus.Ldap
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name: "removing LDAP server successfully",
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("Delete", "corporate-ldap").
+					Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "LDAP server doesn't exist, skipping removal",
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("Delete", "corporate-ldap").
+					Return(nexus.ErrNotFound)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "failed to remove LDAP server",
+			nexusApiClient: func(t *testing.T) nexus.Ldap {
+				m := mocks.NewMockLdap(t)
+
+				m.On("Delete", "corporate-ldap").
+					Return(errors.New("connection refused"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to delete LDAP server")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewRemoveLdapServer(tt.nexusApiClient(t))
+			err := c.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), &nexusApi.NexusLdapServer{
+				Spec: nexusApi.NexusLdapServerSpec{
+					Name: "corporate-ldap",
+				},
+			})
+
+			tt.wantErr(t, err)
+		})
+	}
+}
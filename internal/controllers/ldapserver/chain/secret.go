@@ -0,0 +1,33 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+// bindPassword returns the bind password of the LDAP server and the version of its Secret in the form "name=version".
+// Both are empty if the server doesn't use the password.
+func bindPassword(ctx context.Context, k8sClient client.Client, server *nexusApi.NexusLdapServer) (string, string, error) {
+	ref := server.Spec.Connection.AuthPasswordRef
+	if ref == nil {
+		return "", "", nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: server.Namespace}, secret); err != nil {
+		return "", "", fmt.Errorf("failed to get secret %s: %w", ref.Name, err)
+	}
+
+	password, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", "", fmt.Errorf("key %s not found in secret %s", ref.Key, ref.Name)
+	}
+
+	return string(password), secret.Name + "=" + secret.ResourceVersion, nil
+}
@@ -0,0 +1,80 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/pkg/ldap"
+)
+
+// ConnectionVerifier verifies the connection to the LDAP server.
+type ConnectionVerifier interface {
+	Verify(ctx context.Context, conn ldap.Connection) error
+}
+
+// VerifyLdapServer is a handler for verifying the connection to the LDAP server.
+type VerifyLdapServer struct {
+	k8sClient client.Client
+	verifier  ConnectionVerifier
+}
+
+// NewVerifyLdapServer creates an instance of VerifyLdapServer handler.
+func NewVerifyLdapServer(k8sClient client.Client, verifier ConnectionVerifier) *VerifyLdapServer {
+	return &VerifyLdapServer{k8sClient: k8sClient, verifier: verifier}
+}
+
+// ServeRequest connects to the LDAP server with the bind credentials and sets the ConnectionVerified condition.
+// The connection is made from the operator, not from nexus.
+// The failed verification doesn't fail the reconciliation because the LDAP server may be reachable only from nexus.
+func (c VerifyLdapServer) ServeRequest(ctx context.Context, server *nexusApi.NexusLdapServer) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", server.Spec.Name)
+	conn := server.Spec.Connection
+
+	if conn.AuthScheme == nexusApi.LdapAuthSchemeDigestMD5 || conn.AuthScheme == nexusApi.LdapAuthSchemeCramMD5 {
+		controllers.SetConnectionNotVerified(&server.Status.ReconcileStatus, server.Generation,
+			fmt.Sprintf("verification of the %s authentication scheme is not supported", conn.AuthScheme))
+
+		return nil
+	}
+
+	if conn.Protocol == ldap.ProtocolLdaps && conn.UseTrustStore {
+		// nexus trusts the certificates of its truststore, the operator trusts only the system certificate authorities,
+		// so the result of the verification from the operator would be misleading
+		controllers.SetConnectionNotVerified(&server.Status.ReconcileStatus, server.Generation,
+			"verification of ldaps connections that use the nexus truststore is not supported")
+
+		return nil
+	}
+
+	password, _, err := bindPassword(ctx, c.k8sClient, server)
+	if err != nil {
+		return fmt.Errorf("failed to get bind password: %w", err)
+	}
+
+	ldapConn := ldap.Connection{
+		Protocol: conn.Protocol,
+		Host:     conn.Host,
+		Port:     conn.Port,
+		Timeout:  time.Duration(conn.ConnectionTimeoutSeconds) * time.Second,
+	}
+
+	if conn.AuthScheme != nexusApi.LdapAuthSchemeNone {
+		ldapConn.BindDN = conn.AuthUsername
+		ldapConn.Password = password
+	}
+
+	err = c.verifier.Verify(ctx, ldapConn)
+	if err != nil {
+		log.Info("LDAP server connection verification failed", "reason", err.Error())
+	}
+
+	controllers.SetConnectionVerified(&server.Status.ReconcileStatus, server.Generation, err)
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/pkg/ldap"
+	"github.com/epam/edp-nexus-operator/pkg/testutils"
+)
+
+func TestVerifyLdapServer_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	ldapServer := testutils.NewLdapServer(t, map[string]string{
+		"cn=nexus,dc=example,dc=com": "secret",
+	})
+
+	newServer := func(password string) *nexusApi.NexusLdapServer {
+		s := newTestLdapServer()
+		s.Spec.Connection.Host = ldapServer.Host()
+		s.Spec.Connection.Port = ldapServer.Port()
+		s.Spec.Connection.AuthPasswordRef.Name = "ldap-bind-" + password
+
+		return s
+	}
+
+	bindSecret := func(password string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ldap-bind-" + password, Namespace: "default"},
+			Data:       map[string][]byte{"password": []byte(password)},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		server     *nexusApi.NexusLdapServer
+		wantStatus metav1.ConditionStatus
+		wantReason string
+		wantErr    require.ErrorAssertionFunc
+	}{
+		{
+			name:       "connection is verified",
+			server:     newServer("secret"),
+			wantStatus: metav1.ConditionTrue,
+			wantReason: controllers.ReasonConnected,
+			wantErr:    require.NoError,
+		},
+		{
+			name:       "invalid bind password",
+			server:     newServer("wrong"),
+			wantStatus: metav1.ConditionFalse,
+			wantReason: controllers.ReasonConnectionFailed,
+			wantErr:    require.NoError,
+		},
+		{
+			name: "anonymous bind is rejected",
+			server: func() *nexusApi.NexusLdapServer {
+				s := newServer("secret")
+				s.Spec.Connection.AuthScheme = nexusApi.LdapAuthSchemeNone
+
+				return s
+			}(),
+			wantStatus: metav1.ConditionFalse,
+			wantReason: controllers.ReasonConnectionFailed,
+			wantErr:    require.NoError,
+		},
+		{
+			name: "verification of DIGEST_MD5 is skipped",
+			server: func() *nexusApi.NexusLdapServer {
+				s := newServer("secret")
+				s.Spec.Connection.AuthScheme = nexusApi.LdapAuthSchemeDigestMD5
+
+				return s
+			}(),
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: controllers.ReasonVerificationSkipped,
+			wantErr:    require.NoError,
+		},
+		{
+			name: "verification of ldaps with nexus truststore is skipped",
+			server: func() *nexusApi.NexusLdapServer {
+				s := newServer("secret")
+				s.Spec.Connection.Protocol = ldap.ProtocolLdaps
+				s.Spec.Connection.UseTrustStore = true
+
+				return s
+			}(),
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: controllers.ReasonVerificationSkipped,
+			wantErr:    require.NoError,
+		},
+		{
+			name:    "secret with bind password doesn't exist",
+			server:  newServer("missing"),
+			wantErr: require.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			k8sClient := newTestClient(t, bindSecret("secret"), bindSecret("wrong"))
+
+			err := NewVerifyLdapServer(k8sClient, ldap.NewVerifier()).
+				ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.server)
+
+			tt.wantErr(t, err)
+
+			if err != nil {
+				return
+			}
+
+			condition := meta.FindStatusCondition(tt.server.Status.Conditions, common.ConditionConnectionVerified)
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.wantStatus, condition.Status)
+			assert.Equal(t, tt.wantReason, condition.Reason)
+		})
+	}
+}
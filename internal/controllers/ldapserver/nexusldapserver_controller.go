@@ -0,0 +1,247 @@
+package ldapserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/internal/controllers/ldapserver/chain"
+)
+
+// ctrlLog instance ca be used when we can't get logger from context.
+var ctrlLog = ctrl.Log.WithName("nexus_ldap_server_ctrl")
+
+// NexusLdapServerReconciler reconciles a NexusLdapServer object.
+type NexusLdapServerReconciler struct {
+	client            client.Client
+	scheme            *runtime.Scheme
+	apiClientProvider controllers.ApiClientProvider
+	verifier          chain.ConnectionVerifier
+	resyncInterval    time.Duration
+	recorder          record.EventRecorder
+}
+
+func NewNexusLdapServerReconciler(
+	k8sClient client.Client,
+	scheme *runtime.Scheme,
+	apiClientProvider controllers.ApiClientProvider,
+	verifier chain.ConnectionVerifier,
+	resyncInterval time.Duration,
+) *NexusLdapServerReconciler {
+	return &NexusLdapServerReconciler{
+		client:            k8sClient,
+		scheme:            scheme,
+		apiClientProvider: apiClientProvider,
+		verifier:          verifier,
+		resyncInterval:    resyncInterval,
+	}
+}
+
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusldapservers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusldapservers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusldapservers/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *NexusLdapServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling NexusLdapServer")
+
+	server := &nexusApi.NexusLdapServer{}
+	if err := r.client.Get(ctx, req.NamespacedName, server); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get NexusLdapServer: %w", err)
+	}
+
+	oldStatus := server.Status.DeepCopy()
+
+	ownership := controllers.NewOwnershipRecord(r.client, server.Namespace, server.Spec.NexusRef)
+
+	if server.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(server, controllers.NexusOperatorFinalizer) {
+			deleteFromNexus, err := ownership.CanDelete(ctx, server, server.Spec.ManagementPolicy, server.Spec.Name)
+			if err != nil {
+				log.Error(err, "An error has occurred while checking ownership of NexusLdapServer")
+
+				return controllers.RequeueOnError(err), nil
+			}
+
+			if deleteFromNexus && controllers.ShouldDeleteFromNexus(ctx, r.recorder, server, server.Spec.DeletionPolicy) {
+				nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, server.Namespace, server)
+				if err != nil {
+					log.Error(err, "An error has occurred while getting nexus api client")
+
+					controllers.SetDependenciesNotResolved(&server.Status.ReconcileStatus, server.Generation, err)
+
+					if statusErr := r.updateNexusLdapServerStatus(ctx, server, oldStatus); statusErr != nil {
+						return ctrl.Result{}, statusErr
+					}
+
+					return ctrl.Result{
+						RequeueAfter: controllers.ErrorRequeueTime,
+					}, nil
+				}
+
+				if err = chain.NewRemoveLdapServer(nexusApiClient.Security.LDAP).ServeRequest(ctx, server); err != nil {
+					log.Error(err, "An error has occurred while deleting NexusLdapServer")
+
+					return controllers.RequeueOnError(err), nil
+				}
+			}
+
+			if err = ownership.Release(ctx, server, server.Spec.Name); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			controllerutil.RemoveFinalizer(server, controllers.NexusOperatorFinalizer)
+
+			if err = r.client.Update(ctx, server); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to update NexusLdapServer: %w", err)
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, server.Namespace, server)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&server.Status.ReconcileStatus, server.Generation, err)
+
+		if statusErr := r.updateNexusLdapServerStatus(ctx, server, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
+	if controllerutil.AddFinalizer(server, controllers.NexusOperatorFinalizer) {
+		err = r.client.Update(ctx, server)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update NexusLdapServer: %w", err)
+		}
+	}
+
+	if err = chain.CreateChain(nexusApiClient.Security.LDAP, r.client, ownership, r.verifier).
+		ServeRequest(ctx, server); err != nil {
+		log.Error(err, "An error has occurred while handling NexusLdapServer")
+
+		server.Status.Value = common.StatusError
+		server.Status.Error = err.Error()
+		controllers.SetSyncFailed(&server.Status.ReconcileStatus, server.Generation, err)
+
+		if statusErr := r.updateNexusLdapServerStatus(ctx, server, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return controllers.RequeueOnError(err), nil
+	}
+
+	server.Status.Value = common.StatusCreated
+	server.Status.Error = ""
+	controllers.SetSynced(&server.Status.ReconcileStatus, server.Generation)
+
+	if err = r.updateNexusLdapServerStatus(ctx, server, oldStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return controllers.ResyncResult(ctx, server, r.resyncInterval), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NexusLdapServerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor(controllers.EventRecorderName)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&nexusApi.NexusLdapServer{}).
+		Watches(
+			// Watch for changes to Secret resources for updating the bind password.
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.mapSecretToNexusLdapServer),
+			builder.WithPredicates(predicate.Funcs{
+				// We don't need to handle delete event for updating the bind password.
+				DeleteFunc: func(_ event.DeleteEvent) bool {
+					return false
+				},
+			}),
+		).
+		Complete(r)
+	if err != nil {
+		return fmt.Errorf("failed to create controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *NexusLdapServerReconciler) updateNexusLdapServerStatus(
+	ctx context.Context,
+	server *nexusApi.NexusLdapServer,
+	oldStatus *nexusApi.NexusLdapServerStatus,
+) error {
+	if equality.Semantic.DeepEqual(&server.Status, oldStatus) {
+		return nil
+	}
+
+	if err := r.client.Status().Update(ctx, server); err != nil {
+		return fmt.Errorf("failed to update NexusLdapServer status: %w", err)
+	}
+
+	return nil
+}
+
+// mapSecretToNexusLdapServer returns a list of NexusLdapServer requests to be processed for a given secret.
+func (r *NexusLdapServerReconciler) mapSecretToNexusLdapServer(
+	ctx context.Context,
+	secret client.Object,
+) []reconcile.Request {
+	l := ctrlLog.WithName("secrets_watcher").WithValues("secret", secret.GetName())
+	servers := &nexusApi.NexusLdapServerList{}
+
+	if err := r.client.List(ctx, servers, client.InNamespace(secret.GetNamespace())); err != nil {
+		l.Error(err, "failed to get NexusLdapServer list")
+
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, 1)
+
+	for i := range servers.Items {
+		ref := servers.Items[i].Spec.Connection.AuthPasswordRef
+		if ref == nil || ref.Name != secret.GetName() {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{
+			Name:      servers.Items[i].Name,
+			Namespace: servers.Items[i].Namespace,
+		}})
+	}
+
+	return requests
+}
@@ -0,0 +1,106 @@
+package ldapserver
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+var _ = Describe("NexusLdapServer controller", func() {
+	nexusLdapServerCRName := "nexus-ldap-server"
+	bindSecretName := "ldap-bind-secret"
+	It("Should create NexusLdapServer object", func() {
+		By("By creating a secret with the bind password")
+		Expect(k8sClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      bindSecretName,
+				Namespace: namespace,
+			},
+			Data: map[string][]byte{
+				"password": []byte(ldapBindPassword),
+			},
+		})).Should(Succeed())
+
+		By("By creating a new NexusLdapServer object")
+		newNexusLdapServer := &nexusApi.NexusLdapServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nexusLdapServerCRName,
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusLdapServerSpec{
+				Name: "test-ldap",
+				Connection: nexusApi.LdapConnection{
+					Host:         ldapServer.Host(),
+					Port:         ldapServer.Port(),
+					SearchBase:   "dc=example,dc=com",
+					AuthUsername: ldapBindDN,
+					AuthPasswordRef: &common.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: bindSecretName},
+						Key:                  "password",
+					},
+				},
+				UserMapping: nexusApi.LdapUserMapping{
+					BaseDN: "ou=people",
+				},
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusLdapServer)).Should(Succeed())
+		Eventually(func(g Gomega) {
+			createdNexusLdapServer := &nexusApi.NexusLdapServer{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusLdapServerCRName, Namespace: namespace}, createdNexusLdapServer)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(createdNexusLdapServer.Status.Value).Should(Equal(common.StatusCreated))
+			g.Expect(createdNexusLdapServer.Status.Error).Should(BeEmpty())
+			g.Expect(meta.IsStatusConditionTrue(
+				createdNexusLdapServer.Status.Conditions,
+				common.ConditionConnectionVerified,
+			)).Should(BeTrue())
+		}, timeout, interval).Should(Succeed())
+	})
+	It("Should report failed verification when the bind password changes", func() {
+		By("Updating the secret with the bind password")
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: bindSecretName, Namespace: namespace}, secret)).
+			Should(Succeed())
+
+		secret.Data["password"] = []byte("wrong-password")
+		Expect(k8sClient.Update(ctx, secret)).Should(Succeed())
+
+		Eventually(func(g Gomega) {
+			createdNexusLdapServer := &nexusApi.NexusLdapServer{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusLdapServerCRName, Namespace: namespace}, createdNexusLdapServer)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(createdNexusLdapServer.Status.Value).Should(Equal(common.StatusCreated))
+			g.Expect(createdNexusLdapServer.Status.SecretsVersion).Should(Equal(bindSecretName + "=" + secret.ResourceVersion))
+			g.Expect(meta.IsStatusConditionFalse(
+				createdNexusLdapServer.Status.Conditions,
+				common.ConditionConnectionVerified,
+			)).Should(BeTrue())
+		}, timeout, interval).Should(Succeed())
+	})
+	It("Should delete NexusLdapServer object", func() {
+		By("Getting NexusLdapServer object")
+		createdNexusLdapServer := &nexusApi.NexusLdapServer{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nexusLdapServerCRName, Namespace: namespace}, createdNexusLdapServer)).
+			Should(Succeed())
+
+		By("Deleting NexusLdapServer object")
+		Expect(k8sClient.Delete(ctx, createdNexusLdapServer)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusLdapServer := &nexusApi.NexusLdapServer{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusLdapServerCRName, Namespace: namespace}, createdNexusLdapServer)
+			return k8sErrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+})
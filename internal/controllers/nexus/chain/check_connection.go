@@ -18,7 +18,9 @@ func NewCheckConnection(nexusApiClient nexusclinet.User) *CheckConnection {
 	return &CheckConnection{nexusApiClient: nexusApiClient}
 }
 
-func (h *CheckConnection) ServeRequest(ctx context.Context, nexus *nexusApi.Nexus) error {
+// ServeRequest checks the connection to nexus and updates the given status.
+// The status is shared by Nexus and ClusterNexus resources.
+func (h *CheckConnection) ServeRequest(ctx context.Context, status *nexusApi.NexusStatus) error {
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Start checking connection to nexus")
 
@@ -26,10 +28,14 @@ func (h *CheckConnection) ServeRequest(ctx context.Context, nexus *nexusApi.Nexu
 	// if the user is not found, we will not get an error
 	_, err := h.nexusApiClient.Get("user")
 	if err != nil {
+		if nexusclinet.IsTLSError(err) {
+			return fmt.Errorf("failed to establish TLS connection to nexus api, check TLS settings: %w", err)
+		}
+
 		return fmt.Errorf("failed to connect to nexus api: %w", err)
 	}
 
-	nexus.Status.Connected = true
+	status.Connected = true
 
 	log.Info("Connection to nexus is established")
 
@@ -2,7 +2,9 @@ package chain
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
+	"net/url"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -50,6 +52,25 @@ func TestCheckConnection_ServeRequest(t *testing.T) {
 				require.Contains(t, err.Error(), "failed to connect")
 			},
 		},
+		{
+			name:  "failed to establish TLS connection",
+			nexus: &nexusApi.Nexus{},
+			nexusApiClient: func(t *testing.T) nexus.User {
+				m := mocks.NewMockUser(t)
+
+				m.On("Get", "user").Return(nil, &url.Error{
+					Op:  "Get",
+					URL: "https://nexus",
+					Err: x509.UnknownAuthorityError{},
+				})
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to establish TLS connection")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -57,7 +78,7 @@ func TestCheckConnection_ServeRequest(t *testing.T) {
 			t.Parallel()
 
 			h := NewCheckConnection(tt.nexusApiClient(t))
-			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.nexus)
+			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), &tt.nexus.Status)
 
 			tt.wantErr(t, err)
 		})
@@ -0,0 +1,105 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	nexusclinet "github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+const (
+	// ReasonHealthCheckPassed is the reason of the condition for a passed nexus health check.
+	ReasonHealthCheckPassed = "HealthCheckPassed"
+	// ReasonHealthCheckFailed is the reason of the condition for a failed nexus health check.
+	ReasonHealthCheckFailed = "HealthCheckFailed"
+)
+
+var nonAlphanumericRegexp = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+type CheckHealth struct {
+	statusClient nexusclinet.StatusManager
+}
+
+func NewCheckHealth(statusClient nexusclinet.StatusManager) *CheckHealth {
+	return &CheckHealth{statusClient: statusClient}
+}
+
+// ServeRequest reads the nexus node status and health checks and updates the given status.
+// The status is shared by Nexus and ClusterNexus resources.
+func (h *CheckHealth) ServeRequest(ctx context.Context, status *nexusApi.NexusStatus) error {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Start checking nexus health")
+
+	systemStatus, err := h.statusClient.GetStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get nexus status: %w", err)
+	}
+
+	status.Version = systemStatus.Version
+	status.Edition = systemStatus.Edition
+	status.NodeID = systemStatus.NodeID
+	status.ReadOnly = systemStatus.ReadOnly
+
+	setHealthCheckConditions(status, systemStatus.HealthChecks)
+
+	log.Info("Nexus health is checked", "version", status.Version, "readOnly", status.ReadOnly)
+
+	return nil
+}
+
+// setHealthCheckConditions sets a condition for each health check
+// and removes conditions of health checks that are not reported anymore.
+func setHealthCheckConditions(status *nexusApi.NexusStatus, checks map[string]nexusclinet.HealthCheck) {
+	reported := make(map[string]bool, len(checks))
+
+	for name, check := range checks {
+		conditionType := healthCheckConditionType(name)
+		if conditionType == "" {
+			continue
+		}
+
+		reported[conditionType] = true
+
+		condition := metav1.Condition{
+			Type:    conditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonHealthCheckPassed,
+			Message: check.Message,
+		}
+
+		if !check.Healthy {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = ReasonHealthCheckFailed
+		}
+
+		meta.SetStatusCondition(&status.Conditions, condition)
+	}
+
+	for i := len(status.Conditions) - 1; i >= 0; i-- {
+		c := status.Conditions[i]
+
+		if (c.Reason == ReasonHealthCheckPassed || c.Reason == ReasonHealthCheckFailed) && !reported[c.Type] {
+			meta.RemoveStatusCondition(&status.Conditions, c.Type)
+		}
+	}
+}
+
+// healthCheckConditionType converts health check name to the condition type, e.g. "Blob Stores" to "BlobStores".
+func healthCheckConditionType(name string) string {
+	words := nonAlphanumericRegexp.Split(name, -1)
+
+	for i, w := range words {
+		if w != "" {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+
+	return strings.Join(words, "")
+}
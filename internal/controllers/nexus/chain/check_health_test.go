@@ -0,0 +1,108 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestCheckHealth_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		status       *nexusApi.NexusStatus
+		statusClient func(t *testing.T) nexus.StatusManager
+		wantErr      require.ErrorAssertionFunc
+		want         func(t *testing.T, status *nexusApi.NexusStatus)
+	}{
+		{
+			name: "status is updated",
+			status: &nexusApi.NexusStatus{
+				Conditions: []metav1.Condition{
+					{Type: "Scheduler", Status: metav1.ConditionTrue, Reason: ReasonHealthCheckPassed},
+					{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Ready"},
+				},
+			},
+			statusClient: func(t *testing.T) nexus.StatusManager {
+				m := mocks.NewMockStatusManager(t)
+
+				m.On("GetStatus", mock.Anything).Return(&nexus.SystemStatus{
+					Version:  "3.61.0-02",
+					Edition:  "OSS",
+					NodeID:   "node-1",
+					ReadOnly: true,
+					HealthChecks: map[string]nexus.HealthCheck{
+						"Blob Stores":              {Healthy: true, Message: "All blob stores are ready"},
+						"Available CPUs":           {Healthy: false, Message: "Not enough CPUs"},
+						"Thread Deadlock Detector": {Healthy: true},
+					},
+				}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			want: func(t *testing.T, status *nexusApi.NexusStatus) {
+				require.Equal(t, "3.61.0-02", status.Version)
+				require.Equal(t, "OSS", status.Edition)
+				require.Equal(t, "node-1", status.NodeID)
+				require.True(t, status.ReadOnly)
+
+				require.True(t, meta.IsStatusConditionTrue(status.Conditions, "BlobStores"))
+				require.True(t, meta.IsStatusConditionTrue(status.Conditions, "ThreadDeadlockDetector"))
+
+				cpus := meta.FindStatusCondition(status.Conditions, "AvailableCPUs")
+				require.NotNil(t, cpus)
+				require.Equal(t, metav1.ConditionFalse, cpus.Status)
+				require.Equal(t, ReasonHealthCheckFailed, cpus.Reason)
+				require.Equal(t, "Not enough CPUs", cpus.Message)
+
+				require.Nil(t, meta.FindStatusCondition(status.Conditions, "Scheduler"),
+					"conditions of not reported health checks should be removed")
+				require.NotNil(t, meta.FindStatusCondition(status.Conditions, "Ready"),
+					"conditions not related to health checks should be kept")
+			},
+		},
+		{
+			name:   "failed to get status",
+			status: &nexusApi.NexusStatus{},
+			statusClient: func(t *testing.T) nexus.StatusManager {
+				m := mocks.NewMockStatusManager(t)
+
+				m.On("GetStatus", mock.Anything).Return(nil, errors.New("failed to connect"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get nexus status")
+			},
+			want: func(t *testing.T, status *nexusApi.NexusStatus) {
+				require.Empty(t, status.Version)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewCheckHealth(tt.statusClient(t))
+			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.status)
+
+			tt.wantErr(t, err)
+			tt.want(t, tt.status)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/datadrivers/go-nexus-client/nexus3"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -14,6 +15,7 @@ import (
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
 	"github.com/epam/edp-nexus-operator/internal/controllers/nexus/chain"
+	nexusclient "github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
 const (
@@ -23,6 +25,8 @@ const (
 
 type apiClientProvider interface {
 	GetNexusApiClientFromNexus(ctx context.Context, nexus *nexusApi.Nexus) (*nexus3.NexusClient, error)
+	GetNexusStatusClientFromNexus(ctx context.Context, nexus *nexusApi.Nexus) (*nexusclient.StatusClient, error)
+	EvictNexusClients(namespace, name string)
 }
 
 func NewNexusReconciler(c client.Client, scheme *runtime.Scheme, nexusApiProvider apiClientProvider) *NexusReconciler {
@@ -43,6 +47,7 @@ type NexusReconciler struct {
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexuses/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexuses/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -53,13 +58,16 @@ func (r *NexusReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 	nexus := &nexusApi.Nexus{}
 	if err := r.client.Get(ctx, req.NamespacedName, nexus); err != nil {
 		if k8sErrors.IsNotFound(err) {
+			// the resource is deleted, so its cached clients are not used anymore
+			r.apiClientProvider.EvictNexusClients(req.Namespace, req.Name)
+
 			return reconcile.Result{}, nil
 		}
 
 		return reconcile.Result{}, fmt.Errorf("failed to get Nexus instance from k8s: %w", err)
 	}
 
-	oldStatus := nexus.Status
+	oldStatus := nexus.Status.DeepCopy()
 
 	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexus(ctx, nexus)
 	if err != nil {
@@ -73,7 +81,7 @@ func (r *NexusReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 		return reconcile.Result{RequeueAfter: defaultRequeueTime}, fmt.Errorf("failed to get nexus api client: %w", err)
 	}
 
-	if err = chain.NewCheckConnection(nexusApiClient.Security.User).ServeRequest(ctx, nexus); err != nil {
+	if err = chain.NewCheckConnection(nexusclient.NewUserClient(nexusApiClient.Security.User)).ServeRequest(ctx, &nexus.Status); err != nil {
 		nexus.Status.Error = err.Error()
 		nexus.Status.Connected = false
 
@@ -85,6 +93,28 @@ func (r *NexusReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 	}
 
 	nexus.Status.Connected = true
+
+	statusClient, err := r.apiClientProvider.GetNexusStatusClientFromNexus(ctx, nexus)
+	if err != nil {
+		nexus.Status.Error = err.Error()
+
+		if statusErr := r.updateNexusStatus(ctx, nexus, oldStatus); statusErr != nil {
+			return reconcile.Result{}, statusErr
+		}
+
+		return reconcile.Result{RequeueAfter: defaultRequeueTime}, fmt.Errorf("failed to get nexus status client: %w", err)
+	}
+
+	if err = chain.NewCheckHealth(statusClient).ServeRequest(ctx, &nexus.Status); err != nil {
+		nexus.Status.Error = err.Error()
+
+		if statusErr := r.updateNexusStatus(ctx, nexus, oldStatus); statusErr != nil {
+			return reconcile.Result{}, statusErr
+		}
+
+		return reconcile.Result{RequeueAfter: defaultRequeueTime}, fmt.Errorf("failed to check nexus health: %w", err)
+	}
+
 	nexus.Status.Error = ""
 
 	if err = r.updateNexusStatus(ctx, nexus, oldStatus); err != nil {
@@ -108,8 +138,8 @@ func (r *NexusReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return nil
 }
 
-func (r *NexusReconciler) updateNexusStatus(ctx context.Context, nexus *nexusApi.Nexus, oldStatus nexusApi.NexusStatus) error {
-	if nexus.Status == oldStatus {
+func (r *NexusReconciler) updateNexusStatus(ctx context.Context, nexus *nexusApi.Nexus, oldStatus *nexusApi.NexusStatus) error {
+	if equality.Semantic.DeepEqual(&nexus.Status, oldStatus) {
 		return nil
 	}
 
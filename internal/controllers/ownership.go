@@ -0,0 +1,329 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	"github.com/epam/edp-nexus-operator/pkg/helper"
+)
+
+// ErrAlreadyManaged is returned when the object in nexus is managed by another resource.
+var ErrAlreadyManaged = errors.New("object is already managed by another resource")
+
+// Owner is the resource that manages the object in nexus.
+type Owner struct {
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	UID       types.UID `json:"uid"`
+}
+
+// OwnershipRecord maps objects in nexus to the resources that manage them.
+// The record is kept in a ConfigMap next to the Nexus resource,
+// in the operator namespace for ClusterNexus, so resources from different namespaces share it.
+// Each kind is stored in a separate key as a JSON map of the object name to its owner.
+type OwnershipRecord struct {
+	k8sClient client.Client
+	configMap types.NamespacedName
+}
+
+// NewOwnershipRecord creates an OwnershipRecord of the Nexus instance referenced by the resource.
+func NewOwnershipRecord(k8sClient client.Client, namespace string, nexusRef common.NexusRef) *OwnershipRecord {
+	kind := nexusRef.Kind
+	if kind == "" {
+		kind = common.NexusKind
+	}
+
+	if kind == common.ClusterNexusKind {
+		namespace = helper.GetOperatorNamespace()
+	}
+
+	return &OwnershipRecord{
+		k8sClient: k8sClient,
+		configMap: types.NamespacedName{
+			Namespace: namespace,
+			Name:      fmt.Sprintf("%s-%s-ownership", strings.ToLower(kind), nexusRef.Name),
+		},
+	}
+}
+
+// Acquire checks if the resource can change the object in nexus according to the management policy.
+// exists shows that the object exists in nexus.
+// If the object can be changed, the resource is recorded as its owner.
+// It returns false if the object must not be changed, e.g. for ObserveOnly policy.
+func (r *OwnershipRecord) Acquire(
+	ctx context.Context,
+	obj client.Object,
+	policy common.ManagementPolicy,
+	objectName string,
+	exists bool,
+) (bool, error) {
+	if policy == common.ManagementPolicyObserveOnly {
+		if !exists {
+			return false, fmt.Errorf("%s doesn't exist in nexus", objectName)
+		}
+
+		ctrl.LoggerFrom(ctx).Info("Management policy is ObserveOnly, skipping changes in nexus")
+
+		return false, nil
+	}
+
+	owned, err := r.IsOwner(ctx, obj, objectName)
+	if err != nil {
+		return false, err
+	}
+
+	if owned {
+		return true, nil
+	}
+
+	if exists && policy == common.ManagementPolicyFailIfExists {
+		return false, fmt.Errorf("%s already exists in nexus and management policy is FailIfExists", objectName)
+	}
+
+	if err = r.claim(ctx, obj, objectName); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CanDelete checks if the resource can delete the object from nexus.
+// Objects with ObserveOnly policy and objects managed by another resource are not deleted.
+func (r *OwnershipRecord) CanDelete(
+	ctx context.Context,
+	obj client.Object,
+	policy common.ManagementPolicy,
+	objectName string,
+) (bool, error) {
+	if policy == common.ManagementPolicyObserveOnly {
+		return false, nil
+	}
+
+	if _, err := r.IsOwner(ctx, obj, objectName); err != nil {
+		if errors.Is(err, ErrAlreadyManaged) {
+			ctrl.LoggerFrom(ctx).Info("Object is managed by another resource, skipping deletion", "reason", err.Error())
+
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// IsOwner checks if the resource is recorded as the owner of the object.
+// It returns ErrAlreadyManaged if the object is managed by another existing resource.
+func (r *OwnershipRecord) IsOwner(ctx context.Context, obj client.Object, objectName string) (bool, error) {
+	kind, err := r.kind(obj)
+	if err != nil {
+		return false, err
+	}
+
+	configMap, err := r.getConfigMap(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	owners, err := decodeOwners(configMap, kind)
+	if err != nil {
+		return false, err
+	}
+
+	return r.checkOwner(ctx, obj, owners[objectName], objectName)
+}
+
+// Release removes the resource from the owners of the object.
+func (r *OwnershipRecord) Release(ctx context.Context, obj client.Object, objectName string) error {
+	kind, err := r.kind(obj)
+	if err != nil {
+		return err
+	}
+
+	if err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := r.getConfigMap(ctx)
+		if err != nil {
+			return err
+		}
+
+		owners, err := decodeOwners(configMap, kind)
+		if err != nil {
+			return err
+		}
+
+		if owner, ok := owners[objectName]; !ok || owner.UID != obj.GetUID() {
+			return nil
+		}
+
+		delete(owners, objectName)
+
+		return r.saveOwners(ctx, configMap, kind, owners)
+	}); err != nil {
+		return fmt.Errorf("failed to release ownership of %s: %w", objectName, err)
+	}
+
+	return nil
+}
+
+func (r *OwnershipRecord) claim(ctx context.Context, obj client.Object, objectName string) error {
+	kind, err := r.kind(obj)
+	if err != nil {
+		return err
+	}
+
+	if err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := r.getConfigMap(ctx)
+		if err != nil {
+			return err
+		}
+
+		owners, err := decodeOwners(configMap, kind)
+		if err != nil {
+			return err
+		}
+
+		// the owner is checked again, because another resource could claim the object in the meantime
+		if owned, err := r.checkOwner(ctx, obj, owners[objectName], objectName); err != nil || owned {
+			return err
+		}
+
+		owners[objectName] = Owner{
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+			UID:       obj.GetUID(),
+		}
+
+		return r.saveOwners(ctx, configMap, kind, owners)
+	}); err != nil {
+		return fmt.Errorf("failed to record ownership of %s: %w", objectName, err)
+	}
+
+	ctrl.LoggerFrom(ctx).Info("Ownership of the object in nexus has been recorded", "object", objectName)
+
+	return nil
+}
+
+// checkOwner returns true if the resource is the owner.
+// Owners that no longer exist, e.g. deleted without the finalizer, are ignored.
+func (r *OwnershipRecord) checkOwner(ctx context.Context, obj client.Object, owner Owner, objectName string) (bool, error) {
+	if owner.UID == "" {
+		return false, nil
+	}
+
+	if owner.UID == obj.GetUID() {
+		return true, nil
+	}
+
+	current, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return false, fmt.Errorf("failed to copy %s", obj.GetName())
+	}
+
+	if err := r.k8sClient.Get(ctx, types.NamespacedName{Namespace: owner.Namespace, Name: owner.Name}, current); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to get owner of %s: %w", objectName, err)
+	}
+
+	if current.GetUID() != owner.UID {
+		return false, nil
+	}
+
+	return false, fmt.Errorf("%w: %s is already managed by %s/%s",
+		ErrAlreadyManaged, objectName, owner.Namespace, owner.Name)
+}
+
+func (r *OwnershipRecord) kind(obj client.Object) (string, error) {
+	gvk, err := apiutil.GVKForObject(obj, r.k8sClient.Scheme())
+	if err != nil {
+		return "", fmt.Errorf("failed to get kind of %s: %w", obj.GetName(), err)
+	}
+
+	return gvk.Kind, nil
+}
+
+// getConfigMap returns the ConfigMap with the record. A new ConfigMap is returned if it doesn't exist.
+func (r *OwnershipRecord) getConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := r.k8sClient.Get(ctx, r.configMap, configMap); err != nil {
+		if !k8sErrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get ownership record: %w", err)
+		}
+
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      r.configMap.Name,
+				Namespace: r.configMap.Namespace,
+			},
+		}, nil
+	}
+
+	return configMap, nil
+}
+
+func (r *OwnershipRecord) saveOwners(
+	ctx context.Context,
+	configMap *corev1.ConfigMap,
+	kind string,
+	owners map[string]Owner,
+) error {
+	data, err := json.Marshal(owners)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ownership record: %w", err)
+	}
+
+	if configMap.Data == nil {
+		configMap.Data = make(map[string]string)
+	}
+
+	configMap.Data[kind] = string(data)
+
+	if configMap.ResourceVersion == "" {
+		if err = r.k8sClient.Create(ctx, configMap); err != nil {
+			if k8sErrors.IsAlreadyExists(err) {
+				// the record was created concurrently, so the update is retried with the current version
+				return k8sErrors.NewConflict(corev1.Resource("configmaps"), configMap.Name, err)
+			}
+
+			return fmt.Errorf("failed to create ownership record: %w", err)
+		}
+
+		return nil
+	}
+
+	if err = r.k8sClient.Update(ctx, configMap); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func decodeOwners(configMap *corev1.ConfigMap, kind string) (map[string]Owner, error) {
+	owners := make(map[string]Owner)
+
+	data, ok := configMap.Data[kind]
+	if !ok {
+		return owners, nil
+	}
+
+	if err := json.Unmarshal([]byte(data), &owners); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ownership record: %w", err)
+	}
+
+	return owners, nil
+}
@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+func TestOwnershipRecord_Acquire(t *testing.T) {
+	t.Parallel()
+
+	role := func(namespace, name, uid string) *nexusApi.NexusRole {
+		return &nexusApi.NexusRole{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: types.UID(uid)},
+		}
+	}
+
+	ownershipConfigMap := func(owners string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nexus-nexus-ownership"},
+			Data:       map[string]string{"NexusRole": owners},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		policy     common.ManagementPolicy
+		exists     bool
+		objects    []client.Object
+		want       bool
+		wantErr    require.ErrorAssertionFunc
+		wantRecord bool
+	}{
+		{
+			name:       "adopt existing object",
+			policy:     common.ManagementPolicyAdopt,
+			exists:     true,
+			want:       true,
+			wantErr:    require.NoError,
+			wantRecord: true,
+		},
+		{
+			name:       "create new object",
+			policy:     common.ManagementPolicyFailIfExists,
+			want:       true,
+			wantErr:    require.NoError,
+			wantRecord: true,
+		},
+		{
+			name:   "fail if object exists",
+			policy: common.ManagementPolicyFailIfExists,
+			exists: true,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "nx-admin already exists in nexus")
+			},
+		},
+		{
+			name:   "object created by the resource",
+			policy: common.ManagementPolicyFailIfExists,
+			exists: true,
+			objects: []client.Object{
+				ownershipConfigMap(`{"nx-admin":{"namespace":"ns","name":"admin","uid":"uid"}}`),
+			},
+			want:       true,
+			wantErr:    require.NoError,
+			wantRecord: true,
+		},
+		{
+			name:   "observe existing object",
+			policy: common.ManagementPolicyObserveOnly,
+			exists: true,
+			want:   false,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "observe missing object",
+			policy: common.ManagementPolicyObserveOnly,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "nx-admin doesn't exist in nexus")
+			},
+		},
+		{
+			name:   "object is managed by another resource",
+			policy: common.ManagementPolicyAdopt,
+			exists: true,
+			objects: []client.Object{
+				ownershipConfigMap(`{"nx-admin":{"namespace":"other","name":"admin","uid":"other-uid"}}`),
+				role("other", "admin", "other-uid"),
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrAlreadyManaged)
+				require.ErrorContains(t, err, "nx-admin is already managed by other/admin")
+			},
+		},
+		{
+			name:   "owner doesn't exist anymore",
+			policy: common.ManagementPolicyAdopt,
+			exists: true,
+			objects: []client.Object{
+				ownershipConfigMap(`{"nx-admin":{"namespace":"other","name":"admin","uid":"other-uid"}}`),
+				role("other", "admin", "recreated-uid"),
+			},
+			want:       true,
+			wantErr:    require.NoError,
+			wantRecord: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			k8sClient := newOwnershipTestClient(t, tt.objects...)
+			record := NewOwnershipRecord(k8sClient, "default", common.NexusRef{Name: "nexus"})
+
+			got, err := record.Acquire(context.Background(), role("ns", "admin", "uid"), tt.policy, "nx-admin", tt.exists)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+
+			owned, err := record.IsOwner(context.Background(), role("ns", "admin", "uid"), "nx-admin")
+			if tt.wantRecord {
+				require.NoError(t, err)
+				assert.True(t, owned)
+			} else {
+				assert.False(t, owned)
+			}
+		})
+	}
+}
+
+func TestOwnershipRecord_Release(t *testing.T) {
+	t.Parallel()
+
+	owner := &nexusApi.NexusRepository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "go-proxy", UID: "uid"},
+	}
+	other := &nexusApi.NexusRepository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "go-proxy-copy", UID: "other-uid"},
+	}
+
+	k8sClient := newOwnershipTestClient(t, owner, other)
+	record := NewOwnershipRecord(k8sClient, "ns", common.NexusRef{Name: "nexus"})
+	ctx := context.Background()
+
+	_, err := record.Acquire(ctx, owner, common.ManagementPolicyAdopt, "go-proxy", false)
+	require.NoError(t, err)
+
+	canDelete, err := record.CanDelete(ctx, other, common.ManagementPolicyAdopt, "go-proxy")
+	require.NoError(t, err)
+	assert.False(t, canDelete, "object managed by another resource should not be deleted")
+
+	canDelete, err = record.CanDelete(ctx, owner, common.ManagementPolicyObserveOnly, "go-proxy")
+	require.NoError(t, err)
+	assert.False(t, canDelete, "observed object should not be deleted")
+
+	canDelete, err = record.CanDelete(ctx, owner, common.ManagementPolicyAdopt, "go-proxy")
+	require.NoError(t, err)
+	assert.True(t, canDelete)
+
+	require.NoError(t, record.Release(ctx, other, "go-proxy"))
+	require.NoError(t, record.Release(ctx, owner, "go-proxy"))
+
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "nexus-nexus-ownership"}, configMap))
+	assert.JSONEq(t, "{}", configMap.Data["NexusRepository"])
+
+	_, err = record.Acquire(ctx, other, common.ManagementPolicyAdopt, "go-proxy", true)
+	require.NoError(t, err, "released object should be adopted by another resource")
+}
+
+func newOwnershipTestClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, nexusApi.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
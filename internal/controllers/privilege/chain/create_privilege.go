@@ -0,0 +1,128 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+const privilegeKind = "NexusPrivilege"
+
+// CreatePrivilege is a handler for creating privilege.
+type CreatePrivilege struct {
+	nexusPrivilegeApiClient nexus.Privilege
+	ownership               *controllers.OwnershipRecord
+}
+
+// NewCreatePrivilege creates an instance of CreatePrivilege handler.
+func NewCreatePrivilege(
+	nexusPrivilegeApiClient nexus.Privilege,
+	ownership *controllers.OwnershipRecord,
+) *CreatePrivilege {
+	return &CreatePrivilege{nexusPrivilegeApiClient: nexusPrivilegeApiClient, ownership: ownership}
+}
+
+// ServeRequest implements the logic of creating privilege.
+func (c CreatePrivilege) ServeRequest(ctx context.Context, privilege *nexusApi.NexusPrivilege) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", privilege.Spec.Name, "type", privilege.Spec.Type)
+	log.Info("Start creating privilege")
+
+	nexusPrivilege, err := c.nexusPrivilegeApiClient.Get(privilege.Spec.Name)
+	if err != nil && !nexus.IsErrNotFound(err) {
+		return fmt.Errorf("failed to get privilege: %w", err)
+	}
+
+	exists := err == nil
+
+	manage, err := c.ownership.Acquire(ctx, privilege, privilege.Spec.ManagementPolicy, privilege.Spec.Name, exists)
+	if err != nil {
+		return err
+	}
+
+	if !manage {
+		return nil
+	}
+
+	if !exists {
+		if !controllers.ReportDrift(ctx, privilegeKind, privilege, &privilege.Status.ReconcileStatus,
+			"Privilege was deleted in nexus, recreating it", "name", privilege.Spec.Name) {
+			log.Info("Privilege doesn't exist, creating new one")
+		}
+
+		if err = c.nexusPrivilegeApiClient.Create(specToPrivilege(&privilege.Spec)); err != nil {
+			return fmt.Errorf("failed to create privilege: %w", err)
+		}
+
+		log.Info("Privilege has been created")
+
+		return nil
+	}
+
+	if nexusPrivilege.Type != string(privilege.Spec.Type) {
+		return fmt.Errorf("privilege %s already exists in nexus with type %s", privilege.Spec.Name, nexusPrivilege.Type)
+	}
+
+	if !privilegeChanged(&privilege.Spec, nexusPrivilege) {
+		return nil
+	}
+
+	if nexusPrivilege.ReadOnly {
+		return fmt.Errorf("privilege %s is read-only in nexus and can't be updated", privilege.Spec.Name)
+	}
+
+	controllers.ReportDrift(ctx, privilegeKind, privilege, &privilege.Status.ReconcileStatus,
+		"Privilege was changed in nexus, reverting changes", "name", privilege.Spec.Name)
+
+	log.Info("Updating privilege")
+
+	if err = c.nexusPrivilegeApiClient.Update(privilege.Spec.Name, specToPrivilege(&privilege.Spec)); err != nil {
+		return fmt.Errorf("failed to update privilege: %w", err)
+	}
+
+	log.Info("Privilege has been updated")
+
+	return nil
+}
+
+func privilegeChanged(spec *nexusApi.NexusPrivilegeSpec, nexusPrivilege *security.Privilege) bool {
+	if spec.Description != nexusPrivilege.Description ||
+		spec.Domain != nexusPrivilege.Domain ||
+		spec.Pattern != nexusPrivilege.Pattern ||
+		spec.Format != nexusPrivilege.Format ||
+		spec.Repository != nexusPrivilege.Repository ||
+		spec.ContentSelector != nexusPrivilege.ContentSelector ||
+		spec.ScriptName != nexusPrivilege.ScriptName {
+		return true
+	}
+
+	// nexus doesn't keep the order of actions
+	specActions := slices.Clone(spec.Actions)
+	nexusActions := slices.Clone(nexusPrivilege.Actions)
+
+	slices.Sort(specActions)
+	slices.Sort(nexusActions)
+
+	return !slices.Equal(specActions, nexusActions)
+}
+
+func specToPrivilege(spec *nexusApi.NexusPrivilegeSpec) security.Privilege {
+	return security.Privilege{
+		Name:            spec.Name,
+		Type:            string(spec.Type),
+		Description:     spec.Description,
+		Actions:         slices.Clone(spec.Actions),
+		Domain:          spec.Domain,
+		Pattern:         spec.Pattern,
+		Format:          spec.Format,
+		Repository:      spec.Repository,
+		ContentSelector: spec.ContentSelector,
+		ScriptName:      spec.ScriptName,
+	}
+}
@@ -0,0 +1,220 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers/testutils"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestCreatePrivilege_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	repositoryViewPrivilege := func(actions ...string) *nexusApi.NexusPrivilege {
+		return &nexusApi.NexusPrivilege{
+			Spec: nexusApi.NexusPrivilegeSpec{
+				Name:        "maven-releases-browse",
+				Type:        nexusApi.PrivilegeTypeRepositoryView,
+				Description: "Browse maven-releases",
+				Actions:     actions,
+				Format:      "maven2",
+				Repository:  "maven-releases",
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		privilege      *nexusApi.NexusPrivilege
+		nexusApiClient func(t *testing.T) nexus.Privilege
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:      "privilege doesn't exist, creating new one",
+			privilege: repositoryViewPrivilege("BROWSE", "READ"),
+			nexusApiClient: func(t *testing.T) nexus.Privilege {
+				m := mocks.NewMockPrivilege(t)
+
+				m.On("Get", "maven-releases-browse").Return(nil, nexus.ErrNotFound)
+
+				m.On("Create", security.Privilege{
+					Name:        "maven-releases-browse",
+					Type:        security.PrivilegeTypeRepositoryView,
+					Description: "Browse maven-releases",
+					Actions:     []string{"BROWSE", "READ"},
+					Format:      "maven2",
+					Repository:  "maven-releases",
+				}).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "wildcard privilege doesn't exist, creating new one",
+			privilege: &nexusApi.NexusPrivilege{
+				Spec: nexusApi.NexusPrivilegeSpec{
+					Name:    "all-read",
+					Type:    nexusApi.PrivilegeTypeWildcard,
+					Pattern: "nexus:*:*:read",
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.Privilege {
+				m := mocks.NewMockPrivilege(t)
+
+				m.On("Get", "all-read").Return(nil, nexus.ErrNotFound)
+
+				m.On("Create", security.Privilege{
+					Name:    "all-read",
+					Type:    security.PrivilegeTypeWildcard,
+					Pattern: "nexus:*:*:read",
+				}).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:      "privilege exists, updating it",
+			privilege: repositoryViewPrivilege("BROWSE", "READ", "EDIT"),
+			nexusApiClient: func(t *testing.T) nexus.Privilege {
+				m := mocks.NewMockPrivilege(t)
+
+				m.On("Get", "maven-releases-browse").Return(&security.Privilege{
+					Name:        "maven-releases-browse",
+					Type:        security.PrivilegeTypeRepositoryView,
+					Description: "Browse maven-releases",
+					Actions:     []string{"BROWSE", "READ"},
+					Format:      "maven2",
+					Repository:  "maven-releases",
+				}, nil)
+
+				m.On("Update", "maven-releases-browse", security.Privilege{
+					Name:        "maven-releases-browse",
+					Type:        security.PrivilegeTypeRepositoryView,
+					Description: "Browse maven-releases",
+					Actions:     []string{"BROWSE", "READ", "EDIT"},
+					Format:      "maven2",
+					Repository:  "maven-releases",
+				}).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:      "privilege exists, actions in different order",
+			privilege: repositoryViewPrivilege("READ", "BROWSE"),
+			nexusApiClient: func(t *testing.T) nexus.Privilege {
+				m := mocks.NewMockPrivilege(t)
+
+				m.On("Get", "maven-releases-browse").Return(&security.Privilege{
+					Name:        "maven-releases-browse",
+					Type:        security.PrivilegeTypeRepositoryView,
+					Description: "Browse maven-releases",
+					Actions:     []string{"BROWSE", "READ"},
+					Format:      "maven2",
+					Repository:  "maven-releases",
+				}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:      "privilege exists with another type",
+			privilege: repositoryViewPrivilege("BROWSE"),
+			nexusApiClient: func(t *testing.T) nexus.Privilege {
+				m := mocks.NewMockPrivilege(t)
+
+				m.On("Get", "maven-releases-browse").Return(&security.Privilege{
+					Name:    "maven-releases-browse",
+					Type:    security.PrivilegeTypeWildcard,
+					Pattern: "nexus:*",
+				}, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "already exists in nexus with type wildcard")
+			},
+		},
+		{
+			name:      "built-in privilege can't be updated",
+			privilege: repositoryViewPrivilege("BROWSE"),
+			nexusApiClient: func(t *testing.T) nexus.Privilege {
+				m := mocks.NewMockPrivilege(t)
+
+				m.On("Get", "maven-releases-browse").Return(&security.Privilege{
+					Name:       "maven-releases-browse",
+					Type:       security.PrivilegeTypeRepositoryView,
+					Actions:    []string{"BROWSE", "READ"},
+					Format:     "maven2",
+					Repository: "maven-releases",
+					ReadOnly:   true,
+				}, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "is read-only in nexus")
+			},
+		},
+		{
+			name:      "failed to get privilege",
+			privilege: repositoryViewPrivilege("BROWSE"),
+			nexusApiClient: func(t *testing.T) nexus.Privilege {
+				m := mocks.NewMockPrivilege(t)
+
+				m.On("Get", "maven-releases-browse").Return(nil, errors.New("connection refused"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to get privilege")
+			},
+		},
+		{
+			name:      "failed to create privilege",
+			privilege: repositoryViewPrivilege("BROWSE"),
+			nexusApiClient: func(t *testing.T) nexus.Privilege {
+				m := mocks.NewMockPrivilege(t)
+
+				m.On("Get", "maven-releases-browse").Return(nil, nexus.ErrNotFound)
+				m.On("Create", security.Privilege{
+					Name:        "maven-releases-browse",
+					Type:        security.PrivilegeTypeRepositoryView,
+					Description: "Browse maven-releases",
+					Actions:     []string{"BROWSE"},
+					Format:      "maven2",
+					Repository:  "maven-releases",
+				}).Return(errors.New("repository doesn't exist"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to create privilege")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewCreatePrivilege(tt.nexusApiClient(t), testutils.NewOwnershipRecord(t))
+			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.privilege)
+
+			tt.wantErr(t, err)
+		})
+	}
+}
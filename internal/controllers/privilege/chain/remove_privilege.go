@@ -0,0 +1,39 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+// RemovePrivilege is a handler for removing privilege.
+type RemovePrivilege struct {
+	nexusPrivilegeApiClient nexus.Privilege
+}
+
+// NewRemovePrivilege creates an instance of RemovePrivilege handler.
+func NewRemovePrivilege(nexusPrivilegeApiClient nexus.Privilege) *RemovePrivilege {
+	return &RemovePrivilege{nexusPrivilegeApiClient: nexusPrivilegeApiClient}
+}
+
+// ServeRequest implements the logic of removing privilege.
+func (c RemovePrivilege) ServeRequest(ctx context.Context, privilege *nexusApi.NexusPrivilege) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", privilege.Spec.Name)
+	log.Info("Start removing privilege")
+
+	if err := c.nexusPrivilegeApiClient.Delete(privilege.Spec.Name); err != nil {
+		if !nexus.IsErrNotFound(err) {
+			return fmt.Errorf("failed to delete privilege: %w", err)
+		}
+
+		log.Info("Privilege doesn't exist, skipping removal")
+	}
+
+	log.Info("Privilege has been removed")
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestRemovePrivilege_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		privilege      *nexusApi.NexusPrivilege
+		nexusApiClient func(t *testing.T) nexus.Privilege
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name: "removing privilege successfully",
+			privilege: &nexusApi.NexusPrivilege{
+				Spec: nexusApi.NexusPrivilegeSpec{
+					Name: "privilege-name",
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.Privilege {
+				m := mocks.NewMockPrivilege(t)
+
+				m.On("Delete", "privilege-name").
+					Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "privilege doesn't exist, skipping removal",
+			privilege: &nexusApi.NexusPrivilege{
+				Spec: nexusApi.NexusPrivilegeSpec{
+					Name: "privilege-name",
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.Privilege {
+				m := mocks.NewMockPrivilege(t)
+
+				m.On("Delete", "privilege-name").
+					Return(nexus.ErrNotFound)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "failed to remove privilege",
+			privilege: &nexusApi.NexusPrivilege{
+				Spec: nexusApi.NexusPrivilegeSpec{
+					Name: "privilege-name",
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.Privilege {
+				m := mocks.NewMockPrivilege(t)
+
+				m.On("Delete", "privilege-name").
+					Return(errors.New("failed to remove privilege"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to remove privilege")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewRemovePrivilege(tt.nexusApiClient(t))
+			err := c.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.privilege)
+
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package privilege
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/internal/controllers/privilege/chain"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+// NexusPrivilegeReconciler reconciles a NexusPrivilege object.
+type NexusPrivilegeReconciler struct {
+	client            client.Client
+	scheme            *runtime.Scheme
+	apiClientProvider controllers.ApiClientProvider
+	resyncInterval    time.Duration
+	recorder          record.EventRecorder
+}
+
+func NewNexusPrivilegeReconciler(
+	k8sClient client.Client,
+	scheme *runtime.Scheme,
+	apiClientProvider controllers.ApiClientProvider,
+	resyncInterval time.Duration,
+) *NexusPrivilegeReconciler {
+	return &NexusPrivilegeReconciler{
+		client:            k8sClient,
+		scheme:            scheme,
+		apiClientProvider: apiClientProvider,
+		resyncInterval:    resyncInterval,
+	}
+}
+
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusprivileges,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusprivileges/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusprivileges/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *NexusPrivilegeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling NexusPrivilege")
+
+	privilege := &nexusApi.NexusPrivilege{}
+	if err := r.client.Get(ctx, req.NamespacedName, privilege); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get NexusPrivilege: %w", err)
+	}
+
+	oldStatus := privilege.Status.DeepCopy()
+
+	ownership := controllers.NewOwnershipRecord(r.client, privilege.Namespace, privilege.Spec.NexusRef)
+
+	if privilege.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(privilege, controllers.NexusOperatorFinalizer) {
+			deleteFromNexus, err := ownership.CanDelete(ctx, privilege, privilege.Spec.ManagementPolicy, privilege.Spec.Name)
+			if err != nil {
+				log.Error(err, "An error has occurred while checking ownership of NexusPrivilege")
+
+				return controllers.RequeueOnError(err), nil
+			}
+
+			if deleteFromNexus && controllers.ShouldDeleteFromNexus(ctx, r.recorder, privilege, privilege.Spec.DeletionPolicy) {
+				nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, privilege.Namespace, privilege)
+				if err != nil {
+					log.Error(err, "An error has occurred while getting nexus api client")
+
+					controllers.SetDependenciesNotResolved(&privilege.Status.ReconcileStatus, privilege.Generation, err)
+
+					if statusErr := r.updateNexusPrivilegeStatus(ctx, privilege, oldStatus); statusErr != nil {
+						return ctrl.Result{}, statusErr
+					}
+
+					return ctrl.Result{
+						RequeueAfter: controllers.ErrorRequeueTime,
+					}, nil
+				}
+
+				privilegeApiClient := nexus.NewPrivilegeClient(nexusApiClient.Security.Privilege)
+
+				if err = chain.NewRemovePrivilege(privilegeApiClient).ServeRequest(ctx, privilege); err != nil {
+					log.Error(err, "An error has occurred while deleting NexusPrivilege")
+
+					return controllers.RequeueOnError(err), nil
+				}
+			}
+
+			if err = ownership.Release(ctx, privilege, privilege.Spec.Name); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			controllerutil.RemoveFinalizer(privilege, controllers.NexusOperatorFinalizer)
+
+			if err = r.client.Update(ctx, privilege); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to update NexusPrivilege: %w", err)
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, privilege.Namespace, privilege)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&privilege.Status.ReconcileStatus, privilege.Generation, err)
+
+		if statusErr := r.updateNexusPrivilegeStatus(ctx, privilege, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
+	privilegeApiClient := nexus.NewPrivilegeClient(nexusApiClient.Security.Privilege)
+
+	if controllerutil.AddFinalizer(privilege, controllers.NexusOperatorFinalizer) {
+		err = r.client.Update(ctx, privilege)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update NexusPrivilege: %w", err)
+		}
+	}
+
+	if err = chain.NewCreatePrivilege(privilegeApiClient, ownership).ServeRequest(ctx, privilege); err != nil {
+		log.Error(err, "An error has occurred while handling NexusPrivilege")
+
+		privilege.Status.Value = common.StatusError
+		privilege.Status.Error = err.Error()
+		controllers.SetSyncFailed(&privilege.Status.ReconcileStatus, privilege.Generation, err)
+
+		if statusErr := r.updateNexusPrivilegeStatus(ctx, privilege, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return controllers.RequeueOnError(err), nil
+	}
+
+	privilege.Status.Value = common.StatusCreated
+	privilege.Status.Error = ""
+	controllers.SetSynced(&privilege.Status.ReconcileStatus, privilege.Generation)
+
+	if err = r.updateNexusPrivilegeStatus(ctx, privilege, oldStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return controllers.ResyncResult(ctx, privilege, r.resyncInterval), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NexusPrivilegeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor(controllers.EventRecorderName)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&nexusApi.NexusPrivilege{}).
+		Complete(r)
+	if err != nil {
+		return fmt.Errorf("failed to create controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *NexusPrivilegeReconciler) updateNexusPrivilegeStatus(
+	ctx context.Context,
+	privilege *nexusApi.NexusPrivilege,
+	oldStatus *nexusApi.NexusPrivilegeStatus,
+) error {
+	if equality.Semantic.DeepEqual(&privilege.Status, oldStatus) {
+		return nil
+	}
+
+	if err := r.client.Status().Update(ctx, privilege); err != nil {
+		return fmt.Errorf("failed to update NexusPrivilege status: %w", err)
+	}
+
+	return nil
+}
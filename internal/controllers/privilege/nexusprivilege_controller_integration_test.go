@@ -0,0 +1,111 @@
+package privilege
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+var _ = Describe("NexusPrivilege controller", func() {
+	nexusPrivilegeCRName := "nexus-privilege"
+	It("Should create NexusPrivilege object", func() {
+		By("By creating a new NexusPrivilege object")
+		newNexusPrivilege := &nexusApi.NexusPrivilege{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nexusPrivilegeCRName,
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusPrivilegeSpec{
+				Name:        "test-privilege",
+				Type:        nexusApi.PrivilegeTypeRepositoryView,
+				Description: "test privilege",
+				Actions:     []string{"BROWSE"},
+				Format:      "maven2",
+				Repository:  "maven-releases",
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusPrivilege)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusPrivilege := &nexusApi.NexusPrivilege{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusPrivilegeCRName, Namespace: namespace}, createdNexusPrivilege)
+			if err != nil {
+				return false
+			}
+
+			return createdNexusPrivilege.Status.Value == common.StatusCreated && createdNexusPrivilege.Status.Error == ""
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should update NexusPrivilege object", func() {
+		By("Getting NexusPrivilege object")
+		createdNexusPrivilege := &nexusApi.NexusPrivilege{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nexusPrivilegeCRName, Namespace: namespace}, createdNexusPrivilege)).
+			Should(Succeed())
+
+		By("Updating NexusPrivilege object")
+		createdNexusPrivilege.Spec.Description = "updated description"
+		createdNexusPrivilege.Spec.Actions = append(createdNexusPrivilege.Spec.Actions, "READ")
+
+		Expect(k8sClient.Update(ctx, createdNexusPrivilege)).Should(Succeed())
+		Consistently(func() bool {
+			createdNexusPrivilege := &nexusApi.NexusPrivilege{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusPrivilegeCRName, Namespace: namespace}, createdNexusPrivilege)
+			if err != nil {
+				return false
+			}
+
+			return createdNexusPrivilege.Status.Value == common.StatusCreated && createdNexusPrivilege.Status.Error == ""
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should delete NexusPrivilege object", func() {
+		By("Getting NexusPrivilege object")
+		createdNexusPrivilege := &nexusApi.NexusPrivilege{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nexusPrivilegeCRName, Namespace: namespace}, createdNexusPrivilege)).
+			Should(Succeed())
+
+		By("Deleting NexusPrivilege object")
+		Expect(k8sClient.Delete(ctx, createdNexusPrivilege)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusPrivilege := &nexusApi.NexusPrivilege{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusPrivilegeCRName, Namespace: namespace}, createdNexusPrivilege)
+			return k8sErrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should fail if repository not found", func() {
+		By("Creating a new NexusPrivilege object")
+		newNexusPrivilege := &nexusApi.NexusPrivilege{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "nexus-privilege-invalid",
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusPrivilegeSpec{
+				Name:       "nexus-privilege-invalid",
+				Type:       nexusApi.PrivilegeTypeRepositoryAdmin,
+				Actions:    []string{"BROWSE"},
+				Format:     "maven2",
+				Repository: "invalid-repository",
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusPrivilege)).Should(Succeed())
+		Eventually(func(g Gomega) {
+			createdNexusPrivilege := &nexusApi.NexusPrivilege{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: "nexus-privilege-invalid", Namespace: namespace}, createdNexusPrivilege)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(createdNexusPrivilege.Status.Value).Should(Equal(common.StatusError))
+			g.Expect(createdNexusPrivilege.Status.Error).ShouldNot(BeEmpty())
+		}).WithPolling(time.Second).WithTimeout(timeout).Should(Succeed())
+	})
+})
@@ -0,0 +1,68 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+const (
+	realmsKind = "NexusRealms"
+	// ActiveRealmsObjectName is the name of the active realms in the ownership record.
+	// Nexus has a single list of active realms, so only one NexusRealms can manage it.
+	ActiveRealmsObjectName = "active-realms"
+)
+
+// ApplyRealms is a handler for applying active realms.
+type ApplyRealms struct {
+	nexusRealmApiClient nexus.Realm
+	ownership           *controllers.OwnershipRecord
+}
+
+// NewApplyRealms creates an instance of ApplyRealms handler.
+func NewApplyRealms(nexusRealmApiClient nexus.Realm, ownership *controllers.OwnershipRecord) *ApplyRealms {
+	return &ApplyRealms{nexusRealmApiClient: nexusRealmApiClient, ownership: ownership}
+}
+
+// ServeRequest implements the logic of applying active realms.
+func (c ApplyRealms) ServeRequest(ctx context.Context, realms *nexusApi.NexusRealms) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("realms", realms.Spec.ActiveRealms)
+	log.Info("Start applying active realms")
+
+	if _, err := c.ownership.Acquire(ctx, realms, common.ManagementPolicyAdopt, ActiveRealmsObjectName, true); err != nil {
+		return err
+	}
+
+	active, err := c.nexusRealmApiClient.ListActive()
+	if err != nil {
+		return fmt.Errorf("failed to get active realms: %w", err)
+	}
+
+	if !slices.Equal(active, realms.Spec.ActiveRealms) {
+		controllers.ReportDrift(ctx, realmsKind, realms, &realms.Status.ReconcileStatus,
+			"Active realms were changed in nexus, reverting changes", "active", active)
+
+		log.Info("Updating active realms")
+
+		if err = c.nexusRealmApiClient.Activate(realms.Spec.ActiveRealms); err != nil {
+			return fmt.Errorf("failed to activate realms: %w", err)
+		}
+
+		if active, err = c.nexusRealmApiClient.ListActive(); err != nil {
+			return fmt.Errorf("failed to get active realms: %w", err)
+		}
+
+		log.Info("Active realms have been updated")
+	}
+
+	realms.Status.ActiveRealms = active
+
+	return nil
+}
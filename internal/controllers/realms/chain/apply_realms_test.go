@@ -0,0 +1,139 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/internal/controllers/testutils"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestApplyRealms_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	newRealms := func(name string, ids ...string) *nexusApi.NexusRealms {
+		return &nexusApi.NexusRealms{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				UID:       types.UID("uid-" + name),
+			},
+			Spec: nexusApi.NexusRealmsSpec{
+				ActiveRealms: ids,
+				NexusRef:     common.NexusRef{Name: "nexus"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		realms         *nexusApi.NexusRealms
+		existing       []client.Object
+		nexusApiClient func(t *testing.T) nexus.Realm
+		wantErr        require.ErrorAssertionFunc
+		wantActive     []string
+	}{
+		{
+			name:   "realms are up to date",
+			realms: newRealms("realms", "NexusAuthenticatingRealm", "DockerToken"),
+			nexusApiClient: func(t *testing.T) nexus.Realm {
+				m := mocks.NewMockRealm(t)
+
+				m.On("ListActive").Return([]string{"NexusAuthenticatingRealm", "DockerToken"}, nil)
+
+				return m
+			},
+			wantErr:    require.NoError,
+			wantActive: []string{"NexusAuthenticatingRealm", "DockerToken"},
+		},
+		{
+			name:   "realms order is changed",
+			realms: newRealms("realms", "DockerToken", "NexusAuthenticatingRealm"),
+			nexusApiClient: func(t *testing.T) nexus.Realm {
+				m := mocks.NewMockRealm(t)
+
+				m.On("ListActive").Return([]string{"NexusAuthenticatingRealm", "DockerToken"}, nil).Once()
+				m.On("Activate", []string{"DockerToken", "NexusAuthenticatingRealm"}).Return(nil)
+				m.On("ListActive").Return([]string{"DockerToken", "NexusAuthenticatingRealm"}, nil).Once()
+
+				return m
+			},
+			wantErr:    require.NoError,
+			wantActive: []string{"DockerToken", "NexusAuthenticatingRealm"},
+		},
+		{
+			name:   "failed to activate realms",
+			realms: newRealms("realms", "NexusAuthenticatingRealm", "SamlRealm"),
+			nexusApiClient: func(t *testing.T) nexus.Realm {
+				m := mocks.NewMockRealm(t)
+
+				m.On("ListActive").Return([]string{"NexusAuthenticatingRealm"}, nil)
+				m.On("Activate", []string{"NexusAuthenticatingRealm", "SamlRealm"}).
+					Return(errors.New("unknown realm SamlRealm"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to activate realms")
+			},
+		},
+		{
+			name:   "failed to get active realms",
+			realms: newRealms("realms", "NexusAuthenticatingRealm"),
+			nexusApiClient: func(t *testing.T) nexus.Realm {
+				m := mocks.NewMockRealm(t)
+
+				m.On("ListActive").Return(nil, errors.New("connection refused"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to get active realms")
+			},
+		},
+		{
+			name:     "realms are managed by another resource",
+			realms:   newRealms("realms", "NexusAuthenticatingRealm"),
+			existing: []client.Object{newRealms("other-realms", "NexusAuthenticatingRealm")},
+			nexusApiClient: func(t *testing.T) nexus.Realm {
+				return mocks.NewMockRealm(t)
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, controllers.ErrAlreadyManaged)
+				require.ErrorContains(t, err, "active-realms is already managed by default/other-realms")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := ctrl.LoggerInto(context.Background(), logr.Discard())
+			ownership := testutils.NewOwnershipRecord(t, tt.existing...)
+
+			for _, obj := range tt.existing {
+				_, err := ownership.Acquire(ctx, obj, common.ManagementPolicyAdopt, ActiveRealmsObjectName, true)
+				require.NoError(t, err)
+			}
+
+			err := NewApplyRealms(tt.nexusApiClient(t), ownership).ServeRequest(ctx, tt.realms)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantActive, tt.realms.Status.ActiveRealms)
+		})
+	}
+}
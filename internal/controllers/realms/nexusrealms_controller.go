@@ -0,0 +1,159 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/internal/controllers/realms/chain"
+)
+
+// NexusRealmsReconciler reconciles a NexusRealms object.
+type NexusRealmsReconciler struct {
+	client            client.Client
+	scheme            *runtime.Scheme
+	apiClientProvider controllers.ApiClientProvider
+	resyncInterval    time.Duration
+}
+
+func NewNexusRealmsReconciler(
+	k8sClient client.Client,
+	scheme *runtime.Scheme,
+	apiClientProvider controllers.ApiClientProvider,
+	resyncInterval time.Duration,
+) *NexusRealmsReconciler {
+	return &NexusRealmsReconciler{
+		client:            k8sClient,
+		scheme:            scheme,
+		apiClientProvider: apiClientProvider,
+		resyncInterval:    resyncInterval,
+	}
+}
+
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusrealms,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusrealms/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusrealms/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *NexusRealmsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling NexusRealms")
+
+	realms := &nexusApi.NexusRealms{}
+	if err := r.client.Get(ctx, req.NamespacedName, realms); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get NexusRealms: %w", err)
+	}
+
+	oldStatus := realms.Status.DeepCopy()
+	ownership := controllers.NewOwnershipRecord(r.client, realms.Namespace, realms.Spec.NexusRef)
+
+	// Nexus always has active realms, so they are left in nexus when the resource is deleted.
+	if realms.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(realms, controllers.NexusOperatorFinalizer) {
+			log.Info("Leaving active realms in nexus")
+
+			if err := ownership.Release(ctx, realms, chain.ActiveRealmsObjectName); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			controllerutil.RemoveFinalizer(realms, controllers.NexusOperatorFinalizer)
+
+			if err := r.client.Update(ctx, realms); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to update NexusRealms: %w", err)
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, realms.Namespace, realms)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&realms.Status.ReconcileStatus, realms.Generation, err)
+
+		if statusErr := r.updateNexusRealmsStatus(ctx, realms, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
+	if controllerutil.AddFinalizer(realms, controllers.NexusOperatorFinalizer) {
+		err = r.client.Update(ctx, realms)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update NexusRealms: %w", err)
+		}
+	}
+
+	if err = chain.NewApplyRealms(nexusApiClient.Security.Realm, ownership).ServeRequest(ctx, realms); err != nil {
+		log.Error(err, "An error has occurred while handling NexusRealms")
+
+		realms.Status.Value = common.StatusError
+		realms.Status.Error = err.Error()
+		controllers.SetSyncFailed(&realms.Status.ReconcileStatus, realms.Generation, err)
+
+		if statusErr := r.updateNexusRealmsStatus(ctx, realms, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return controllers.RequeueOnError(err), nil
+	}
+
+	realms.Status.Value = common.StatusCreated
+	realms.Status.Error = ""
+	controllers.SetSynced(&realms.Status.ReconcileStatus, realms.Generation)
+
+	if err = r.updateNexusRealmsStatus(ctx, realms, oldStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return controllers.ResyncResult(ctx, realms, r.resyncInterval), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NexusRealmsReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&nexusApi.NexusRealms{}).
+		Complete(r)
+	if err != nil {
+		return fmt.Errorf("failed to create controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *NexusRealmsReconciler) updateNexusRealmsStatus(
+	ctx context.Context,
+	realms *nexusApi.NexusRealms,
+	oldStatus *nexusApi.NexusRealmsStatus,
+) error {
+	if equality.Semantic.DeepEqual(&realms.Status, oldStatus) {
+		return nil
+	}
+
+	if err := r.client.Status().Update(ctx, realms); err != nil {
+		return fmt.Errorf("failed to update NexusRealms status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package realms
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+var _ = Describe("NexusRealms controller", func() {
+	nexusRealmsCRName := "nexus-realms"
+	It("Should create NexusRealms object", func() {
+		By("By creating a new NexusRealms object")
+		newNexusRealms := &nexusApi.NexusRealms{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nexusRealmsCRName,
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusRealmsSpec{
+				ActiveRealms: []string{"NexusAuthenticatingRealm", "DockerToken"},
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusRealms)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusRealms := &nexusApi.NexusRealms{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusRealmsCRName, Namespace: namespace}, createdNexusRealms)
+			if err != nil {
+				return false
+			}
+
+			return createdNexusRealms.Status.Value == common.StatusCreated &&
+				len(createdNexusRealms.Status.ActiveRealms) == 2
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should not allow second NexusRealms for the same Nexus", func() {
+		By("By creating a second NexusRealms object")
+		secondNexusRealms := &nexusApi.NexusRealms{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "second-nexus-realms",
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusRealmsSpec{
+				ActiveRealms: []string{"NexusAuthenticatingRealm"},
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, secondNexusRealms)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusRealms := &nexusApi.NexusRealms{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: "second-nexus-realms", Namespace: namespace}, createdNexusRealms)
+			if err != nil {
+				return false
+			}
+
+			return createdNexusRealms.Status.Value == common.StatusError
+		}, timeout, interval).Should(BeTrue())
+		Expect(k8sClient.Delete(ctx, secondNexusRealms)).Should(Succeed())
+	})
+	It("Should update NexusRealms object", func() {
+		By("Getting NexusRealms object")
+		createdNexusRealms := &nexusApi.NexusRealms{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nexusRealmsCRName, Namespace: namespace}, createdNexusRealms)).
+			Should(Succeed())
+
+		By("Updating NexusRealms object")
+		createdNexusRealms.Spec.ActiveRealms = []string{"NexusAuthenticatingRealm"}
+
+		Expect(k8sClient.Update(ctx, createdNexusRealms)).Should(Succeed())
+		Eventually(func() bool {
+			updatedNexusRealms := &nexusApi.NexusRealms{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusRealmsCRName, Namespace: namespace}, updatedNexusRealms)
+			if err != nil {
+				return false
+			}
+
+			return len(updatedNexusRealms.Status.ActiveRealms) == 1
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should delete NexusRealms object", func() {
+		By("Deleting NexusRealms object")
+		createdNexusRealms := &nexusApi.NexusRealms{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nexusRealmsCRName, Namespace: namespace}, createdNexusRealms)).
+			Should(Succeed())
+		Expect(k8sClient.Delete(ctx, createdNexusRealms)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusRealms := &nexusApi.NexusRealms{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusRealmsCRName, Namespace: namespace}, createdNexusRealms)
+			return k8sErrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+})
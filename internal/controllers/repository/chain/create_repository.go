@@ -5,20 +5,49 @@ import (
 	"errors"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/epam/edp-nexus-operator/api/common"
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
+const (
+	repositoryKind = "NexusRepository"
+
+	// ReasonRenamed is the reason of the event recorded when the repository is renamed.
+	ReasonRenamed = "Renamed"
+)
+
 // CreateRepository is a handler for creating repository.
 type CreateRepository struct {
-	nexusRepositoryApiClient nexus.Repository
+	nexusRepositoryApiClient  nexus.Repository
+	nexusRoutingRuleApiClient nexus.RoutingRule
+	k8sClient                 client.Client
+	ownership                 *controllers.OwnershipRecord
+	recorder                  record.EventRecorder
 }
 
 // NewCreateRepository creates an instance of CreateRepository handler.
-func NewCreateRepository(nexusRepositoryApiClient nexus.Repository) *CreateRepository {
-	return &CreateRepository{nexusRepositoryApiClient: nexusRepositoryApiClient}
+func NewCreateRepository(
+	nexusRepositoryApiClient nexus.Repository,
+	nexusRoutingRuleApiClient nexus.RoutingRule,
+	k8sClient client.Client,
+	ownership *controllers.OwnershipRecord,
+	recorder record.EventRecorder,
+) *CreateRepository {
+	return &CreateRepository{
+		nexusRepositoryApiClient:  nexusRepositoryApiClient,
+		nexusRoutingRuleApiClient: nexusRoutingRuleApiClient,
+		k8sClient:                 k8sClient,
+		ownership:                 ownership,
+		recorder:                  recorder,
+	}
 }
 
 // ServeRequest implements the logic of creating repository.
@@ -26,39 +55,170 @@ func (c *CreateRepository) ServeRequest(ctx context.Context, repository *nexusAp
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Start creating repository")
 
-	repoData, err := nexus.GetRepoData(&repository.Spec)
+	// the spec is copied because secret references are replaced with their values
+	repoData, err := nexus.GetRepoData(repository.Spec.DeepCopy())
 	if err != nil {
 		return fmt.Errorf("failed to get repository data: %w", err)
 	}
 
+	secretsVersion, err := resolveRepositorySecrets(ctx, c.k8sClient, repoData.Data, repository.Namespace)
+	if err != nil {
+		return err
+	}
+
 	log = log.WithValues("type", repoData.Type, "format", repoData.Format, "name", repoData.Name)
 
 	log.Info("Getting repository")
 
-	_, err = c.nexusRepositoryApiClient.Get(ctx, repoData.Name, repoData.Format, repoData.Type)
+	nexusRepository, err := c.nexusRepositoryApiClient.Get(ctx, repoData.Name, repoData.Format, repoData.Type)
+	if err != nil && !errors.Is(err, nexus.ErrNotFound) {
+		return fmt.Errorf("failed to get repository: %w", err)
+	}
+
+	exists := err == nil
+
+	manage, err := c.ownership.Acquire(ctx, repository, repository.Spec.ManagementPolicy, repoData.Name, exists)
 	if err != nil {
-		if errors.Is(err, nexus.ErrNotFound) {
-			log.Info("Repository doesn't exist, creating new one")
+		return err
+	}
+
+	if !manage {
+		repository.Status.NexusName = repoData.Name
 
-			if err = c.nexusRepositoryApiClient.Create(ctx, repoData.Format, repoData.Type, repoData.Data); err != nil {
-				return fmt.Errorf("failed to create repository: %w", err)
-			}
+		return nil
+	}
 
-			log.Info("Repository has been created")
+	if err = checkRoutingRule(c.nexusRoutingRuleApiClient, repoData.Data); err != nil {
+		return err
+	}
 
-			return nil
+	if err = c.applyRepository(ctrl.LoggerInto(ctx, log), repository, repoData, nexusRepository, exists, secretsVersion); err != nil {
+		return err
+	}
+
+	return c.removeRenamedRepository(ctx, repository, repoData.Name)
+}
+
+// applyRepository creates the repository in nexus or updates it if it differs from the spec.
+func (c *CreateRepository) applyRepository(
+	ctx context.Context,
+	repository *nexusApi.NexusRepository,
+	repoData *nexus.RepoData,
+	nexusRepository map[string]interface{},
+	exists bool,
+	secretsVersion string,
+) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if !exists {
+		if !controllers.ReportDrift(ctx, repositoryKind, repository, &repository.Status.ReconcileStatus,
+			"Repository was deleted in nexus, recreating it") {
+			log.Info("Repository doesn't exist, creating new one")
 		}
 
-		return fmt.Errorf("failed to get repository: %w", err)
+		if err := c.nexusRepositoryApiClient.Create(ctx, repoData.Format, repoData.Type, repoData.Data); err != nil {
+			return fmt.Errorf("failed to create repository: %w", err)
+		}
+
+		repository.Status.SecretsVersion = secretsVersion
+
+		log.Info("Repository has been created")
+
+		return nil
+	}
+
+	changes, err := repositoryChanges(repoData.Data, nexusRepository)
+	if err != nil {
+		return fmt.Errorf("failed to compare repository: %w", err)
 	}
 
-	log.Info("Updating repository")
+	// write-only fields, e.g. passwords and signing keys, can't be compared,
+	// so the repository is also updated when the spec or the referenced Secrets change.
+	specChanged := !controllers.IsSynced(&repository.Status.ReconcileStatus, repository.Generation)
+	secretsChanged := repository.Status.SecretsVersion != secretsVersion
+
+	if len(changes) == 0 && !specChanged && !secretsChanged {
+		log.Info("Repository is up to date")
+
+		return nil
+	}
+
+	if len(changes) > 0 && controllers.ReportDrift(ctx, repositoryKind, repository, &repository.Status.ReconcileStatus,
+		"Repository was changed in nexus, reverting changes", "fields", changes) {
+		repository.Status.Drift = &nexusApi.RepositoryDrift{
+			Fields:       changes,
+			DetectedTime: metav1.Now(),
+		}
+	}
+
+	log.Info("Updating repository", "fields", changes)
 
 	if err = c.nexusRepositoryApiClient.Update(ctx, repoData.Name, repoData.Format, repoData.Type, repoData.Data); err != nil {
 		return fmt.Errorf("failed to update repository: %w", err)
 	}
 
+	repository.Status.SecretsVersion = secretsVersion
+
 	log.Info("Repository has been updated")
 
 	return nil
 }
+
+// removeRenamedRepository handles the repository with the previously applied name when the repository is renamed.
+// The old repository is left in nexus unless RenameDeleteOldAnnotation is set, because deleting it deletes its content.
+// The deletion policy Orphan always leaves the old repository in nexus.
+func (c *CreateRepository) removeRenamedRepository(
+	ctx context.Context,
+	repository *nexusApi.NexusRepository,
+	name string,
+) error {
+	oldName := repository.Status.NexusName
+	if oldName == "" || oldName == name {
+		repository.Status.NexusName = name
+
+		return nil
+	}
+
+	log := ctrl.LoggerFrom(ctx).WithValues("old_name", oldName, "name", name)
+
+	canDelete, err := c.ownership.CanDelete(ctx, repository, repository.Spec.ManagementPolicy, oldName)
+	if err != nil {
+		return err
+	}
+
+	if canDelete &&
+		repository.Annotations[nexusApi.RenameDeleteOldAnnotation] == "true" &&
+		repository.Spec.DeletionPolicy != common.DeletionPolicyOrphan {
+		log.Info("Repository was renamed, deleting repository with the old name")
+
+		if err = c.nexusRepositoryApiClient.Delete(ctx, oldName); err != nil && !nexus.IsErrNotFound(err) {
+			return fmt.Errorf("failed to delete repository %s after rename: %w", oldName, err)
+		}
+
+		c.recorder.Eventf(repository, corev1.EventTypeNormal, ReasonRenamed,
+			"Repository was renamed from %s to %s, repository %s was deleted with its content", oldName, name, oldName)
+	} else {
+		log.Info("Repository was renamed, leaving repository with the old name in nexus")
+
+		c.recorder.Eventf(repository, corev1.EventTypeNormal, ReasonRenamed,
+			"Repository was renamed from %s to %s, repository %s was left in nexus", oldName, name, oldName)
+	}
+
+	if err = c.ownership.Release(ctx, repository, oldName); err != nil {
+		return err
+	}
+
+	repository.Status.NexusName = name
+
+	return nil
+}
+
+// RepositoryName returns the name of the repository in nexus.
+func RepositoryName(spec *nexusApi.NexusRepositorySpec) (string, error) {
+	repoData, err := nexus.GetRepoData(spec)
+	if err != nil {
+		return "", fmt.Errorf("failed to get repository data: %w", err)
+	}
+
+	return repoData.Name, nil
+}
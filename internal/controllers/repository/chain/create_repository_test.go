@@ -5,12 +5,22 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/go-logr/logr"
 	testifymock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/epam/edp-nexus-operator/api/common"
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
 )
@@ -22,7 +32,13 @@ func TestCreateRepository_ServeRequest(t *testing.T) {
 		name                     string
 		repository               *nexusApi.NexusRepository
 		nexusRepositoryApiClient func(t *testing.T) nexus.Repository
+		routingRuleApiClient     func(t *testing.T) nexus.RoutingRule
+		objects                  []client.Object
 		wantErr                  require.ErrorAssertionFunc
+		wantDriftFields          []string
+		wantSecretsVersion       string
+		wantNexusName            string
+		wantEvent                string
 	}{
 		{
 			name: "repository doesn't exist, creating new one",
@@ -82,6 +98,422 @@ func TestCreateRepository_ServeRequest(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "repository is up to date, skipping update",
+			repository: &nexusApi.NexusRepository{
+				Spec: nexusApi.NexusRepositorySpec{
+					Go: &nexusApi.GoSpec{
+						Proxy: &nexusApi.GoProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name:   "go-proxy",
+								Online: true,
+							},
+						},
+					},
+				},
+				Status: nexusApi.NexusRepositoryStatus{
+					ReconcileStatus: common.ReconcileStatus{
+						Conditions: []metav1.Condition{
+							{Type: common.ConditionSynced, Status: metav1.ConditionTrue},
+						},
+					},
+				},
+			},
+			nexusRepositoryApiClient: func(t *testing.T) nexus.Repository {
+				m := mocks.NewMockRepository(t)
+
+				m.On("Get", testifymock.Anything, "go-proxy", nexus.FormatGo, nexus.TypeProxy).
+					Return(map[string]interface{}{"name": "go-proxy", "online": true, "format": "go"}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "repository was changed in nexus, reverting changes",
+			repository: &nexusApi.NexusRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 2,
+				},
+				Spec: nexusApi.NexusRepositorySpec{
+					Go: &nexusApi.GoSpec{
+						Proxy: &nexusApi.GoProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name:   "go-proxy",
+								Online: true,
+							},
+						},
+					},
+				},
+				Status: nexusApi.NexusRepositoryStatus{
+					ReconcileStatus: common.ReconcileStatus{
+						ObservedGeneration: 2,
+						Conditions: []metav1.Condition{
+							{Type: common.ConditionSynced, Status: metav1.ConditionTrue},
+						},
+					},
+				},
+			},
+			nexusRepositoryApiClient: func(t *testing.T) nexus.Repository {
+				m := mocks.NewMockRepository(t)
+
+				m.On("Get", testifymock.Anything, "go-proxy", nexus.FormatGo, nexus.TypeProxy).
+					Return(map[string]interface{}{"name": "go-proxy", "online": false}, nil)
+				m.On("Update", testifymock.Anything, "go-proxy", nexus.FormatGo, nexus.TypeProxy, testifymock.Anything).
+					Return(nil)
+
+				return m
+			},
+			wantErr:         require.NoError,
+			wantDriftFields: []string{"online"},
+		},
+		{
+			name: "repository with remote credentials from secret",
+			repository: &nexusApi.NexusRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: nexusApi.NexusRepositorySpec{
+					Go: &nexusApi.GoSpec{
+						Proxy: &nexusApi.GoProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name: "go-proxy",
+								HTTPClient: nexusApi.HTTPClient{
+									Authentication: &nexusApi.HTTPClientAuthentication{
+										Type: "username",
+										UsernameRef: &common.SourceRef{
+											SecretKeyRef: &common.SecretKeySelector{
+												LocalObjectReference: corev1.LocalObjectReference{Name: "remote-credentials"},
+												Key:                  "username",
+											},
+										},
+										PasswordRef: &common.SourceRef{
+											SecretKeyRef: &common.SecretKeySelector{
+												LocalObjectReference: corev1.LocalObjectReference{Name: "remote-credentials"},
+												Key:                  "password",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "remote-credentials", Namespace: "default"},
+					Data: map[string][]byte{
+						"username": []byte("user"),
+						"password": []byte("secret"),
+					},
+				},
+			},
+			nexusRepositoryApiClient: func(t *testing.T) nexus.Repository {
+				m := mocks.NewMockRepository(t)
+
+				m.On("Get", testifymock.Anything, "go-proxy", nexus.FormatGo, nexus.TypeProxy).
+					Return(nil, nexus.ErrNotFound)
+				m.On("Create", testifymock.Anything, nexus.FormatGo, nexus.TypeProxy, &nexusApi.GoProxyRepository{
+					ProxySpec: nexusApi.ProxySpec{
+						Name: "go-proxy",
+						HTTPClient: nexusApi.HTTPClient{
+							Authentication: &nexusApi.HTTPClientAuthentication{
+								Type:     "username",
+								Username: "user",
+								Password: "secret",
+							},
+						},
+					},
+				}).Return(nil)
+
+				return m
+			},
+			wantErr:            require.NoError,
+			wantSecretsVersion: "remote-credentials=999",
+		},
+		{
+			name: "remote credentials secret not found",
+			repository: &nexusApi.NexusRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: nexusApi.NexusRepositorySpec{
+					Maven: &nexusApi.MavenSpec{
+						Proxy: &nexusApi.MavenProxyRepository{
+							Name: "maven-proxy",
+							HTTPClient: nexusApi.HTTPClientWithPreemptiveAuth{
+								Authentication: &nexusApi.HTTPClientAuthenticationWithPreemptive{
+									Username: "user",
+									PasswordRef: &common.SourceRef{
+										SecretKeyRef: &common.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: "remote-credentials"},
+											Key:                  "password",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			nexusRepositoryApiClient: func(t *testing.T) nexus.Repository {
+				return mocks.NewMockRepository(t)
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get password")
+			},
+		},
+		{
+			name: "apt repository with signing key from secret",
+			repository: &nexusApi.NexusRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: nexusApi.NexusRepositorySpec{
+					Apt: &nexusApi.AptSpec{
+						Hosted: &nexusApi.AptHostedRepository{
+							HostedSpec: nexusApi.HostedSpec{
+								Name: "apt-hosted",
+							},
+							AptSigning: nexusApi.AptSigning{
+								KeypairRef: &common.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "apt-signing"},
+									Key:                  "keypair",
+								},
+								PassphraseRef: &common.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "apt-signing"},
+									Key:                  "passphrase",
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "apt-signing", Namespace: "default"},
+					Data: map[string][]byte{
+						"keypair":    []byte("private-key"),
+						"passphrase": []byte("passphrase"),
+					},
+				},
+			},
+			nexusRepositoryApiClient: func(t *testing.T) nexus.Repository {
+				m := mocks.NewMockRepository(t)
+
+				m.On("Get", testifymock.Anything, "apt-hosted", nexus.FormatApt, nexus.TypeHosted).
+					Return(nil, nexus.ErrNotFound)
+				m.On("Create", testifymock.Anything, nexus.FormatApt, nexus.TypeHosted, &nexusApi.AptHostedRepository{
+					HostedSpec: nexusApi.HostedSpec{
+						Name: "apt-hosted",
+					},
+					AptSigning: nexusApi.AptSigning{
+						Keypair:    "private-key",
+						Passphrase: ptr.To("passphrase"),
+					},
+				}).Return(nil)
+
+				return m
+			},
+			wantErr:            require.NoError,
+			wantSecretsVersion: "apt-signing=999",
+		},
+		{
+			name: "signing key secret was changed, updating repository",
+			repository: &nexusApi.NexusRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: nexusApi.NexusRepositorySpec{
+					Yum: &nexusApi.YumSpec{
+						Proxy: &nexusApi.YumProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name: "yum-proxy",
+							},
+							YumSigning: &nexusApi.YumSigning{
+								KeypairRef: &common.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "yum-signing"},
+									Key:                  "keypair",
+								},
+							},
+						},
+					},
+				},
+				Status: nexusApi.NexusRepositoryStatus{
+					ReconcileStatus: common.ReconcileStatus{
+						Conditions: []metav1.Condition{
+							{Type: common.ConditionSynced, Status: metav1.ConditionTrue},
+						},
+					},
+					SecretsVersion: "yum-signing=1",
+				},
+			},
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "yum-signing", Namespace: "default"},
+					Data: map[string][]byte{
+						"keypair": []byte("new-private-key"),
+					},
+				},
+			},
+			nexusRepositoryApiClient: func(t *testing.T) nexus.Repository {
+				m := mocks.NewMockRepository(t)
+
+				m.On("Get", testifymock.Anything, "yum-proxy", nexus.FormatYum, nexus.TypeProxy).
+					Return(map[string]interface{}{"name": "yum-proxy"}, nil)
+				m.On("Update", testifymock.Anything, "yum-proxy", nexus.FormatYum, nexus.TypeProxy, &nexusApi.YumProxyRepository{
+					ProxySpec: nexusApi.ProxySpec{
+						Name: "yum-proxy",
+					},
+					YumSigning: &nexusApi.YumSigning{
+						Keypair: ptr.To("new-private-key"),
+					},
+				}).Return(nil)
+
+				return m
+			},
+			wantErr:            require.NoError,
+			wantSecretsVersion: "yum-signing=999",
+		},
+		{
+			name: "signing key not found in secret",
+			repository: &nexusApi.NexusRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: nexusApi.NexusRepositorySpec{
+					Apt: &nexusApi.AptSpec{
+						Hosted: &nexusApi.AptHostedRepository{
+							HostedSpec: nexusApi.HostedSpec{
+								Name: "apt-hosted",
+							},
+							AptSigning: nexusApi.AptSigning{
+								KeypairRef: &common.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "apt-signing"},
+									Key:                  "keypair",
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "apt-signing", Namespace: "default"},
+					Data: map[string][]byte{
+						"other": []byte("private-key"),
+					},
+				},
+			},
+			nexusRepositoryApiClient: func(t *testing.T) nexus.Repository {
+				return mocks.NewMockRepository(t)
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get keypair")
+				require.NotContains(t, err.Error(), "private-key")
+			},
+		},
+		{
+			name: "repository was renamed, leaving repository with the old name by default",
+			repository: &nexusApi.NexusRepository{
+				Spec: nexusApi.NexusRepositorySpec{
+					Go: &nexusApi.GoSpec{
+						Proxy: &nexusApi.GoProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name: "go-proxy-new",
+							},
+						},
+					},
+				},
+				Status: nexusApi.NexusRepositoryStatus{
+					NexusName: "go-proxy",
+				},
+			},
+			nexusRepositoryApiClient: func(t *testing.T) nexus.Repository {
+				m := mocks.NewMockRepository(t)
+
+				m.On("Get", testifymock.Anything, "go-proxy-new", nexus.FormatGo, nexus.TypeProxy).
+					Return(nil, nexus.ErrNotFound)
+				m.On("Create", testifymock.Anything, nexus.FormatGo, nexus.TypeProxy, testifymock.Anything).
+					Return(nil)
+
+				return m
+			},
+			wantErr:       require.NoError,
+			wantNexusName: "go-proxy-new",
+			wantEvent:     "repository go-proxy was left in nexus",
+		},
+		{
+			name: "repository was renamed, deleting repository with the old name",
+			repository: &nexusApi.NexusRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{nexusApi.RenameDeleteOldAnnotation: "true"},
+				},
+				Spec: nexusApi.NexusRepositorySpec{
+					Go: &nexusApi.GoSpec{
+						Proxy: &nexusApi.GoProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name: "go-proxy-new",
+							},
+						},
+					},
+				},
+				Status: nexusApi.NexusRepositoryStatus{
+					NexusName: "go-proxy",
+				},
+			},
+			nexusRepositoryApiClient: func(t *testing.T) nexus.Repository {
+				m := mocks.NewMockRepository(t)
+
+				m.On("Get", testifymock.Anything, "go-proxy-new", nexus.FormatGo, nexus.TypeProxy).
+					Return(nil, nexus.ErrNotFound)
+				m.On("Create", testifymock.Anything, nexus.FormatGo, nexus.TypeProxy, testifymock.Anything).
+					Return(nil)
+				m.On("Delete", testifymock.Anything, "go-proxy").
+					Return(nil)
+
+				return m
+			},
+			wantErr:       require.NoError,
+			wantNexusName: "go-proxy-new",
+			wantEvent:     "repository go-proxy was deleted with its content",
+		},
+		{
+			name: "repository was renamed, leaving orphaned repository with the old name",
+			repository: &nexusApi.NexusRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{nexusApi.RenameDeleteOldAnnotation: "true"},
+				},
+				Spec: nexusApi.NexusRepositorySpec{
+					Go: &nexusApi.GoSpec{
+						Proxy: &nexusApi.GoProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name: "go-proxy-new",
+							},
+						},
+					},
+					DeletionPolicy: common.DeletionPolicyOrphan,
+				},
+				Status: nexusApi.NexusRepositoryStatus{
+					NexusName: "go-proxy",
+				},
+			},
+			nexusRepositoryApiClient: func(t *testing.T) nexus.Repository {
+				m := mocks.NewMockRepository(t)
+
+				m.On("Get", testifymock.Anything, "go-proxy-new", nexus.FormatGo, nexus.TypeProxy).
+					Return(nil, nexus.ErrNotFound)
+				m.On("Create", testifymock.Anything, nexus.FormatGo, nexus.TypeProxy, testifymock.Anything).
+					Return(nil)
+
+				return m
+			},
+			wantErr:       require.NoError,
+			wantNexusName: "go-proxy-new",
+		},
 		{
 			name: "failed to update repository",
 			repository: &nexusApi.NexusRepository{
@@ -146,6 +578,73 @@ func TestCreateRepository_ServeRequest(t *testing.T) {
 				require.Contains(t, err.Error(), "failed to create repository")
 			},
 		},
+		{
+			name: "repository with routing rule, creating new one",
+			repository: &nexusApi.NexusRepository{
+				Spec: nexusApi.NexusRepositorySpec{
+					Go: &nexusApi.GoSpec{
+						Proxy: &nexusApi.GoProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name:        "go-proxy",
+								RoutingRule: ptr.To("go-proxy-routing-rule"),
+							},
+						},
+					},
+				},
+			},
+			nexusRepositoryApiClient: func(t *testing.T) nexus.Repository {
+				m := mocks.NewMockRepository(t)
+
+				m.On("Get", testifymock.Anything, "go-proxy", nexus.FormatGo, nexus.TypeProxy).
+					Return(nil, nexus.ErrNotFound)
+				m.On("Create", testifymock.Anything, nexus.FormatGo, nexus.TypeProxy, testifymock.Anything).
+					Return(nil)
+
+				return m
+			},
+			routingRuleApiClient: func(t *testing.T) nexus.RoutingRule {
+				m := mocks.NewMockRoutingRule(t)
+
+				m.On("Get", "go-proxy-routing-rule").
+					Return(&schema.RoutingRule{Name: "go-proxy-routing-rule"}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "routing rule doesn't exist, waiting for it",
+			repository: &nexusApi.NexusRepository{
+				Spec: nexusApi.NexusRepositorySpec{
+					Maven: &nexusApi.MavenSpec{
+						Proxy: &nexusApi.MavenProxyRepository{
+							Name:        "maven-proxy",
+							RoutingRule: ptr.To("maven-routing-rule"),
+						},
+					},
+				},
+			},
+			nexusRepositoryApiClient: func(t *testing.T) nexus.Repository {
+				m := mocks.NewMockRepository(t)
+
+				m.On("Get", testifymock.Anything, "maven-proxy", nexus.FormatMaven, nexus.TypeProxy).
+					Return(nil, nexus.ErrNotFound)
+
+				return m
+			},
+			routingRuleApiClient: func(t *testing.T) nexus.RoutingRule {
+				m := mocks.NewMockRoutingRule(t)
+
+				m.On("Get", "maven-routing-rule").
+					Return(nil, nexus.ErrNotFound)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrRoutingRuleNotFound)
+				require.ErrorContains(t, err, "maven-routing-rule")
+			},
+		},
 		{
 			name: "failed to get repository",
 			repository: &nexusApi.NexusRepository{
@@ -178,9 +677,43 @@ func TestCreateRepository_ServeRequest(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			c := NewCreateRepository(tt.nexusRepositoryApiClient(t))
+			scheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(scheme))
+			require.NoError(t, nexusApi.AddToScheme(scheme))
+
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+
+			ownership := controllers.NewOwnershipRecord(k8sClient, "default", common.NexusRef{Name: "nexus"})
+
+			routingRuleApiClient := nexus.RoutingRule(mocks.NewMockRoutingRule(t))
+			if tt.routingRuleApiClient != nil {
+				routingRuleApiClient = tt.routingRuleApiClient(t)
+			}
+
+			recorder := record.NewFakeRecorder(10)
+
+			c := NewCreateRepository(tt.nexusRepositoryApiClient(t), routingRuleApiClient, k8sClient, ownership, recorder)
 			err := c.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.repository)
 			tt.wantErr(t, err)
+
+			if tt.wantEvent != "" {
+				require.Len(t, recorder.Events, 1)
+				require.Contains(t, <-recorder.Events, tt.wantEvent)
+			}
+			require.Equal(t, tt.wantSecretsVersion, tt.repository.Status.SecretsVersion)
+
+			if tt.wantNexusName != "" {
+				require.Equal(t, tt.wantNexusName, tt.repository.Status.NexusName)
+			}
+
+			if tt.wantDriftFields == nil {
+				require.Nil(t, tt.repository.Status.Drift)
+
+				return
+			}
+
+			require.NotNil(t, tt.repository.Status.Drift)
+			require.Equal(t, tt.wantDriftFields, tt.repository.Status.Drift.Fields)
 		})
 	}
 }
@@ -0,0 +1,287 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/helper"
+)
+
+var errEmptyValue = errors.New("referenced value is empty")
+
+// remoteCredentials contains pointers to the credentials of the remote repository of the proxy repository.
+type remoteCredentials struct {
+	username    *string
+	usernameRef **common.SourceRef
+	password    *string
+	passwordRef **common.SourceRef
+}
+
+// getRemoteCredentials returns credentials of the remote repository from the repository data.
+// It returns nil if the repository doesn't use authentication.
+func getRemoteCredentials(data interface{}) *remoteCredentials {
+	switch repo := data.(type) {
+	case *nexusApi.MavenProxyRepository:
+		if auth := repo.HTTPClient.Authentication; auth != nil {
+			return &remoteCredentials{
+				username:    &auth.Username,
+				usernameRef: &auth.UsernameRef,
+				password:    &auth.Password,
+				passwordRef: &auth.PasswordRef,
+			}
+		}
+	case interface {
+		GetHTTPClientAuthentication() *nexusApi.HTTPClientAuthentication
+	}:
+		if auth := repo.GetHTTPClientAuthentication(); auth != nil {
+			return &remoteCredentials{
+				username:    &auth.Username,
+				usernameRef: &auth.UsernameRef,
+				password:    &auth.Password,
+				passwordRef: &auth.PasswordRef,
+			}
+		}
+	}
+
+	return nil
+}
+
+// signingKeyRefs returns references to the Secrets with PGP signing keys of apt and yum repositories.
+func signingKeyRefs(data interface{}) []*common.SecretKeySelector {
+	switch repo := data.(type) {
+	case *nexusApi.AptHostedRepository:
+		return []*common.SecretKeySelector{repo.AptSigning.KeypairRef, repo.AptSigning.PassphraseRef}
+	case *nexusApi.YumGroupRepository:
+		if repo.YumSigning != nil {
+			return []*common.SecretKeySelector{repo.YumSigning.KeypairRef, repo.YumSigning.PassphraseRef}
+		}
+	case *nexusApi.YumProxyRepository:
+		if repo.YumSigning != nil {
+			return []*common.SecretKeySelector{repo.YumSigning.KeypairRef, repo.YumSigning.PassphraseRef}
+		}
+	}
+
+	return nil
+}
+
+// secretResolver gets values referenced by the repository from Secrets and ConfigMaps.
+// It records resource versions of the Secrets, so the repository can be updated when the Secrets change.
+// Errors never contain the values.
+type secretResolver struct {
+	k8sClient client.Client
+	namespace string
+	versions  map[string]string
+}
+
+// resolveRepositorySecrets replaces the references in the repository data with their values.
+// The references are removed from the data, so they are not sent to nexus.
+// It returns resource versions of the referenced Secrets.
+func resolveRepositorySecrets(
+	ctx context.Context,
+	k8sClient client.Client,
+	data interface{},
+	namespace string,
+) (string, error) {
+	r := &secretResolver{
+		k8sClient: k8sClient,
+		namespace: namespace,
+		versions:  make(map[string]string),
+	}
+
+	if err := r.resolveRemoteCredentials(ctx, data); err != nil {
+		return "", fmt.Errorf("failed to get remote repository credentials: %w", err)
+	}
+
+	if err := r.resolveSigningKeys(ctx, data); err != nil {
+		return "", fmt.Errorf("failed to get signing key: %w", err)
+	}
+
+	return r.secretsVersion(), nil
+}
+
+func (r *secretResolver) resolveRemoteCredentials(ctx context.Context, data interface{}) error {
+	credentials := getRemoteCredentials(data)
+	if credentials == nil {
+		return nil
+	}
+
+	if err := r.resolveSourceRef(ctx, credentials.username, *credentials.usernameRef); err != nil {
+		return fmt.Errorf("failed to get username: %w", err)
+	}
+
+	if err := r.resolveSourceRef(ctx, credentials.password, *credentials.passwordRef); err != nil {
+		return fmt.Errorf("failed to get password: %w", err)
+	}
+
+	*credentials.usernameRef = nil
+	*credentials.passwordRef = nil
+
+	return nil
+}
+
+func (r *secretResolver) resolveSigningKeys(ctx context.Context, data interface{}) error {
+	switch repo := data.(type) {
+	case *nexusApi.AptHostedRepository:
+		signing := &repo.AptSigning
+
+		if signing.KeypairRef != nil {
+			keypair, err := r.secretValue(ctx, signing.KeypairRef)
+			if err != nil {
+				return fmt.Errorf("failed to get keypair: %w", err)
+			}
+
+			signing.Keypair = keypair
+		}
+
+		if err := r.resolveSecretKeyRef(ctx, &signing.Passphrase, signing.PassphraseRef); err != nil {
+			return fmt.Errorf("failed to get passphrase: %w", err)
+		}
+
+		signing.KeypairRef = nil
+		signing.PassphraseRef = nil
+	case *nexusApi.YumGroupRepository:
+		return r.resolveYumSigning(ctx, repo.YumSigning)
+	case *nexusApi.YumProxyRepository:
+		return r.resolveYumSigning(ctx, repo.YumSigning)
+	}
+
+	return nil
+}
+
+func (r *secretResolver) resolveYumSigning(ctx context.Context, signing *nexusApi.YumSigning) error {
+	if signing == nil {
+		return nil
+	}
+
+	if err := r.resolveSecretKeyRef(ctx, &signing.Keypair, signing.KeypairRef); err != nil {
+		return fmt.Errorf("failed to get keypair: %w", err)
+	}
+
+	if err := r.resolveSecretKeyRef(ctx, &signing.Passphrase, signing.PassphraseRef); err != nil {
+		return fmt.Errorf("failed to get passphrase: %w", err)
+	}
+
+	signing.KeypairRef = nil
+	signing.PassphraseRef = nil
+
+	return nil
+}
+
+func (r *secretResolver) resolveSourceRef(ctx context.Context, value *string, ref *common.SourceRef) error {
+	if ref == nil {
+		return nil
+	}
+
+	if ref.SecretKeyRef != nil {
+		v, err := r.secretValue(ctx, ref.SecretKeyRef)
+		if err != nil {
+			return err
+		}
+
+		*value = v
+
+		return nil
+	}
+
+	v, err := helper.GetValueFromSourceRef(ctx, ref, r.namespace, r.k8sClient)
+	if err != nil {
+		return err
+	}
+
+	if v == "" {
+		return errEmptyValue
+	}
+
+	*value = v
+
+	return nil
+}
+
+func (r *secretResolver) resolveSecretKeyRef(ctx context.Context, value **string, ref *common.SecretKeySelector) error {
+	if ref == nil {
+		return nil
+	}
+
+	v, err := r.secretValue(ctx, ref)
+	if err != nil {
+		return err
+	}
+
+	*value = &v
+
+	return nil
+}
+
+func (r *secretResolver) secretValue(ctx context.Context, ref *common.SecretKeySelector) (string, error) {
+	secret := &corev1.Secret{}
+	if err := r.k8sClient.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: r.namespace}, secret); err != nil {
+		return "", fmt.Errorf("failed to get secret %s: %w", ref.Name, err)
+	}
+
+	r.versions[secret.Name] = secret.ResourceVersion
+
+	v, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s", ref.Key, ref.Name)
+	}
+
+	if len(v) == 0 {
+		return "", errEmptyValue
+	}
+
+	return string(v), nil
+}
+
+// secretsVersion returns resource versions of the resolved Secrets in the form "name=version,...".
+func (r *secretResolver) secretsVersion() string {
+	names := make([]string, 0, len(r.versions))
+	for name := range r.versions {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	versions := make([]string, 0, len(names))
+	for _, name := range names {
+		versions = append(versions, name+"="+r.versions[name])
+	}
+
+	return strings.Join(versions, ",")
+}
+
+// ReferencedSecrets returns names of the Secrets referenced by the repository,
+// e.g. credentials of the remote repository or PGP signing keys.
+func ReferencedSecrets(spec *nexusApi.NexusRepositorySpec) []string {
+	repoData, err := nexus.GetRepoData(spec)
+	if err != nil {
+		return nil
+	}
+
+	var secrets []string
+
+	if credentials := getRemoteCredentials(repoData.Data); credentials != nil {
+		for _, ref := range []*common.SourceRef{*credentials.usernameRef, *credentials.passwordRef} {
+			if ref != nil && ref.SecretKeyRef != nil {
+				secrets = append(secrets, ref.SecretKeyRef.Name)
+			}
+		}
+	}
+
+	for _, ref := range signingKeyRefs(repoData.Data) {
+		if ref != nil {
+			secrets = append(secrets, ref.Name)
+		}
+	}
+
+	return secrets
+}
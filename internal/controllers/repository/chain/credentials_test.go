@@ -0,0 +1,85 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+func TestReferencedSecrets(t *testing.T) {
+	t.Parallel()
+
+	secretRef := func(name string) *common.SourceRef {
+		return &common.SourceRef{
+			SecretKeyRef: &common.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				Key:                  "key",
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+		spec *nexusApi.NexusRepositorySpec
+		want []string
+	}{
+		{
+			name: "proxy repository with secret references",
+			spec: &nexusApi.NexusRepositorySpec{
+				Npm: &nexusApi.NpmSpec{
+					Proxy: &nexusApi.NpmProxyRepository{
+						ProxySpec: nexusApi.ProxySpec{
+							Name: "npm-proxy",
+							HTTPClient: nexusApi.HTTPClient{
+								Authentication: &nexusApi.HTTPClientAuthentication{
+									UsernameRef: secretRef("npm-username"),
+									PasswordRef: secretRef("npm-password"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []string{"npm-username", "npm-password"},
+		},
+		{
+			name: "maven proxy repository with password reference",
+			spec: &nexusApi.NexusRepositorySpec{
+				Maven: &nexusApi.MavenSpec{
+					Proxy: &nexusApi.MavenProxyRepository{
+						Name: "maven-proxy",
+						HTTPClient: nexusApi.HTTPClientWithPreemptiveAuth{
+							Authentication: &nexusApi.HTTPClientAuthenticationWithPreemptive{
+								Username:    "user",
+								PasswordRef: secretRef("maven-password"),
+							},
+						},
+					},
+				},
+			},
+			want: []string{"maven-password"},
+		},
+		{
+			name: "hosted repository",
+			spec: &nexusApi.NexusRepositorySpec{
+				Npm: &nexusApi.NpmSpec{
+					Hosted: &nexusApi.NpmHostedRepository{
+						HostedSpec: nexusApi.HostedSpec{Name: "npm-hosted"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, ReferencedSecrets(tt.spec))
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package chain
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	// repositoryWriteOnlyFields are not returned by nexus api, so they can't be compared.
+	repositoryWriteOnlyFields = map[string]bool{
+		"aptSigning":                         true,
+		"yumSigning":                         true,
+		"httpClient.authentication.password": true,
+	}
+
+	// repositoryRenamedFields maps fields of the repository request to the fields of nexus api response.
+	repositoryRenamedFields = map[string]string{
+		"routingRule": "routingRuleName",
+	}
+
+	// repositoryCaseInsensitiveFields are enums that nexus api returns in lower case.
+	repositoryCaseInsensitiveFields = map[string]bool{
+		"storage.writePolicy": true,
+	}
+)
+
+// repositoryChanges returns sorted paths of the fields of the desired repository
+// that differ from the repository returned by nexus api.
+// Fields that nexus returns but the spec doesn't contain are ignored, because nexus sets them to default values.
+func repositoryChanges(desired interface{}, nexusRepository map[string]interface{}) ([]string, error) {
+	raw, err := json.Marshal(desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal repository: %w", err)
+	}
+
+	spec := map[string]interface{}{}
+	if err = json.Unmarshal(raw, &spec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal repository: %w", err)
+	}
+
+	var changes []string
+
+	diffRepositoryValues("", spec, nexusRepository, &changes)
+
+	slices.Sort(changes)
+
+	return changes, nil
+}
+
+func diffRepositoryValues(path string, desired, actual interface{}, changes *[]string) {
+	if repositoryWriteOnlyFields[path] {
+		return
+	}
+
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		a, _ := actual.(map[string]interface{})
+
+		for key, value := range d {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+
+			actualKey := key
+			if renamed, ok := repositoryRenamedFields[fieldPath]; ok {
+				actualKey = renamed
+			}
+
+			diffRepositoryValues(fieldPath, value, a[actualKey], changes)
+		}
+	case []interface{}:
+		a, _ := actual.([]interface{})
+
+		if len(d) != len(a) {
+			*changes = append(*changes, path)
+
+			return
+		}
+
+		for i := range d {
+			var itemChanges []string
+
+			diffRepositoryValues(path, d[i], a[i], &itemChanges)
+
+			if len(itemChanges) > 0 {
+				*changes = append(*changes, path)
+
+				return
+			}
+		}
+	default:
+		if !repositoryValuesEqual(path, desired, actual) {
+			*changes = append(*changes, path)
+		}
+	}
+}
+
+// repositoryValuesEqual compares scalar values decoded from json.
+// Nexus api returns null for the fields that are not set, so null is equal to the zero value.
+func repositoryValuesEqual(path string, desired, actual interface{}) bool {
+	if desired == nil || actual == nil {
+		return isZeroRepositoryValue(desired) && isZeroRepositoryValue(actual)
+	}
+
+	if repositoryCaseInsensitiveFields[path] {
+		d, dOk := desired.(string)
+		a, aOk := actual.(string)
+
+		if dOk && aOk {
+			return strings.EqualFold(d, a)
+		}
+	}
+
+	return desired == actual
+}
+
+func isZeroRepositoryValue(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !val
+	case float64:
+		return val == 0
+	case string:
+		return val == ""
+	case []interface{}:
+		return len(val) == 0
+	case map[string]interface{}:
+		return len(val) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,142 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+func TestRepositoryChanges(t *testing.T) {
+	t.Parallel()
+
+	desired := &nexusApi.GoProxyRepository{
+		ProxySpec: nexusApi.ProxySpec{
+			Name:        "go-proxy",
+			Online:      true,
+			RoutingRule: ptr.To("rule"),
+			Storage: nexusApi.Storage{
+				BlobStoreName: "default",
+			},
+			Proxy: nexusApi.Proxy{
+				RemoteURL:      "https://proxy.golang.org",
+				ContentMaxAge:  1440,
+				MetadataMaxAge: 1440,
+			},
+			HTTPClient: nexusApi.HTTPClient{
+				Authentication: &nexusApi.HTTPClientAuthentication{
+					Type:     "username",
+					Username: "user",
+					Password: "secret",
+				},
+			},
+			Cleanup: &nexusApi.Cleanup{
+				PolicyNames: []string{"policy"},
+			},
+		},
+	}
+
+	nexusRepository := func() map[string]interface{} {
+		return map[string]interface{}{
+			"name":            "go-proxy",
+			"format":          "go",
+			"type":            "proxy",
+			"url":             "http://nexus/repository/go-proxy",
+			"online":          true,
+			"routingRuleName": "rule",
+			"storage": map[string]interface{}{
+				"blobStoreName":               "default",
+				"strictContentTypeValidation": false,
+			},
+			"proxy": map[string]interface{}{
+				"remoteUrl":      "https://proxy.golang.org",
+				"contentMaxAge":  float64(1440),
+				"metadataMaxAge": float64(1440),
+			},
+			"negativeCache": map[string]interface{}{
+				"enabled":    false,
+				"timeToLive": float64(0),
+			},
+			"httpClient": map[string]interface{}{
+				"blocked":    false,
+				"autoBlock":  false,
+				"connection": nil,
+				"authentication": map[string]interface{}{
+					"type":     "username",
+					"username": "user",
+				},
+			},
+			"cleanup": map[string]interface{}{
+				"policyNames": []interface{}{"policy"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(repo map[string]interface{})
+		want   []string
+	}{
+		{
+			name:   "repository is up to date",
+			modify: func(repo map[string]interface{}) {},
+		},
+		{
+			name: "changed fields",
+			modify: func(repo map[string]interface{}) {
+				repo["online"] = false
+				repo["routingRuleName"] = nil
+				repo["proxy"].(map[string]interface{})["contentMaxAge"] = float64(60)
+				repo["cleanup"].(map[string]interface{})["policyNames"] = []interface{}{"policy", "other"}
+			},
+			want: []string{"cleanup.policyNames", "online", "proxy.contentMaxAge", "routingRule"},
+		},
+		{
+			name: "missing nested object",
+			modify: func(repo map[string]interface{}) {
+				delete(repo, "httpClient")
+			},
+			want: []string{"httpClient.authentication.type", "httpClient.authentication.username"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := nexusRepository()
+			tt.modify(repo)
+
+			got, err := repositoryChanges(desired, repo)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRepositoryChanges_CaseInsensitiveWritePolicy(t *testing.T) {
+	t.Parallel()
+
+	desired := &nexusApi.HelmHostedRepository{
+		HostedSpec: nexusApi.HostedSpec{
+			Name: "helm-hosted",
+			Storage: nexusApi.HostedStorage{
+				BlobStoreName: "default",
+				WritePolicy:   "ALLOW_ONCE",
+			},
+		},
+	}
+
+	got, err := repositoryChanges(desired, map[string]interface{}{
+		"name": "helm-hosted",
+		"storage": map[string]interface{}{
+			"blobStoreName": "default",
+			"writePolicy":   "allow_once",
+		},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -0,0 +1,52 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+// ErrRoutingRuleNotFound is returned when the routing rule assigned to the repository doesn't exist in nexus.
+var ErrRoutingRuleNotFound = errors.New("routing rule doesn't exist in nexus")
+
+// getRoutingRule returns the name of the routing rule assigned to the repository data.
+// It returns an empty string if the repository doesn't support routing rules or doesn't use them.
+func getRoutingRule(data interface{}) string {
+	if repo, ok := data.(interface{ GetRoutingRule() string }); ok {
+		return repo.GetRoutingRule()
+	}
+
+	return ""
+}
+
+// RoutingRuleName returns the name of the routing rule assigned to the repository.
+func RoutingRuleName(spec *nexusApi.NexusRepositorySpec) string {
+	repoData, err := nexus.GetRepoData(spec)
+	if err != nil {
+		return ""
+	}
+
+	return getRoutingRule(repoData.Data)
+}
+
+// checkRoutingRule checks that the routing rule assigned to the repository exists in nexus.
+// Nexus rejects the repository with a bad request error otherwise,
+// so the repository waits for the routing rule instead.
+func checkRoutingRule(nexusRoutingRuleApiClient nexus.RoutingRule, data interface{}) error {
+	name := getRoutingRule(data)
+	if name == "" {
+		return nil
+	}
+
+	if _, err := nexusRoutingRuleApiClient.Get(name); err != nil {
+		if nexus.IsErrNotFound(err) {
+			return fmt.Errorf("%w: %s", ErrRoutingRuleNotFound, name)
+		}
+
+		return fmt.Errorf("failed to get routing rule %s: %w", name, err)
+	}
+
+	return nil
+}
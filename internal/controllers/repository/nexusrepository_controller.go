@@ -2,12 +2,25 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
 
+	"github.com/datadrivers/go-nexus-client/nexus3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/epam/edp-nexus-operator/api/common"
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
@@ -18,21 +31,35 @@ import (
 
 type apiClientProvider interface {
 	GetNexusRepositoryClientFromNexusRef(ctx context.Context, namespace string, ref common.HasNexusRef) (*nexus.RepoClient, error)
+	GetNexusApiClientFromNexusRef(ctx context.Context, namespace string, ref common.HasNexusRef) (*nexus3.NexusClient, error)
 }
 
 // NexusRepositoryReconciler reconciles a NexusRepository object.
 type NexusRepositoryReconciler struct {
 	client            client.Client
 	apiClientProvider apiClientProvider
+	resyncInterval    time.Duration
+	recorder          record.EventRecorder
 }
 
-func NewNexusRepositoryReconciler(k8sClient client.Client, apiClientProvider apiClientProvider) *NexusRepositoryReconciler {
-	return &NexusRepositoryReconciler{client: k8sClient, apiClientProvider: apiClientProvider}
+func NewNexusRepositoryReconciler(
+	k8sClient client.Client,
+	apiClientProvider apiClientProvider,
+	resyncInterval time.Duration,
+) *NexusRepositoryReconciler {
+	return &NexusRepositoryReconciler{
+		client:            k8sClient,
+		apiClientProvider: apiClientProvider,
+		resyncInterval:    resyncInterval,
+	}
 }
 
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusrepositories,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusrepositories/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusrepositories/finalizers,verbs=update
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusroutingrules,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update
 // +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -50,25 +77,51 @@ func (r *NexusRepositoryReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, fmt.Errorf("failed to get NexusRepository: %w", err)
 	}
 
-	nexusApiClient, err := r.apiClientProvider.GetNexusRepositoryClientFromNexusRef(ctx, repository.Namespace, repository)
-	if err != nil {
-		log.Error(err, "An error has occurred while getting nexus api client")
+	oldStatus := repository.Status.DeepCopy()
 
-		return ctrl.Result{
-			RequeueAfter: controllers.ErrorRequeueTime,
-		}, nil
-	}
+	ownership := controllers.NewOwnershipRecord(r.client, repository.Namespace, repository.Spec.NexusRef)
 
 	if repository.GetDeletionTimestamp() != nil {
 		if controllerutil.ContainsFinalizer(repository, controllers.NexusOperatorFinalizer) {
 			log.Info("Deleting NexusRepository")
 
-			if err = chain.NewRemoveRepository(nexusApiClient).ServeRequest(ctx, repository); err != nil {
-				log.Error(err, "An error has occurred while deleting NexusRepository")
+			repoName, err := chain.RepositoryName(&repository.Spec)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
+			deleteFromNexus, err := ownership.CanDelete(ctx, repository, repository.Spec.ManagementPolicy, repoName)
+			if err != nil {
+				log.Error(err, "An error has occurred while checking ownership of NexusRepository")
+
+				return controllers.RequeueOnError(err), nil
+			}
+
+			if deleteFromNexus && controllers.ShouldDeleteFromNexus(ctx, r.recorder, repository, repository.Spec.DeletionPolicy) {
+				nexusApiClient, err := r.apiClientProvider.GetNexusRepositoryClientFromNexusRef(ctx, repository.Namespace, repository)
+				if err != nil {
+					log.Error(err, "An error has occurred while getting nexus api client")
+
+					controllers.SetDependenciesNotResolved(&repository.Status.ReconcileStatus, repository.Generation, err)
+
+					if statusErr := r.updateNexusRepositoryStatus(ctx, repository, oldStatus); statusErr != nil {
+						return ctrl.Result{}, statusErr
+					}
+
+					return ctrl.Result{
+						RequeueAfter: controllers.ErrorRequeueTime,
+					}, nil
+				}
 
-				return ctrl.Result{
-					RequeueAfter: controllers.ErrorRequeueTime,
-				}, nil
+				if err = chain.NewRemoveRepository(nexusApiClient).ServeRequest(ctx, repository); err != nil {
+					log.Error(err, "An error has occurred while deleting NexusRepository")
+
+					return controllers.RequeueOnError(err), nil
+				}
+			}
+
+			if err = ownership.Release(ctx, repository, repoName); err != nil {
+				return ctrl.Result{}, err
 			}
 
 			controllerutil.RemoveFinalizer(repository, controllers.NexusOperatorFinalizer)
@@ -83,6 +136,21 @@ func (r *NexusRepositoryReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, nil
 	}
 
+	nexusApiClient, routingRuleApiClient, err := r.getNexusClients(ctx, repository)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&repository.Status.ReconcileStatus, repository.Generation, err)
+
+		if statusErr := r.updateNexusRepositoryStatus(ctx, repository, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
 	if controllerutil.AddFinalizer(repository, controllers.NexusOperatorFinalizer) {
 		err = r.client.Update(ctx, repository)
 		if err != nil {
@@ -90,25 +158,38 @@ func (r *NexusRepositoryReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 	}
 
-	oldStatus := repository.Status
+	if err = chain.NewCreateRepository(nexusApiClient, routingRuleApiClient, r.client, ownership, r.recorder).
+		ServeRequest(ctx, repository); err != nil {
+		repository.Status.Value = common.StatusError
+		repository.Status.Error = err.Error()
+
+		if errors.Is(err, chain.ErrRoutingRuleNotFound) {
+			// the repository is reconciled again when the NexusRoutingRule is created
+			log.Info("Waiting for the routing rule", "reason", err.Error())
+
+			controllers.SetDependencyNotFound(&repository.Status.ReconcileStatus, repository.Generation, err)
+
+			if statusErr := r.updateNexusRepositoryStatus(ctx, repository, oldStatus); statusErr != nil {
+				return ctrl.Result{}, statusErr
+			}
+
+			return ctrl.Result{RequeueAfter: controllers.ErrorRequeueTime}, nil
+		}
 
-	if err = chain.NewCreateRepository(nexusApiClient).ServeRequest(ctx, repository); err != nil {
 		log.Error(err, "An error has occurred while handling NexusRepository")
 
-		repository.Status.Value = common.StatusError
-		repository.Status.Error = err.Error()
+		controllers.SetSyncFailed(&repository.Status.ReconcileStatus, repository.Generation, err)
 
-		if err = r.updateNexusRepositoryStatus(ctx, repository, oldStatus); err != nil {
-			return ctrl.Result{}, err
+		if statusErr := r.updateNexusRepositoryStatus(ctx, repository, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
 		}
 
-		return ctrl.Result{
-			RequeueAfter: controllers.ErrorRequeueTime,
-		}, nil
+		return controllers.RequeueOnError(err), nil
 	}
 
 	repository.Status.Value = common.StatusCreated
 	repository.Status.Error = ""
+	controllers.SetSynced(&repository.Status.ReconcileStatus, repository.Generation)
 
 	if err = r.updateNexusRepositoryStatus(ctx, repository, oldStatus); err != nil {
 		return ctrl.Result{}, err
@@ -116,13 +197,36 @@ func (r *NexusRepositoryReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 	log.Info("Reconciling NexusRepository has been finished")
 
-	return ctrl.Result{}, nil
+	return controllers.ResyncResult(ctx, repository, r.resyncInterval), nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *NexusRepositoryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor(controllers.EventRecorderName)
+
 	if err := ctrl.NewControllerManagedBy(mgr).
 		For(&nexusApi.NexusRepository{}).
+		Watches(
+			// Watch for changes to Secret resources for updating remote repository credentials.
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.mapSecretToNexusRepository),
+			builder.WithPredicates(predicate.Funcs{
+				// We don't need to handle delete event for updating credentials.
+				DeleteFunc: func(_ event.DeleteEvent) bool {
+					return false
+				},
+			}),
+		).
+		Watches(
+			// Watch for NexusRoutingRules to create repositories that wait for the routing rule.
+			&nexusApi.NexusRoutingRule{},
+			handler.EnqueueRequestsFromMapFunc(r.mapRoutingRuleToNexusRepository),
+			builder.WithPredicates(predicate.Funcs{
+				DeleteFunc: func(_ event.DeleteEvent) bool {
+					return false
+				},
+			}),
+		).
 		Complete(r); err != nil {
 		return fmt.Errorf("failed to setup NexusRepository reconciler: %w", err)
 	}
@@ -130,12 +234,30 @@ func (r *NexusRepositoryReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return nil
 }
 
+// getNexusClients returns the repository client and the routing rule client of the referenced Nexus.
+func (r *NexusRepositoryReconciler) getNexusClients(
+	ctx context.Context,
+	repository *nexusApi.NexusRepository,
+) (*nexus.RepoClient, nexus.RoutingRule, error) {
+	repoClient, err := r.apiClientProvider.GetNexusRepositoryClientFromNexusRef(ctx, repository.Namespace, repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	apiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, repository.Namespace, repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return repoClient, apiClient.RoutingRule, nil
+}
+
 func (r *NexusRepositoryReconciler) updateNexusRepositoryStatus(
 	ctx context.Context,
 	repository *nexusApi.NexusRepository,
-	oldStatus nexusApi.NexusRepositoryStatus,
+	oldStatus *nexusApi.NexusRepositoryStatus,
 ) error {
-	if repository.Status == oldStatus {
+	if equality.Semantic.DeepEqual(&repository.Status, oldStatus) {
 		return nil
 	}
 
@@ -145,3 +267,60 @@ func (r *NexusRepositoryReconciler) updateNexusRepositoryStatus(
 
 	return nil
 }
+
+// mapSecretToNexusRepository returns a list of NexusRepository requests
+// that use the secret for remote repository credentials or signing keys.
+func (r *NexusRepositoryReconciler) mapSecretToNexusRepository(ctx context.Context, secret client.Object) []reconcile.Request {
+	log := ctrl.LoggerFrom(ctx).WithValues("secret", secret.GetName())
+	repositoryList := &nexusApi.NexusRepositoryList{}
+
+	if err := r.client.List(ctx, repositoryList, client.InNamespace(secret.GetNamespace())); err != nil {
+		log.Error(err, "Failed to get NexusRepository list")
+
+		return nil
+	}
+
+	var requests []reconcile.Request
+
+	for i := range repositoryList.Items {
+		if slices.Contains(chain.ReferencedSecrets(&repositoryList.Items[i].Spec), secret.GetName()) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{
+				Name:      repositoryList.Items[i].Name,
+				Namespace: repositoryList.Items[i].Namespace,
+			}})
+		}
+	}
+
+	return requests
+}
+
+// mapRoutingRuleToNexusRepository returns a list of NexusRepository requests
+// that wait for the routing rule to be created in nexus.
+func (r *NexusRepositoryReconciler) mapRoutingRuleToNexusRepository(ctx context.Context, obj client.Object) []reconcile.Request {
+	routingRule, ok := obj.(*nexusApi.NexusRoutingRule)
+	if !ok {
+		return nil
+	}
+
+	log := ctrl.LoggerFrom(ctx).WithValues("routingRule", routingRule.Name)
+	repositoryList := &nexusApi.NexusRepositoryList{}
+
+	if err := r.client.List(ctx, repositoryList, client.InNamespace(routingRule.Namespace)); err != nil {
+		log.Error(err, "Failed to get NexusRepository list")
+
+		return nil
+	}
+
+	var requests []reconcile.Request
+
+	for i := range repositoryList.Items {
+		repository := &repositoryList.Items[i]
+
+		if chain.RoutingRuleName(&repository.Spec) == routingRule.Spec.Name &&
+			meta.IsStatusConditionFalse(repository.Status.Conditions, common.ConditionDependenciesResolved) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(repository)})
+		}
+	}
+
+	return requests
+}
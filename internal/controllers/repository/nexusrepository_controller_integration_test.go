@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -62,7 +63,10 @@ var _ = Describe("NexusRepository controller", func() {
 				return false
 			}
 
-			return createdNexusRepository.Status.Value == common.StatusCreated && createdNexusRepository.Status.Error == ""
+			return createdNexusRepository.Status.Value == common.StatusCreated &&
+				createdNexusRepository.Status.Error == "" &&
+				createdNexusRepository.Status.ObservedGeneration == createdNexusRepository.Generation &&
+				meta.IsStatusConditionTrue(createdNexusRepository.Status.Conditions, common.ConditionReady)
 		}, timeout, interval).Should(BeTrue())
 	})
 	It("Should update NexusRepository object", func() {
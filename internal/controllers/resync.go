@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+const (
+	// ResyncIntervalAnnotation overrides the resync interval of the resource, e.g. "5m". "0" disables the resync.
+	ResyncIntervalAnnotation = "edp.epam.com/resync-interval"
+	// DefaultResyncInterval is the default interval of the periodic reconciliation of resources.
+	DefaultResyncInterval = time.Minute * 10
+	// resyncJitterFactor spreads resyncs of resources created at the same time.
+	resyncJitterFactor = 0.1
+)
+
+// driftTotal counts resources that were changed or deleted in nexus outside the operator.
+var driftTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "nexus_drift_total",
+		Help: "Number of detected changes of resources made in nexus outside the operator.",
+	},
+	[]string{"kind", "namespace", "name"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(driftTotal)
+}
+
+// ResyncResult returns the result of the successful reconciliation.
+// The resource is requeued after the resync interval to revert changes made in nexus outside the operator.
+// The interval can be overridden with the ResyncIntervalAnnotation.
+func ResyncResult(ctx context.Context, obj metav1.Object, defaultInterval time.Duration) ctrl.Result {
+	interval := defaultInterval
+
+	if value, ok := obj.GetAnnotations()[ResyncIntervalAnnotation]; ok {
+		annotationInterval, err := parseResyncInterval(value)
+		if err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Invalid resync interval annotation, using default interval",
+				"annotation", ResyncIntervalAnnotation, "interval", defaultInterval)
+		} else {
+			interval = annotationInterval
+		}
+	}
+
+	if interval <= 0 {
+		return ctrl.Result{}
+	}
+
+	return ctrl.Result{RequeueAfter: wait.Jitter(interval, resyncJitterFactor)}
+}
+
+func parseResyncInterval(value string) (time.Duration, error) {
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse resync interval: %w", err)
+	}
+
+	if interval < 0 {
+		return 0, fmt.Errorf("resync interval %s must not be negative", value)
+	}
+
+	return interval, nil
+}
+
+// IsSynced checks if the current generation of the resource was already applied to nexus.
+func IsSynced(status *common.ReconcileStatus, generation int64) bool {
+	return status.ObservedGeneration == generation &&
+		meta.IsStatusConditionTrue(status.Conditions, common.ConditionSynced)
+}
+
+// ReportDrift reports that the resource was changed or deleted in nexus outside the operator.
+// Differences of resources that are not synced yet are caused by the spec changes, so they are not reported.
+// It returns true if the drift is reported.
+func ReportDrift(
+	ctx context.Context,
+	kind string,
+	obj metav1.Object,
+	status *common.ReconcileStatus,
+	msg string,
+	keysAndValues ...any,
+) bool {
+	if !IsSynced(status, obj.GetGeneration()) {
+		return false
+	}
+
+	ctrl.LoggerFrom(ctx).Info(msg, keysAndValues...)
+
+	driftTotal.WithLabelValues(kind, obj.GetNamespace(), obj.GetName()).Inc()
+
+	return true
+}
@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+)
+
+func TestResyncResult(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		annotations     map[string]string
+		defaultInterval time.Duration
+		wantMin         time.Duration
+		wantMax         time.Duration
+	}{
+		{
+			name:            "default interval",
+			defaultInterval: time.Minute * 10,
+			wantMin:         time.Minute * 10,
+			wantMax:         time.Minute * 11,
+		},
+		{
+			name:            "interval from annotation",
+			annotations:     map[string]string{ResyncIntervalAnnotation: "1m"},
+			defaultInterval: time.Minute * 10,
+			wantMin:         time.Minute,
+			wantMax:         time.Minute + time.Second*6,
+		},
+		{
+			name:            "resync is disabled with annotation",
+			annotations:     map[string]string{ResyncIntervalAnnotation: "0"},
+			defaultInterval: time.Minute * 10,
+		},
+		{
+			name:            "resync is disabled with flag",
+			defaultInterval: 0,
+		},
+		{
+			name:            "invalid annotation",
+			annotations:     map[string]string{ResyncIntervalAnnotation: "-1m"},
+			defaultInterval: time.Minute,
+			wantMin:         time.Minute,
+			wantMax:         time.Minute + time.Second*6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj := &metav1.ObjectMeta{Annotations: tt.annotations}
+
+			got := ResyncResult(context.Background(), obj, tt.defaultInterval)
+
+			assert.GreaterOrEqual(t, got.RequeueAfter, tt.wantMin)
+			assert.LessOrEqual(t, got.RequeueAfter, tt.wantMax)
+		})
+	}
+}
+
+func TestReportDrift(t *testing.T) {
+	t.Parallel()
+
+	obj := &metav1.ObjectMeta{Name: "drift-test", Namespace: "default", Generation: 2}
+	status := &common.ReconcileStatus{}
+	counter := driftTotal.WithLabelValues("NexusRole", "default", "drift-test")
+
+	assert.False(t, ReportDrift(context.Background(), "NexusRole", obj, status, "changed"),
+		"not synced resource should not be reported")
+
+	SetSynced(status, 1)
+	assert.False(t, ReportDrift(context.Background(), "NexusRole", obj, status, "changed"),
+		"spec changes should not be reported")
+
+	SetSynced(status, 2)
+	assert.True(t, ReportDrift(context.Background(), "NexusRole", obj, status, "changed"))
+	assert.InDelta(t, 1, testutil.ToFloat64(counter), 0)
+}
@@ -2,6 +2,7 @@ package chain
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 
@@ -9,17 +10,24 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
+const roleKind = "NexusRole"
+
+// ErrExternalGroupNotFound is returned when the group mapped to the role doesn't exist in the user source.
+var ErrExternalGroupNotFound = errors.New("group doesn't exist in the user source")
+
 // CreateRole is a handler for creating role.
 type CreateRole struct {
 	nexusRoleApiClient nexus.Role
+	ownership          *controllers.OwnershipRecord
 }
 
 // NewCreateRole creates an instance of CreateRole handler.
-func NewCreateRole(nexusRoleApiClient nexus.Role) *CreateRole {
-	return &CreateRole{nexusRoleApiClient: nexusRoleApiClient}
+func NewCreateRole(nexusRoleApiClient nexus.Role, ownership *controllers.OwnershipRecord) *CreateRole {
+	return &CreateRole{nexusRoleApiClient: nexusRoleApiClient, ownership: ownership}
 }
 
 // ServeRequest implements the logic of creating role.
@@ -28,12 +36,30 @@ func (c CreateRole) ServeRequest(ctx context.Context, role *nexusApi.NexusRole)
 	log.Info("Start creating role")
 
 	nexusRole, err := c.nexusRoleApiClient.Get(role.Spec.ID)
+	if err != nil && !nexus.IsErrNotFound(err) {
+		return fmt.Errorf("failed to get role: %w", err)
+	}
+
+	exists := err == nil
+
+	manage, err := c.ownership.Acquire(ctx, role, role.Spec.ManagementPolicy, role.Spec.ID, exists)
 	if err != nil {
-		if !nexus.IsErrNotFound(err) {
-			return fmt.Errorf("failed to get role: %w", err)
-		}
+		return err
+	}
 
-		log.Info("Role doesn't exist, creating new one")
+	if !manage {
+		return nil
+	}
+
+	if err = c.checkExternalGroup(role); err != nil {
+		return err
+	}
+
+	if !exists {
+		if !controllers.ReportDrift(ctx, roleKind, role, &role.Status.ReconcileStatus,
+			"Role was deleted in nexus, recreating it", "id", role.Spec.ID) {
+			log.Info("Role doesn't exist, creating new one")
+		}
 
 		if err = c.nexusRoleApiClient.Create(specToRole(&role.Spec)); err != nil {
 			return fmt.Errorf("failed to create role: %w", err)
@@ -45,6 +71,9 @@ func (c CreateRole) ServeRequest(ctx context.Context, role *nexusApi.NexusRole)
 	}
 
 	if roleChanged(&role.Spec, nexusRole) {
+		controllers.ReportDrift(ctx, roleKind, role, &role.Status.ReconcileStatus,
+			"Role was changed in nexus, reverting changes", "id", role.Spec.ID)
+
 		log.Info("Updating role")
 
 		if err = c.nexusRoleApiClient.Update(role.Spec.ID, specToRole(&role.Spec)); err != nil {
@@ -57,10 +86,34 @@ func (c CreateRole) ServeRequest(ctx context.Context, role *nexusApi.NexusRole)
 	return nil
 }
 
+// checkExternalGroup checks that the group mapped to the role exists in the user source.
+// SAML groups come from assertions of the identity provider and can't be listed, so they are not checked.
+func (c CreateRole) checkExternalGroup(role *nexusApi.NexusRole) error {
+	if role.Spec.Source == "" ||
+		role.Spec.Source == nexusApi.UserSourceDefault ||
+		role.Spec.Source == nexusApi.UserSourceSaml {
+		return nil
+	}
+
+	groups, err := c.nexusRoleApiClient.ListBySource(role.Spec.Source)
+	if err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(groups, func(group security.Role) bool {
+		return group.ID == role.Spec.ID
+	}) {
+		return fmt.Errorf("%w: %s in %s source", ErrExternalGroupNotFound, role.Spec.ID, role.Spec.Source)
+	}
+
+	return nil
+}
+
 func roleChanged(spec *nexusApi.NexusRoleSpec, nexusRole *security.Role) bool {
 	if spec.Description != nexusRole.Description ||
 		spec.Name != nexusRole.Name ||
-		!slices.Equal(spec.Privileges, nexusRole.Privileges) {
+		!controllers.EqualUnordered(spec.Privileges, nexusRole.Privileges) ||
+		!controllers.EqualUnordered(spec.Roles, nexusRole.Roles) {
 		return true
 	}
 
@@ -73,5 +126,6 @@ func specToRole(spec *nexusApi.NexusRoleSpec) security.Role {
 		Name:        spec.Name,
 		Description: spec.Description,
 		Privileges:  slices.Clone(spec.Privileges),
+		Roles:       slices.Clone(spec.Roles),
 	}
 }
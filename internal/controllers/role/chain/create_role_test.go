@@ -11,6 +11,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers/testutils"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
 )
@@ -37,7 +38,7 @@ func TestCreateRole_ServeRequest(t *testing.T) {
 			nexusApiClient: func(t *testing.T) nexus.Role {
 				m := mocks.NewMockRole(t)
 
-				m.On("Get", "role-id").Return(nil, errors.New("not found"))
+				m.On("Get", "role-id").Return(nil, nexus.ErrNotFound)
 
 				m.On("Create", security.Role{
 					ID:          "role-id",
@@ -105,6 +106,32 @@ func TestCreateRole_ServeRequest(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "role exists, privileges and roles in different order, no changes",
+			role: &nexusApi.NexusRole{
+				Spec: nexusApi.NexusRoleSpec{
+					ID:          "role-id",
+					Name:        "role-name",
+					Description: "role-description",
+					Privileges:  []string{"privilege1", "privilege2"},
+					Roles:       []string{"role1", "role2"},
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.Role {
+				m := mocks.NewMockRole(t)
+
+				m.On("Get", "role-id").Return(&security.Role{
+					ID:          "role-id",
+					Name:        "role-name",
+					Description: "role-description",
+					Privileges:  []string{"privilege2", "privilege1"},
+					Roles:       []string{"role2", "role1"},
+				}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
 		{
 			name: "failed to update role",
 			role: &nexusApi.NexusRole{
@@ -174,7 +201,7 @@ func TestCreateRole_ServeRequest(t *testing.T) {
 			nexusApiClient: func(t *testing.T) nexus.Role {
 				m := mocks.NewMockRole(t)
 
-				m.On("Get", "role-id").Return(nil, errors.New("not found"))
+				m.On("Get", "role-id").Return(nil, nexus.ErrNotFound)
 
 				m.On("Create", security.Role{
 					ID:          "role-id",
@@ -190,13 +217,161 @@ func TestCreateRole_ServeRequest(t *testing.T) {
 				require.Contains(t, err.Error(), "failed to create role")
 			},
 		},
+		{
+			name: "role with nested roles is changed in nexus",
+			role: &nexusApi.NexusRole{
+				Spec: nexusApi.NexusRoleSpec{
+					ID:         "role-id",
+					Name:       "role-name",
+					Privileges: []string{"privilege"},
+					Roles:      []string{"nx-anonymous", "developers"},
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.Role {
+				m := mocks.NewMockRole(t)
+
+				m.On("Get", "role-id").Return(&security.Role{
+					ID:         "role-id",
+					Name:       "role-name",
+					Privileges: []string{"privilege"},
+					Roles:      []string{"nx-anonymous"},
+				}, nil)
+
+				m.On("Update", "role-id", security.Role{
+					ID:         "role-id",
+					Name:       "role-name",
+					Privileges: []string{"privilege"},
+					Roles:      []string{"nx-anonymous", "developers"},
+				}).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "role without nested roles, no changes",
+			role: &nexusApi.NexusRole{
+				Spec: nexusApi.NexusRoleSpec{
+					ID:         "role-id",
+					Name:       "role-name",
+					Privileges: []string{"privilege"},
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.Role {
+				m := mocks.NewMockRole(t)
+
+				m.On("Get", "role-id").Return(&security.Role{
+					ID:         "role-id",
+					Name:       "role-name",
+					Privileges: []string{"privilege"},
+					Roles:      []string{},
+				}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "LDAP group mapping doesn't exist, creating new one",
+			role: &nexusApi.NexusRole{
+				Spec: nexusApi.NexusRoleSpec{
+					ID:     "developers",
+					Name:   "developers",
+					Roles:  []string{"nx-developer"},
+					Source: nexusApi.UserSourceLdap,
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.Role {
+				m := mocks.NewMockRole(t)
+
+				m.On("Get", "developers").Return(nil, nexus.ErrNotFound)
+				m.On("ListBySource", nexusApi.UserSourceLdap).Return([]security.Role{
+					{ID: "admins"},
+					{ID: "developers"},
+				}, nil)
+
+				m.On("Create", security.Role{
+					ID:    "developers",
+					Name:  "developers",
+					Roles: []string{"nx-developer"},
+				}).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "LDAP group doesn't exist",
+			role: &nexusApi.NexusRole{
+				Spec: nexusApi.NexusRoleSpec{
+					ID:     "developers",
+					Name:   "developers",
+					Source: nexusApi.UserSourceLdap,
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.Role {
+				m := mocks.NewMockRole(t)
+
+				m.On("Get", "developers").Return(nil, nexus.ErrNotFound)
+				m.On("ListBySource", nexusApi.UserSourceLdap).Return([]security.Role{{ID: "admins"}}, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrExternalGroupNotFound)
+				require.ErrorContains(t, err, "developers in LDAP source")
+			},
+		},
+		{
+			name: "failed to get groups of the source",
+			role: &nexusApi.NexusRole{
+				Spec: nexusApi.NexusRoleSpec{
+					ID:     "developers",
+					Name:   "developers",
+					Source: nexusApi.UserSourceCrowd,
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.Role {
+				m := mocks.NewMockRole(t)
+
+				m.On("Get", "developers").Return(nil, nexus.ErrNotFound)
+				m.On("ListBySource", nexusApi.UserSourceCrowd).
+					Return(nil, errors.New("failed to get roles of source Crowd"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to get roles of source Crowd")
+			},
+		},
+		{
+			name: "SAML groups are not checked",
+			role: &nexusApi.NexusRole{
+				Spec: nexusApi.NexusRoleSpec{
+					ID:     "developers",
+					Name:   "developers",
+					Source: nexusApi.UserSourceSaml,
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.Role {
+				m := mocks.NewMockRole(t)
+
+				m.On("Get", "developers").Return(&security.Role{
+					ID:   "developers",
+					Name: "developers",
+				}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			h := NewCreateRole(tt.nexusApiClient(t))
+			h := NewCreateRole(tt.nexusApiClient(t), testutils.NewOwnershipRecord(t))
 			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.role)
 
 			tt.wantErr(t, err)
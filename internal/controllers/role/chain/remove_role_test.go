@@ -51,7 +51,7 @@ func TestRemoveRole_ServeRequest(t *testing.T) {
 				m := mocks.NewMockRole(t)
 
 				m.On("Delete", "role-id").
-					Return(errors.New("not found"))
+					Return(nexus.ErrNotFound)
 
 				return m
 			},
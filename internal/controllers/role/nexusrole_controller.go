@@ -2,10 +2,14 @@ package role
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -14,6 +18,7 @@ import (
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
 	"github.com/epam/edp-nexus-operator/internal/controllers"
 	"github.com/epam/edp-nexus-operator/internal/controllers/role/chain"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
 // NexusRoleReconciler reconciles a NexusRole object.
@@ -21,15 +26,29 @@ type NexusRoleReconciler struct {
 	client            client.Client
 	scheme            *runtime.Scheme
 	apiClientProvider controllers.ApiClientProvider
+	resyncInterval    time.Duration
+	recorder          record.EventRecorder
 }
 
-func NewNexusRoleReconciler(k8sClient client.Client, scheme *runtime.Scheme, apiClientProvider controllers.ApiClientProvider) *NexusRoleReconciler {
-	return &NexusRoleReconciler{client: k8sClient, scheme: scheme, apiClientProvider: apiClientProvider}
+func NewNexusRoleReconciler(
+	k8sClient client.Client,
+	scheme *runtime.Scheme,
+	apiClientProvider controllers.ApiClientProvider,
+	resyncInterval time.Duration,
+) *NexusRoleReconciler {
+	return &NexusRoleReconciler{
+		client:            k8sClient,
+		scheme:            scheme,
+		apiClientProvider: apiClientProvider,
+		resyncInterval:    resyncInterval,
+	}
 }
 
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusroles,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusroles/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusroles/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update
 // +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -47,23 +66,46 @@ func (r *NexusRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, fmt.Errorf("failed to get NexusRole: %w", err)
 	}
 
-	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, role.Namespace, role)
-	if err != nil {
-		log.Error(err, "An error has occurred while getting nexus api client")
+	oldStatus := role.Status.DeepCopy()
 
-		return ctrl.Result{
-			RequeueAfter: controllers.ErrorRequeueTime,
-		}, nil
-	}
+	ownership := controllers.NewOwnershipRecord(r.client, role.Namespace, role.Spec.NexusRef)
 
 	if role.GetDeletionTimestamp() != nil {
 		if controllerutil.ContainsFinalizer(role, controllers.NexusOperatorFinalizer) {
-			if err = chain.NewRemoveRole(nexusApiClient.Security.Role).ServeRequest(ctx, role); err != nil {
-				log.Error(err, "An error has occurred while deleting NexusRole")
+			deleteFromNexus, err := ownership.CanDelete(ctx, role, role.Spec.ManagementPolicy, role.Spec.ID)
+			if err != nil {
+				log.Error(err, "An error has occurred while checking ownership of NexusRole")
 
-				return ctrl.Result{
-					RequeueAfter: controllers.ErrorRequeueTime,
-				}, nil
+				return controllers.RequeueOnError(err), nil
+			}
+
+			if deleteFromNexus && controllers.ShouldDeleteFromNexus(ctx, r.recorder, role, role.Spec.DeletionPolicy) {
+				nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, role.Namespace, role)
+				if err != nil {
+					log.Error(err, "An error has occurred while getting nexus api client")
+
+					controllers.SetDependenciesNotResolved(&role.Status.ReconcileStatus, role.Generation, err)
+
+					if statusErr := r.updateNexusRoleStatus(ctx, role, oldStatus); statusErr != nil {
+						return ctrl.Result{}, statusErr
+					}
+
+					return ctrl.Result{
+						RequeueAfter: controllers.ErrorRequeueTime,
+					}, nil
+				}
+
+				roleApiClient := nexus.NewRoleClient(nexusApiClient.Security.Role)
+
+				if err = chain.NewRemoveRole(roleApiClient).ServeRequest(ctx, role); err != nil {
+					log.Error(err, "An error has occurred while deleting NexusRole")
+
+					return controllers.RequeueOnError(err), nil
+				}
+			}
+
+			if err = ownership.Release(ctx, role, role.Spec.ID); err != nil {
+				return ctrl.Result{}, err
 			}
 
 			controllerutil.RemoveFinalizer(role, controllers.NexusOperatorFinalizer)
@@ -76,6 +118,23 @@ func (r *NexusRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, nil
 	}
 
+	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, role.Namespace, role)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&role.Status.ReconcileStatus, role.Generation, err)
+
+		if statusErr := r.updateNexusRoleStatus(ctx, role, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
+	roleApiClient := nexus.NewRoleClient(nexusApiClient.Security.Role)
+
 	if controllerutil.AddFinalizer(role, controllers.NexusOperatorFinalizer) {
 		err = r.client.Update(ctx, role)
 		if err != nil {
@@ -83,35 +142,49 @@ func (r *NexusRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		}
 	}
 
-	oldStatus := role.Status
+	if err = chain.NewCreateRole(roleApiClient, ownership).ServeRequest(ctx, role); err != nil {
+		role.Status.Value = common.StatusError
+		role.Status.Error = err.Error()
+
+		if errors.Is(err, chain.ErrExternalGroupNotFound) {
+			// the group may appear when the user source is configured, e.g. by NexusLdapServer
+			log.Info("Waiting for the external group", "reason", err.Error())
+
+			controllers.SetDependencyNotFound(&role.Status.ReconcileStatus, role.Generation, err)
+
+			if statusErr := r.updateNexusRoleStatus(ctx, role, oldStatus); statusErr != nil {
+				return ctrl.Result{}, statusErr
+			}
+
+			return ctrl.Result{RequeueAfter: controllers.ErrorRequeueTime}, nil
+		}
 
-	if err = chain.NewCreateRole(nexusApiClient.Security.Role).ServeRequest(ctx, role); err != nil {
 		log.Error(err, "An error has occurred while handling NexusRole")
 
-		role.Status.Value = common.StatusError
-		role.Status.Error = err.Error()
+		controllers.SetSyncFailed(&role.Status.ReconcileStatus, role.Generation, err)
 
-		if err = r.updateNexusRoleStatus(ctx, role, oldStatus); err != nil {
-			return ctrl.Result{}, err
+		if statusErr := r.updateNexusRoleStatus(ctx, role, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
 		}
 
-		return ctrl.Result{
-			RequeueAfter: controllers.ErrorRequeueTime,
-		}, nil
+		return controllers.RequeueOnError(err), nil
 	}
 
 	role.Status.Value = common.StatusCreated
 	role.Status.Error = ""
+	controllers.SetSynced(&role.Status.ReconcileStatus, role.Generation)
 
 	if err = r.updateNexusRoleStatus(ctx, role, oldStatus); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return controllers.ResyncResult(ctx, role, r.resyncInterval), nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *NexusRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor(controllers.EventRecorderName)
+
 	err := ctrl.NewControllerManagedBy(mgr).
 		For(&nexusApi.NexusRole{}).
 		Complete(r)
@@ -125,9 +198,9 @@ func (r *NexusRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 func (r *NexusRoleReconciler) updateNexusRoleStatus(
 	ctx context.Context,
 	role *nexusApi.NexusRole,
-	oldStatus nexusApi.NexusRoleStatus,
+	oldStatus *nexusApi.NexusRoleStatus,
 ) error {
-	if role.Status == oldStatus {
+	if equality.Semantic.DeepEqual(&role.Status, oldStatus) {
 		return nil
 	}
 
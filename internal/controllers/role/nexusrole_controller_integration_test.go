@@ -9,6 +9,7 @@ import (
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/epam/edp-nexus-operator/api/common"
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
@@ -105,4 +106,60 @@ var _ = Describe("NexusRole controller", func() {
 			g.Expect(createdNexusRole.Status.Error).ShouldNot(BeEmpty())
 		}).WithPolling(time.Second).WithTimeout(timeout).Should(Succeed())
 	})
+	It("Should delete orphaned NexusRole object after Nexus is deleted", func() {
+		By("Creating a new Nexus object")
+		orphanNexus := &nexusApi.Nexus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-nexus-orphan",
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusSpec{
+				Url:    nexusUrl,
+				Secret: "nexus-auth-secret",
+			},
+		}
+		Expect(k8sClient.Create(ctx, orphanNexus)).Should(Succeed())
+		Eventually(func(g Gomega) {
+			createdNexus := &nexusApi.Nexus{}
+			g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(orphanNexus), createdNexus)).Should(Succeed())
+			g.Expect(createdNexus.Status.Connected).Should(BeTrue())
+		}).WithPolling(interval).WithTimeout(timeout).Should(Succeed())
+
+		By("Creating a new NexusRole object with Orphan deletion policy")
+		orphanRole := &nexusApi.NexusRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "nexus-role-orphan",
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusRoleSpec{
+				ID:             "test-role-orphan",
+				Name:           "test role orphan",
+				Privileges:     []string{"nx-blobstores-all"},
+				DeletionPolicy: common.DeletionPolicyOrphan,
+				NexusRef: common.NexusRef{
+					Name: orphanNexus.Name,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, orphanRole)).Should(Succeed())
+		Eventually(func(g Gomega) {
+			createdNexusRole := &nexusApi.NexusRole{}
+			g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(orphanRole), createdNexusRole)).Should(Succeed())
+			g.Expect(createdNexusRole.Status.Value).Should(Equal(common.StatusCreated))
+		}).WithPolling(interval).WithTimeout(timeout).Should(Succeed())
+
+		By("Deleting Nexus object")
+		Expect(k8sClient.Delete(ctx, orphanNexus)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(orphanNexus), &nexusApi.Nexus{})
+			return k8sErrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+
+		By("Deleting NexusRole object without Nexus")
+		Expect(k8sClient.Delete(ctx, orphanRole)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(orphanRole), &nexusApi.NexusRole{})
+			return k8sErrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
 })
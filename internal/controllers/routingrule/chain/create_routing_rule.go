@@ -0,0 +1,99 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+const routingRuleKind = "NexusRoutingRule"
+
+// CreateRoutingRule is a handler for creating routing rule.
+type CreateRoutingRule struct {
+	nexusRoutingRuleApiClient nexus.RoutingRule
+	ownership                 *controllers.OwnershipRecord
+}
+
+// NewCreateRoutingRule creates an instance of CreateRoutingRule handler.
+func NewCreateRoutingRule(
+	nexusRoutingRuleApiClient nexus.RoutingRule,
+	ownership *controllers.OwnershipRecord,
+) *CreateRoutingRule {
+	return &CreateRoutingRule{nexusRoutingRuleApiClient: nexusRoutingRuleApiClient, ownership: ownership}
+}
+
+// ServeRequest implements the logic of creating routing rule.
+func (c CreateRoutingRule) ServeRequest(ctx context.Context, routingRule *nexusApi.NexusRoutingRule) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", routingRule.Spec.Name)
+	log.Info("Start creating routing rule")
+
+	nexusRoutingRule, err := c.nexusRoutingRuleApiClient.Get(routingRule.Spec.Name)
+	if err != nil && !nexus.IsErrNotFound(err) {
+		return fmt.Errorf("failed to get routing rule: %w", err)
+	}
+
+	exists := err == nil
+
+	manage, err := c.ownership.Acquire(ctx, routingRule, routingRule.Spec.ManagementPolicy, routingRule.Spec.Name, exists)
+	if err != nil {
+		return err
+	}
+
+	if !manage {
+		return nil
+	}
+
+	if !exists {
+		if !controllers.ReportDrift(ctx, routingRuleKind, routingRule, &routingRule.Status.ReconcileStatus,
+			"Routing rule was deleted in nexus, recreating it", "name", routingRule.Spec.Name) {
+			log.Info("Routing rule doesn't exist, creating new one")
+		}
+
+		if err = c.nexusRoutingRuleApiClient.Create(specToRoutingRule(&routingRule.Spec)); err != nil {
+			return fmt.Errorf("failed to create routing rule: %w", err)
+		}
+
+		log.Info("Routing rule has been created")
+
+		return nil
+	}
+
+	if routingRuleChanged(&routingRule.Spec, nexusRoutingRule) {
+		controllers.ReportDrift(ctx, routingRuleKind, routingRule, &routingRule.Status.ReconcileStatus,
+			"Routing rule was changed in nexus, reverting changes", "name", routingRule.Spec.Name)
+
+		log.Info("Updating routing rule")
+
+		if err = c.nexusRoutingRuleApiClient.Update(specToRoutingRule(&routingRule.Spec)); err != nil {
+			return fmt.Errorf("failed to update routing rule: %w", err)
+		}
+
+		log.Info("Routing rule has been updated")
+	}
+
+	return nil
+}
+
+// routingRuleChanged compares the spec with the routing rule in nexus.
+// The order of the matchers is kept, because nexus returns them in the order they were set.
+func routingRuleChanged(spec *nexusApi.NexusRoutingRuleSpec, nexusRoutingRule *schema.RoutingRule) bool {
+	return spec.Description != nexusRoutingRule.Description ||
+		string(spec.Mode) != string(nexusRoutingRule.Mode) ||
+		!slices.Equal(spec.Matchers, nexusRoutingRule.Matchers)
+}
+
+func specToRoutingRule(spec *nexusApi.NexusRoutingRuleSpec) *schema.RoutingRule {
+	return &schema.RoutingRule{
+		Name:        spec.Name,
+		Description: spec.Description,
+		Mode:        schema.RoutingRuleMode(spec.Mode),
+		Matchers:    spec.Matchers,
+	}
+}
@@ -0,0 +1,145 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers/testutils"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestCreateRoutingRule_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	routingRule := func(mode nexusApi.RoutingRuleMode, matchers ...string) *nexusApi.NexusRoutingRule {
+		return &nexusApi.NexusRoutingRule{
+			Spec: nexusApi.NexusRoutingRuleSpec{
+				Name:        "go-proxy-routing-rule",
+				Description: "Allow golang.org packages",
+				Mode:        mode,
+				Matchers:    matchers,
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		routingRule    *nexusApi.NexusRoutingRule
+		nexusApiClient func(t *testing.T) nexus.RoutingRule
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:        "routing rule doesn't exist, creating new one",
+			routingRule: routingRule(nexusApi.RoutingRuleModeAllow, "^/golang.org/.*"),
+			nexusApiClient: func(t *testing.T) nexus.RoutingRule {
+				m := mocks.NewMockRoutingRule(t)
+
+				m.On("Get", "go-proxy-routing-rule").Return(nil, nexus.ErrNotFound)
+
+				m.On("Create", &schema.RoutingRule{
+					Name:        "go-proxy-routing-rule",
+					Description: "Allow golang.org packages",
+					Mode:        schema.RoutingRuleModeAllow,
+					Matchers:    []string{"^/golang.org/.*"},
+				}).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:        "routing rule exists, updating it",
+			routingRule: routingRule(nexusApi.RoutingRuleModeAllow, "^/golang.org/.*", "^/github.com/.*"),
+			nexusApiClient: func(t *testing.T) nexus.RoutingRule {
+				m := mocks.NewMockRoutingRule(t)
+
+				m.On("Get", "go-proxy-routing-rule").Return(&schema.RoutingRule{
+					Name:        "go-proxy-routing-rule",
+					Description: "Allow golang.org packages",
+					Mode:        schema.RoutingRuleModeBlock,
+					Matchers:    []string{"^/golang.org/.*"},
+				}, nil)
+
+				m.On("Update", &schema.RoutingRule{
+					Name:        "go-proxy-routing-rule",
+					Description: "Allow golang.org packages",
+					Mode:        schema.RoutingRuleModeAllow,
+					Matchers:    []string{"^/golang.org/.*", "^/github.com/.*"},
+				}).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:        "routing rule is up to date",
+			routingRule: routingRule(nexusApi.RoutingRuleModeBlock, "^/golang.org/.*"),
+			nexusApiClient: func(t *testing.T) nexus.RoutingRule {
+				m := mocks.NewMockRoutingRule(t)
+
+				m.On("Get", "go-proxy-routing-rule").Return(&schema.RoutingRule{
+					Name:        "go-proxy-routing-rule",
+					Description: "Allow golang.org packages",
+					Mode:        schema.RoutingRuleModeBlock,
+					Matchers:    []string{"^/golang.org/.*"},
+				}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:        "failed to get routing rule",
+			routingRule: routingRule(nexusApi.RoutingRuleModeBlock, "^/golang.org/.*"),
+			nexusApiClient: func(t *testing.T) nexus.RoutingRule {
+				m := mocks.NewMockRoutingRule(t)
+
+				m.On("Get", "go-proxy-routing-rule").Return(nil, errors.New("connection refused"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to get routing rule")
+			},
+		},
+		{
+			name:        "failed to create routing rule",
+			routingRule: routingRule(nexusApi.RoutingRuleModeBlock, "("),
+			nexusApiClient: func(t *testing.T) nexus.RoutingRule {
+				m := mocks.NewMockRoutingRule(t)
+
+				m.On("Get", "go-proxy-routing-rule").Return(nil, nexus.ErrNotFound)
+				m.On("Create", &schema.RoutingRule{
+					Name:        "go-proxy-routing-rule",
+					Description: "Allow golang.org packages",
+					Mode:        schema.RoutingRuleModeBlock,
+					Matchers:    []string{"("},
+				}).Return(errors.New("invalid regular expression"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to create routing rule")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewCreateRoutingRule(tt.nexusApiClient(t), testutils.NewOwnershipRecord(t))
+			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.routingRule)
+
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+// RemoveRoutingRule is a handler for removing routing rule.
+type RemoveRoutingRule struct {
+	nexusRoutingRuleApiClient nexus.RoutingRule
+}
+
+// NewRemoveRoutingRule creates an instance of RemoveRoutingRule handler.
+func NewRemoveRoutingRule(nexusRoutingRuleApiClient nexus.RoutingRule) *RemoveRoutingRule {
+	return &RemoveRoutingRule{nexusRoutingRuleApiClient: nexusRoutingRuleApiClient}
+}
+
+// ServeRequest implements the logic of removing routing rule.
+func (c RemoveRoutingRule) ServeRequest(ctx context.Context, routingRule *nexusApi.NexusRoutingRule) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", routingRule.Spec.Name)
+	log.Info("Start removing routing rule")
+
+	if err := c.nexusRoutingRuleApiClient.Delete(routingRule.Spec.Name); err != nil {
+		if !nexus.IsErrNotFound(err) {
+			return fmt.Errorf("failed to delete routing rule: %w", err)
+		}
+
+		log.Info("Routing rule doesn't exist, skipping removal")
+	}
+
+	log.Info("Routing rule has been removed")
+
+	return nil
+}
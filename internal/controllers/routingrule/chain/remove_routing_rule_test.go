@@ -0,0 +1,92 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestRemoveRoutingRule_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		routingRule    *nexusApi.NexusRoutingRule
+		nexusApiClient func(t *testing.T) nexus.RoutingRule
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name: "removing routing rule successfully",
+			routingRule: &nexusApi.NexusRoutingRule{
+				Spec: nexusApi.NexusRoutingRuleSpec{
+					Name: "routing-rule-name",
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.RoutingRule {
+				m := mocks.NewMockRoutingRule(t)
+
+				m.On("Delete", "routing-rule-name").
+					Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "routing rule doesn't exist, skipping removal",
+			routingRule: &nexusApi.NexusRoutingRule{
+				Spec: nexusApi.NexusRoutingRuleSpec{
+					Name: "routing-rule-name",
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.RoutingRule {
+				m := mocks.NewMockRoutingRule(t)
+
+				m.On("Delete", "routing-rule-name").
+					Return(nexus.ErrNotFound)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "failed to remove routing rule",
+			routingRule: &nexusApi.NexusRoutingRule{
+				Spec: nexusApi.NexusRoutingRuleSpec{
+					Name: "routing-rule-name",
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.RoutingRule {
+				m := mocks.NewMockRoutingRule(t)
+
+				m.On("Delete", "routing-rule-name").
+					Return(errors.New("failed to remove routing rule"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to remove routing rule")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewRemoveRoutingRule(tt.nexusApiClient(t))
+			err := c.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.routingRule)
+
+			tt.wantErr(t, err)
+		})
+	}
+}
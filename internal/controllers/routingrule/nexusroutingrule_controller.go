@@ -0,0 +1,196 @@
+package routingrule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/internal/controllers/routingrule/chain"
+)
+
+// NexusRoutingRuleReconciler reconciles a NexusRoutingRule object.
+type NexusRoutingRuleReconciler struct {
+	client            client.Client
+	scheme            *runtime.Scheme
+	apiClientProvider controllers.ApiClientProvider
+	resyncInterval    time.Duration
+	recorder          record.EventRecorder
+}
+
+func NewNexusRoutingRuleReconciler(
+	k8sClient client.Client,
+	scheme *runtime.Scheme,
+	apiClientProvider controllers.ApiClientProvider,
+	resyncInterval time.Duration,
+) *NexusRoutingRuleReconciler {
+	return &NexusRoutingRuleReconciler{
+		client:            k8sClient,
+		scheme:            scheme,
+		apiClientProvider: apiClientProvider,
+		resyncInterval:    resyncInterval,
+	}
+}
+
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusroutingrules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusroutingrules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusroutingrules/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *NexusRoutingRuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling NexusRoutingRule")
+
+	routingRule := &nexusApi.NexusRoutingRule{}
+	if err := r.client.Get(ctx, req.NamespacedName, routingRule); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get NexusRoutingRule: %w", err)
+	}
+
+	oldStatus := routingRule.Status.DeepCopy()
+
+	ownership := controllers.NewOwnershipRecord(r.client, routingRule.Namespace, routingRule.Spec.NexusRef)
+
+	if routingRule.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(routingRule, controllers.NexusOperatorFinalizer) {
+			deleteFromNexus, err := ownership.CanDelete(ctx, routingRule, routingRule.Spec.ManagementPolicy, routingRule.Spec.Name)
+			if err != nil {
+				log.Error(err, "An error has occurred while checking ownership of NexusRoutingRule")
+
+				return controllers.RequeueOnError(err), nil
+			}
+
+			if deleteFromNexus && controllers.ShouldDeleteFromNexus(ctx, r.recorder, routingRule, routingRule.Spec.DeletionPolicy) {
+				nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, routingRule.Namespace, routingRule)
+				if err != nil {
+					log.Error(err, "An error has occurred while getting nexus api client")
+
+					controllers.SetDependenciesNotResolved(&routingRule.Status.ReconcileStatus, routingRule.Generation, err)
+
+					if statusErr := r.updateNexusRoutingRuleStatus(ctx, routingRule, oldStatus); statusErr != nil {
+						return ctrl.Result{}, statusErr
+					}
+
+					return ctrl.Result{
+						RequeueAfter: controllers.ErrorRequeueTime,
+					}, nil
+				}
+
+				routingRuleApiClient := nexusApiClient.RoutingRule
+
+				if err = chain.NewRemoveRoutingRule(routingRuleApiClient).ServeRequest(ctx, routingRule); err != nil {
+					log.Error(err, "An error has occurred while deleting NexusRoutingRule")
+
+					return controllers.RequeueOnError(err), nil
+				}
+			}
+
+			if err = ownership.Release(ctx, routingRule, routingRule.Spec.Name); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			controllerutil.RemoveFinalizer(routingRule, controllers.NexusOperatorFinalizer)
+
+			if err = r.client.Update(ctx, routingRule); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to update NexusRoutingRule: %w", err)
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, routingRule.Namespace, routingRule)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&routingRule.Status.ReconcileStatus, routingRule.Generation, err)
+
+		if statusErr := r.updateNexusRoutingRuleStatus(ctx, routingRule, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
+	routingRuleApiClient := nexusApiClient.RoutingRule
+
+	if controllerutil.AddFinalizer(routingRule, controllers.NexusOperatorFinalizer) {
+		err = r.client.Update(ctx, routingRule)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update NexusRoutingRule: %w", err)
+		}
+	}
+
+	if err = chain.NewCreateRoutingRule(routingRuleApiClient, ownership).ServeRequest(ctx, routingRule); err != nil {
+		log.Error(err, "An error has occurred while handling NexusRoutingRule")
+
+		routingRule.Status.Value = common.StatusError
+		routingRule.Status.Error = err.Error()
+		controllers.SetSyncFailed(&routingRule.Status.ReconcileStatus, routingRule.Generation, err)
+
+		if statusErr := r.updateNexusRoutingRuleStatus(ctx, routingRule, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return controllers.RequeueOnError(err), nil
+	}
+
+	routingRule.Status.Value = common.StatusCreated
+	routingRule.Status.Error = ""
+	controllers.SetSynced(&routingRule.Status.ReconcileStatus, routingRule.Generation)
+
+	if err = r.updateNexusRoutingRuleStatus(ctx, routingRule, oldStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return controllers.ResyncResult(ctx, routingRule, r.resyncInterval), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NexusRoutingRuleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor(controllers.EventRecorderName)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&nexusApi.NexusRoutingRule{}).
+		Complete(r)
+	if err != nil {
+		return fmt.Errorf("failed to create controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *NexusRoutingRuleReconciler) updateNexusRoutingRuleStatus(
+	ctx context.Context,
+	routingRule *nexusApi.NexusRoutingRule,
+	oldStatus *nexusApi.NexusRoutingRuleStatus,
+) error {
+	if equality.Semantic.DeepEqual(&routingRule.Status, oldStatus) {
+		return nil
+	}
+
+	if err := r.client.Status().Update(ctx, routingRule); err != nil {
+		return fmt.Errorf("failed to update NexusRoutingRule status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package routingrule
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+var _ = Describe("NexusRoutingRule controller", func() {
+	nexusRoutingRuleCRName := "nexus-routing-rule"
+	nexusRepositoryCRName := "nexus-routing-rule-repository"
+	It("Should wait for the routing rule of NexusRepository", func() {
+		By("Creating NexusRepository object with the routing rule that doesn't exist")
+		newNexusRepository := &nexusApi.NexusRepository{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nexusRepositoryCRName,
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusRepositorySpec{
+				Go: &nexusApi.GoSpec{
+					Proxy: &nexusApi.GoProxyRepository{
+						ProxySpec: nexusApi.ProxySpec{
+							Name:        "go-proxy-with-routing-rule",
+							Online:      true,
+							RoutingRule: ptr.To("test-routing-rule"),
+							Proxy: nexusApi.Proxy{
+								RemoteURL: "https://proxy.golang.org",
+							},
+						},
+					},
+				},
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusRepository)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusRepository := &nexusApi.NexusRepository{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusRepositoryCRName, Namespace: namespace}, createdNexusRepository)
+			if err != nil {
+				return false
+			}
+
+			return meta.IsStatusConditionFalse(createdNexusRepository.Status.Conditions, common.ConditionDependenciesResolved)
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should create NexusRoutingRule object", func() {
+		By("By creating a new NexusRoutingRule object")
+		newNexusRoutingRule := &nexusApi.NexusRoutingRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nexusRoutingRuleCRName,
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusRoutingRuleSpec{
+				Name:        "test-routing-rule",
+				Description: "test routing rule",
+				Mode:        nexusApi.RoutingRuleModeAllow,
+				Matchers:    []string{"^/golang.org/.*"},
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusRoutingRule)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusRoutingRule := &nexusApi.NexusRoutingRule{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusRoutingRuleCRName, Namespace: namespace}, createdNexusRoutingRule)
+			if err != nil {
+				return false
+			}
+
+			return createdNexusRoutingRule.Status.Value == common.StatusCreated && createdNexusRoutingRule.Status.Error == ""
+		}, timeout, interval).Should(BeTrue())
+
+		By("Waiting for NexusRepository that uses the routing rule")
+		Eventually(func() bool {
+			createdNexusRepository := &nexusApi.NexusRepository{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusRepositoryCRName, Namespace: namespace}, createdNexusRepository)
+			if err != nil {
+				return false
+			}
+
+			return createdNexusRepository.Status.Value == common.StatusCreated
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should update NexusRoutingRule object", func() {
+		By("Getting NexusRoutingRule object")
+		createdNexusRoutingRule := &nexusApi.NexusRoutingRule{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nexusRoutingRuleCRName, Namespace: namespace}, createdNexusRoutingRule)).
+			Should(Succeed())
+
+		By("Updating NexusRoutingRule object")
+		createdNexusRoutingRule.Spec.Description = "updated description"
+		createdNexusRoutingRule.Spec.Matchers = append(createdNexusRoutingRule.Spec.Matchers, "^/github.com/.*")
+
+		Expect(k8sClient.Update(ctx, createdNexusRoutingRule)).Should(Succeed())
+		Consistently(func() bool {
+			createdNexusRoutingRule := &nexusApi.NexusRoutingRule{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusRoutingRuleCRName, Namespace: namespace}, createdNexusRoutingRule)
+			if err != nil {
+				return false
+			}
+
+			return createdNexusRoutingRule.Status.Value == common.StatusCreated && createdNexusRoutingRule.Status.Error == ""
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should delete NexusRoutingRule object", func() {
+		By("Deleting NexusRepository object")
+		Expect(k8sClient.Delete(ctx, &nexusApi.NexusRepository{
+			ObjectMeta: metav1.ObjectMeta{Name: nexusRepositoryCRName, Namespace: namespace},
+		})).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusRepositoryCRName, Namespace: namespace}, &nexusApi.NexusRepository{})
+			return k8sErrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+
+		By("Deleting NexusRoutingRule object")
+		createdNexusRoutingRule := &nexusApi.NexusRoutingRule{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nexusRoutingRuleCRName, Namespace: namespace}, createdNexusRoutingRule)).
+			Should(Succeed())
+		Expect(k8sClient.Delete(ctx, createdNexusRoutingRule)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusRoutingRule := &nexusApi.NexusRoutingRule{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusRoutingRuleCRName, Namespace: namespace}, createdNexusRoutingRule)
+			return k8sErrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+})
@@ -8,11 +8,13 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
 const (
 	scriptTypeGroovy = "groovy"
+	scriptKind       = "NexusScript"
 )
 
 type CreateScript struct {
@@ -29,7 +31,10 @@ func (c *CreateScript) ServeRequest(ctx context.Context, script *nexusApi.NexusS
 
 	_, getScriptErr := c.nexusScriptApiClient.Get(script.Spec.Name)
 	if getScriptErr != nil {
-		log.Info("Script doesn't exist, creating new one")
+		if !controllers.ReportDrift(ctx, scriptKind, script, &script.Status.ReconcileStatus,
+			"Script was deleted in nexus, recreating it", "script_name", script.Spec.Name) {
+			log.Info("Script doesn't exist, creating new one")
+		}
 
 		if err := c.nexusScriptApiClient.Create(specToScript(&script.Spec)); err != nil {
 			return fmt.Errorf("failed to create script: %w", err)
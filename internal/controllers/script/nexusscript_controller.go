@@ -3,8 +3,11 @@ package script
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -19,15 +22,26 @@ import (
 type NexusScriptReconciler struct {
 	k8sclient         client.Client
 	apiClientProvider controllers.ApiClientProvider
+	resyncInterval    time.Duration
+	recorder          record.EventRecorder
 }
 
-func NewNexusScriptReconciler(k8sclient client.Client, apiClientProvider controllers.ApiClientProvider) *NexusScriptReconciler {
-	return &NexusScriptReconciler{k8sclient: k8sclient, apiClientProvider: apiClientProvider}
+func NewNexusScriptReconciler(
+	k8sclient client.Client,
+	apiClientProvider controllers.ApiClientProvider,
+	resyncInterval time.Duration,
+) *NexusScriptReconciler {
+	return &NexusScriptReconciler{
+		k8sclient:         k8sclient,
+		apiClientProvider: apiClientProvider,
+		resyncInterval:    resyncInterval,
+	}
 }
 
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusscripts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusscripts/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexusscripts/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -45,28 +59,36 @@ func (r *NexusScriptReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, fmt.Errorf("failed to get NexusScript: %w", err)
 	}
 
-	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, script.Namespace, script)
-	if err != nil {
-		log.Error(err, "An error has occurred while getting nexus api k8sclient")
-
-		return ctrl.Result{
-			RequeueAfter: controllers.ErrorRequeueTime,
-		}, nil
-	}
+	oldStatus := script.Status.DeepCopy()
 
 	if script.GetDeletionTimestamp() != nil {
 		if controllerutil.ContainsFinalizer(script, controllers.NexusOperatorFinalizer) {
-			if err = chain.NewRemoveScript(nexusApiClient.Script).ServeRequest(ctx, script); err != nil {
-				log.Error(err, "An error has occurred while deleting NexusScript")
+			if controllers.ShouldDeleteFromNexus(ctx, r.recorder, script, script.Spec.DeletionPolicy) {
+				nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, script.Namespace, script)
+				if err != nil {
+					log.Error(err, "An error has occurred while getting nexus api k8sclient")
+
+					controllers.SetDependenciesNotResolved(&script.Status.ReconcileStatus, script.Generation, err)
+
+					if statusErr := r.updateNexusScriptStatus(ctx, script, oldStatus); statusErr != nil {
+						return ctrl.Result{}, statusErr
+					}
+
+					return ctrl.Result{
+						RequeueAfter: controllers.ErrorRequeueTime,
+					}, nil
+				}
 
-				return ctrl.Result{
-					RequeueAfter: controllers.ErrorRequeueTime,
-				}, nil
+				if err = chain.NewRemoveScript(nexusApiClient.Script).ServeRequest(ctx, script); err != nil {
+					log.Error(err, "An error has occurred while deleting NexusScript")
+
+					return controllers.RequeueOnError(err), nil
+				}
 			}
 
 			controllerutil.RemoveFinalizer(script, controllers.NexusOperatorFinalizer)
 
-			if err = r.k8sclient.Update(ctx, script); err != nil {
+			if err := r.k8sclient.Update(ctx, script); err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to update NexusScript: %w", err)
 			}
 		}
@@ -74,6 +96,21 @@ func (r *NexusScriptReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
+	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, script.Namespace, script)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api k8sclient")
+
+		controllers.SetDependenciesNotResolved(&script.Status.ReconcileStatus, script.Generation, err)
+
+		if statusErr := r.updateNexusScriptStatus(ctx, script, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
 	if controllerutil.AddFinalizer(script, controllers.NexusOperatorFinalizer) {
 		err = r.k8sclient.Update(ctx, script)
 		if err != nil {
@@ -81,39 +118,37 @@ func (r *NexusScriptReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}
 
-	oldStatus := script.Status
-
 	if err = chain.CreateChain(nexusApiClient.Script).ServeRequest(ctx, script); err != nil {
 		log.Error(err, "An error has occurred while handling NexusScript")
 
 		script.Status.Value = common.StatusError
 		script.Status.Error = err.Error()
+		controllers.SetSyncFailed(&script.Status.ReconcileStatus, script.Generation, err)
 
-		if err = r.updateNexusScriptStatus(ctx, script, oldStatus); err != nil {
-			return ctrl.Result{}, err
+		if statusErr := r.updateNexusScriptStatus(ctx, script, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
 		}
 
-		return ctrl.Result{
-			RequeueAfter: controllers.ErrorRequeueTime,
-		}, nil
+		return controllers.RequeueOnError(err), nil
 	}
 
 	script.Status.Value = common.StatusCreated
 	script.Status.Error = ""
+	controllers.SetSynced(&script.Status.ReconcileStatus, script.Generation)
 
 	if err = r.updateNexusScriptStatus(ctx, script, oldStatus); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return controllers.ResyncResult(ctx, script, r.resyncInterval), nil
 }
 
 func (r *NexusScriptReconciler) updateNexusScriptStatus(
 	ctx context.Context,
 	script *nexusApi.NexusScript,
-	oldStatus nexusApi.NexusScriptStatus,
+	oldStatus *nexusApi.NexusScriptStatus,
 ) error {
-	if script.Status == oldStatus {
+	if equality.Semantic.DeepEqual(&script.Status, oldStatus) {
 		return nil
 	}
 
@@ -126,6 +161,8 @@ func (r *NexusScriptReconciler) updateNexusScriptStatus(
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *NexusScriptReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor(controllers.EventRecorderName)
+
 	err := ctrl.NewControllerManagedBy(mgr).
 		For(&nexusApi.NexusScript{}).
 		Complete(r)
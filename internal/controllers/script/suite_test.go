@@ -22,6 +22,7 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
 	"github.com/epam/edp-nexus-operator/internal/controllers/nexus"
 	nexusclient "github.com/epam/edp-nexus-operator/pkg/client/nexus"
 	"github.com/epam/edp-nexus-operator/pkg/testutils"
@@ -102,6 +103,7 @@ var _ = BeforeSuite(func() {
 	err = NewNexusScriptReconciler(
 		k8sManager.GetClient(),
 		nexusclient.NewApiClientProvider(k8sManager.GetClient()),
+		controllers.DefaultResyncInterval,
 	).
 		SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
@@ -0,0 +1,194 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+const (
+	taskKind = "NexusTask"
+
+	cronSchedule          = "cron"
+	defaultTimeZoneOffset = "+00:00"
+)
+
+type CreateTask struct {
+	apiClient nexus.TaskManager
+	ownership *controllers.OwnershipRecord
+}
+
+func NewCreateTask(apiClient nexus.TaskManager, ownership *controllers.OwnershipRecord) *CreateTask {
+	return &CreateTask{apiClient: apiClient, ownership: ownership}
+}
+
+func (c *CreateTask) ServeRequest(ctx context.Context, task *nexusApi.NexusTask) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", task.Spec.Name, "type", task.Spec.Type)
+	log.Info("Start creating task")
+
+	nexusTask, err := c.findTask(ctx, task)
+	if err != nil {
+		return err
+	}
+
+	if nexusTask == nil {
+		if task.Spec.ManagementPolicy == common.ManagementPolicyObserveOnly {
+			return fmt.Errorf("task %s of type %s doesn't exist in nexus", task.Spec.Name, task.Spec.Type)
+		}
+
+		log.Info("Task doesn't exist, creating new one")
+
+		id, err := c.apiClient.Create(ctx, specToTaskTemplate(task))
+		if err != nil {
+			return fmt.Errorf("failed to create task: %w", err)
+		}
+
+		task.Status.TaskID = id
+
+		log.Info("Task has been created", "id", id)
+
+		if _, err = c.ownership.Acquire(ctx, task, task.Spec.ManagementPolicy, id, false); err != nil {
+			return err
+		}
+
+		return c.updateTaskStatus(ctx, task)
+	}
+
+	manage, err := c.ownership.Acquire(ctx, task, task.Spec.ManagementPolicy, nexusTask.ID, true)
+	if err != nil {
+		return err
+	}
+
+	task.Status.TaskID = nexusTask.ID
+
+	if !manage {
+		return c.updateTaskStatus(ctx, task)
+	}
+
+	if nexusTask.Type != task.Spec.Type {
+		return fmt.Errorf("task %s has type %s in nexus, type can't be changed to %s",
+			nexusTask.ID, nexusTask.Type, task.Spec.Type)
+	}
+
+	// Nexus doesn't return properties and schedule of the task,
+	// so the task is updated only when the spec is changed.
+	if !controllers.IsSynced(&task.Status.ReconcileStatus, task.Generation) || nexusTask.Name != task.Spec.Name {
+		log.Info("Updating task", "id", nexusTask.ID)
+
+		if err = c.apiClient.Update(ctx, nexusTask.ID, specToTaskTemplate(task)); err != nil {
+			return fmt.Errorf("failed to update task: %w", err)
+		}
+
+		log.Info("Task has been updated")
+	}
+
+	return c.updateTaskStatus(ctx, task)
+}
+
+// findTask returns the task by the id from the status.
+// If the task was not created by the resource yet, the task with the same name and type is returned,
+// it is adopted according to the management policy.
+func (c *CreateTask) findTask(ctx context.Context, task *nexusApi.NexusTask) (*nexus.Task, error) {
+	if task.Status.TaskID != "" {
+		nexusTask, err := c.apiClient.Get(ctx, task.Status.TaskID)
+		if err == nil {
+			return nexusTask, nil
+		}
+
+		if !nexus.IsErrNotFound(err) {
+			return nil, fmt.Errorf("failed to get task: %w", err)
+		}
+
+		controllers.ReportDrift(ctx, taskKind, task, &task.Status.ReconcileStatus,
+			"Task was deleted in nexus, recreating it", "id", task.Status.TaskID)
+
+		if err = c.ownership.Release(ctx, task, task.Status.TaskID); err != nil {
+			return nil, err
+		}
+
+		task.Status.TaskID = ""
+
+		return nil, nil
+	}
+
+	tasks, err := c.apiClient.List(ctx, task.Spec.Type)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	for i := range tasks {
+		if tasks[i].Name == task.Spec.Name && tasks[i].Type == task.Spec.Type {
+			ctrl.LoggerFrom(ctx).Info("Task with the same name already exists", "id", tasks[i].ID)
+
+			return &tasks[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *CreateTask) updateTaskStatus(ctx context.Context, task *nexusApi.NexusTask) error {
+	nexusTask, err := c.apiClient.Get(ctx, task.Status.TaskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+
+	task.Status.CurrentState = nexusTask.CurrentState
+	task.Status.LastRunResult = nexusTask.LastRunResult
+	task.Status.LastRun = controllers.ToMetaTime(nexusTask.LastRun)
+	task.Status.NextRun = controllers.ToMetaTime(nexusTask.NextRun)
+
+	return nil
+}
+
+func specToTaskTemplate(task *nexusApi.NexusTask) *nexus.TaskTemplate {
+	return &nexus.TaskTemplate{
+		Type:                  task.Spec.Type,
+		Name:                  task.Spec.Name,
+		Enabled:               task.Spec.Enabled,
+		AlertEmail:            task.Spec.AlertEmail,
+		NotificationCondition: string(task.Spec.NotificationCondition),
+		Frequency:             specToTaskFrequency(task),
+		Properties:            task.Spec.Properties,
+	}
+}
+
+func specToTaskFrequency(task *nexusApi.NexusTask) nexus.TaskFrequency {
+	schedule := task.Spec.Schedule
+
+	if schedule.Cron != "" {
+		return nexus.TaskFrequency{
+			Schedule:       cronSchedule,
+			CronExpression: schedule.Cron,
+		}
+	}
+
+	interval := schedule.Interval
+	if interval == "" {
+		interval = nexusApi.TaskIntervalManual
+	}
+
+	if interval == nexusApi.TaskIntervalManual {
+		return nexus.TaskFrequency{
+			Schedule: string(interval),
+		}
+	}
+
+	startDate := task.CreationTimestamp
+	if schedule.StartDate != nil {
+		startDate = *schedule.StartDate
+	}
+
+	return nexus.TaskFrequency{
+		Schedule:       string(interval),
+		StartDate:      startDate.Unix(),
+		TimeZoneOffset: defaultTimeZoneOffset,
+		RecurringDays:  schedule.RecurringDays,
+	}
+}
@@ -0,0 +1,456 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/internal/controllers/testutils"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestCreateTask_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	nextRun := time.Date(2026, 1, 2, 1, 0, 0, 0, time.UTC)
+	syncedStatus := common.ReconcileStatus{
+		ObservedGeneration: 1,
+		Conditions: []metav1.Condition{
+			{Type: common.ConditionSynced, Status: metav1.ConditionTrue},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		task       *nexusApi.NexusTask
+		apiClient  func(t *testing.T) nexus.TaskManager
+		objects    []client.Object
+		wantErr    require.ErrorAssertionFunc
+		wantStatus func(t *testing.T, status *nexusApi.NexusTaskStatus)
+	}{
+		{
+			name: "task doesn't exist, creating new one",
+			task: &nexusApi.NexusTask{
+				Spec: nexusApi.NexusTaskSpec{
+					Name:       "compact",
+					Type:       "blobstore.compact",
+					Enabled:    true,
+					Properties: map[string]string{"blobstoreName": "default"},
+					Schedule: nexusApi.TaskSchedule{
+						Cron: "0 0 1 * * ?",
+					},
+					NotificationCondition: nexusApi.TaskNotificationConditionFailure,
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("List", mock.Anything, "blobstore.compact").
+					Return([]nexus.Task{{ID: "other", Name: "other", Type: "blobstore.compact"}}, nil)
+				m.On("Create", mock.Anything, &nexus.TaskTemplate{
+					Type:                  "blobstore.compact",
+					Name:                  "compact",
+					Enabled:               true,
+					NotificationCondition: "FAILURE",
+					Frequency: nexus.TaskFrequency{
+						Schedule:       "cron",
+						CronExpression: "0 0 1 * * ?",
+					},
+					Properties: map[string]string{"blobstoreName": "default"},
+				}).
+					Return("task-id", nil)
+				m.On("Get", mock.Anything, "task-id").
+					Return(&nexus.Task{
+						ID:           "task-id",
+						Name:         "compact",
+						Type:         "blobstore.compact",
+						CurrentState: "WAITING",
+						NextRun:      &nextRun,
+					}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantStatus: func(t *testing.T, status *nexusApi.NexusTaskStatus) {
+				assert.Equal(t, "task-id", status.TaskID)
+				assert.Equal(t, "WAITING", status.CurrentState)
+				require.NotNil(t, status.NextRun)
+				assert.True(t, status.NextRun.Time.Equal(nextRun))
+				assert.Nil(t, status.LastRun)
+			},
+		},
+		{
+			name: "task with the same name exists, adopting it",
+			task: &nexusApi.NexusTask{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(time.Unix(1700000000, 0)),
+				},
+				Spec: nexusApi.NexusTaskSpec{
+					Name: "docker-gc",
+					Type: "repository.docker.gc",
+					Schedule: nexusApi.TaskSchedule{
+						Interval: nexusApi.TaskIntervalDaily,
+					},
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("List", mock.Anything, "repository.docker.gc").
+					Return([]nexus.Task{{ID: "task-id", Name: "docker-gc", Type: "repository.docker.gc"}}, nil)
+				m.On("Update", mock.Anything, "task-id", &nexus.TaskTemplate{
+					Type: "repository.docker.gc",
+					Name: "docker-gc",
+					Frequency: nexus.TaskFrequency{
+						Schedule:       "daily",
+						StartDate:      1700000000,
+						TimeZoneOffset: "+00:00",
+					},
+				}).
+					Return(nil)
+				m.On("Get", mock.Anything, "task-id").
+					Return(&nexus.Task{ID: "task-id", Name: "docker-gc", Type: "repository.docker.gc"}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantStatus: func(t *testing.T, status *nexusApi.NexusTaskStatus) {
+				assert.Equal(t, "task-id", status.TaskID)
+			},
+		},
+		{
+			name: "task with the same name is managed by another resource",
+			task: &nexusApi.NexusTask{
+				ObjectMeta: metav1.ObjectMeta{Name: "docker-gc", Namespace: "default", UID: "task-uid"},
+				Spec: nexusApi.NexusTaskSpec{
+					Name: "docker-gc",
+					Type: "repository.docker.gc",
+				},
+			},
+			objects: []client.Object{
+				&nexusApi.NexusTask{
+					ObjectMeta: metav1.ObjectMeta{Name: "docker-gc", Namespace: "other", UID: "other-uid"},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "nexus-nexus-ownership", Namespace: "default"},
+					Data: map[string]string{
+						"NexusTask": `{"task-id":{"namespace":"other","name":"docker-gc","uid":"other-uid"}}`,
+					},
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("List", mock.Anything, "repository.docker.gc").
+					Return([]nexus.Task{{ID: "task-id", Name: "docker-gc", Type: "repository.docker.gc"}}, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, controllers.ErrAlreadyManaged)
+			},
+			wantStatus: func(t *testing.T, status *nexusApi.NexusTaskStatus) {
+				assert.Empty(t, status.TaskID)
+			},
+		},
+		{
+			name: "task with the same name exists and management policy is FailIfExists",
+			task: &nexusApi.NexusTask{
+				ObjectMeta: metav1.ObjectMeta{Name: "docker-gc", Namespace: "default", UID: "task-uid"},
+				Spec: nexusApi.NexusTaskSpec{
+					Name:             "docker-gc",
+					Type:             "repository.docker.gc",
+					ManagementPolicy: common.ManagementPolicyFailIfExists,
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("List", mock.Anything, "repository.docker.gc").
+					Return([]nexus.Task{{ID: "task-id", Name: "docker-gc", Type: "repository.docker.gc"}}, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "management policy is FailIfExists")
+			},
+		},
+		{
+			name: "task is observed without changes",
+			task: &nexusApi.NexusTask{
+				ObjectMeta: metav1.ObjectMeta{Name: "docker-gc", Namespace: "default", UID: "task-uid"},
+				Spec: nexusApi.NexusTaskSpec{
+					Name:             "docker-gc",
+					Type:             "repository.docker.gc",
+					ManagementPolicy: common.ManagementPolicyObserveOnly,
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("List", mock.Anything, "repository.docker.gc").
+					Return([]nexus.Task{{ID: "task-id", Name: "docker-gc", Type: "repository.docker.gc"}}, nil)
+				m.On("Get", mock.Anything, "task-id").
+					Return(&nexus.Task{
+						ID:           "task-id",
+						Name:         "docker-gc",
+						Type:         "repository.docker.gc",
+						CurrentState: "RUNNING",
+					}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantStatus: func(t *testing.T, status *nexusApi.NexusTaskStatus) {
+				assert.Equal(t, "task-id", status.TaskID)
+				assert.Equal(t, "RUNNING", status.CurrentState)
+			},
+		},
+		{
+			name: "observed task doesn't exist",
+			task: &nexusApi.NexusTask{
+				ObjectMeta: metav1.ObjectMeta{Name: "docker-gc", Namespace: "default", UID: "task-uid"},
+				Spec: nexusApi.NexusTaskSpec{
+					Name:             "docker-gc",
+					Type:             "repository.docker.gc",
+					ManagementPolicy: common.ManagementPolicyObserveOnly,
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("List", mock.Anything, "repository.docker.gc").Return([]nexus.Task{}, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "doesn't exist in nexus")
+			},
+		},
+		{
+			name: "task is synced, skipping update",
+			task: &nexusApi.NexusTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 1,
+				},
+				Spec: nexusApi.NexusTaskSpec{
+					Name: "compact",
+					Type: "blobstore.compact",
+				},
+				Status: nexusApi.NexusTaskStatus{
+					ReconcileStatus: syncedStatus,
+					TaskID:          "task-id",
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Get", mock.Anything, "task-id").
+					Return(&nexus.Task{
+						ID:            "task-id",
+						Name:          "compact",
+						Type:          "blobstore.compact",
+						LastRunResult: "OK",
+					}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantStatus: func(t *testing.T, status *nexusApi.NexusTaskStatus) {
+				assert.Equal(t, "OK", status.LastRunResult)
+			},
+		},
+		{
+			name: "task was renamed in nexus, updating",
+			task: &nexusApi.NexusTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 1,
+				},
+				Spec: nexusApi.NexusTaskSpec{
+					Name: "compact",
+					Type: "blobstore.compact",
+					Schedule: nexusApi.TaskSchedule{
+						Interval: nexusApi.TaskIntervalManual,
+					},
+				},
+				Status: nexusApi.NexusTaskStatus{
+					ReconcileStatus: syncedStatus,
+					TaskID:          "task-id",
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Get", mock.Anything, "task-id").
+					Return(&nexus.Task{ID: "task-id", Name: "renamed", Type: "blobstore.compact"}, nil)
+				m.On("Update", mock.Anything, "task-id", &nexus.TaskTemplate{
+					Type:      "blobstore.compact",
+					Name:      "compact",
+					Frequency: nexus.TaskFrequency{Schedule: "manual"},
+				}).
+					Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "task was deleted in nexus, recreating it",
+			task: &nexusApi.NexusTask{
+				Spec: nexusApi.NexusTaskSpec{
+					Name: "compact",
+					Type: "blobstore.compact",
+				},
+				Status: nexusApi.NexusTaskStatus{
+					TaskID: "deleted-id",
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Get", mock.Anything, "deleted-id").
+					Return(nil, nexus.ErrNotFound)
+				m.On("Create", mock.Anything, &nexus.TaskTemplate{
+					Type:      "blobstore.compact",
+					Name:      "compact",
+					Frequency: nexus.TaskFrequency{Schedule: "manual"},
+				}).
+					Return("new-id", nil)
+				m.On("Get", mock.Anything, "new-id").
+					Return(&nexus.Task{ID: "new-id", Name: "compact", Type: "blobstore.compact"}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantStatus: func(t *testing.T, status *nexusApi.NexusTaskStatus) {
+				assert.Equal(t, "new-id", status.TaskID)
+			},
+		},
+		{
+			name: "task has different type in nexus",
+			task: &nexusApi.NexusTask{
+				Spec: nexusApi.NexusTaskSpec{
+					Name: "compact",
+					Type: "blobstore.compact",
+				},
+				Status: nexusApi.NexusTaskStatus{
+					TaskID: "task-id",
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Get", mock.Anything, "task-id").
+					Return(&nexus.Task{ID: "task-id", Name: "compact", Type: "repository.docker.gc"}, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "type can't be changed")
+			},
+		},
+		{
+			name: "failed to get task",
+			task: &nexusApi.NexusTask{
+				Spec: nexusApi.NexusTaskSpec{
+					Name: "compact",
+					Type: "blobstore.compact",
+				},
+				Status: nexusApi.NexusTaskStatus{
+					TaskID: "task-id",
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Get", mock.Anything, "task-id").
+					Return(nil, errors.New("connection refused"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get task")
+			},
+		},
+		{
+			name: "failed to create task",
+			task: &nexusApi.NexusTask{
+				Spec: nexusApi.NexusTaskSpec{
+					Name: "compact",
+					Type: "blobstore.compact",
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("List", mock.Anything, "blobstore.compact").
+					Return(nil, nil)
+				m.On("Create", mock.Anything, mock.Anything).
+					Return("", errors.New("invalid properties"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to create task")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewCreateTask(tt.apiClient(t), testutils.NewOwnershipRecord(t, tt.objects...))
+			err := c.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.task)
+
+			tt.wantErr(t, err)
+
+			if tt.wantStatus != nil {
+				tt.wantStatus(t, &tt.task.Status)
+			}
+		})
+	}
+}
+
+func TestSpecToTaskFrequency(t *testing.T) {
+	t.Parallel()
+
+	startDate := metav1.NewTime(time.Unix(1800000000, 0))
+
+	got := specToTaskFrequency(&nexusApi.NexusTask{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(1700000000, 0)),
+		},
+		Spec: nexusApi.NexusTaskSpec{
+			Schedule: nexusApi.TaskSchedule{
+				Interval:      nexusApi.TaskIntervalWeekly,
+				StartDate:     &startDate,
+				RecurringDays: []int{1, 4},
+			},
+		},
+	})
+
+	assert.Equal(t, nexus.TaskFrequency{
+		Schedule:       "weekly",
+		StartDate:      1800000000,
+		TimeZoneOffset: "+00:00",
+		RecurringDays:  []int{1, 4},
+	}, got)
+}
@@ -0,0 +1,42 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+type RemoveTask struct {
+	apiClient nexus.TaskManager
+}
+
+func NewRemoveTask(apiClient nexus.TaskManager) *RemoveTask {
+	return &RemoveTask{apiClient: apiClient}
+}
+
+func (c *RemoveTask) ServeRequest(ctx context.Context, task *nexusApi.NexusTask) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", task.Spec.Name, "id", task.Status.TaskID)
+	log.Info("Start removing task")
+
+	if task.Status.TaskID == "" {
+		log.Info("Task was not created in nexus, skipping removal")
+
+		return nil
+	}
+
+	if err := c.apiClient.Delete(ctx, task.Status.TaskID); err != nil {
+		if !nexus.IsErrNotFound(err) {
+			return fmt.Errorf("failed to delete task: %w", err)
+		}
+
+		log.Info("Task doesn't exist, skipping removal")
+	}
+
+	log.Info("Task has been removed")
+
+	return nil
+}
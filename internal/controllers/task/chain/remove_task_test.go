@@ -0,0 +1,101 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestRemoveTask_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		task      *nexusApi.NexusTask
+		apiClient func(t *testing.T) nexus.TaskManager
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "task successfully removed",
+			task: &nexusApi.NexusTask{
+				Status: nexusApi.NexusTaskStatus{
+					TaskID: "task-id",
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Delete", mock.Anything, "task-id").
+					Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "task was not created, skipping removal",
+			task: &nexusApi.NexusTask{},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				return mocks.NewMockTaskManager(t)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "task doesn't exist, skipping removal",
+			task: &nexusApi.NexusTask{
+				Status: nexusApi.NexusTaskStatus{
+					TaskID: "task-id",
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Delete", mock.Anything, "task-id").
+					Return(nexus.ErrNotFound)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "failed to remove task",
+			task: &nexusApi.NexusTask{
+				Status: nexusApi.NexusTaskStatus{
+					TaskID: "task-id",
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Delete", mock.Anything, "task-id").
+					Return(errors.New("failed to remove task"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to delete task")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewRemoveTask(tt.apiClient(t))
+			err := c.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.task)
+
+			tt.wantErr(t, err)
+		})
+	}
+}
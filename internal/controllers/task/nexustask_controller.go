@@ -0,0 +1,205 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/internal/controllers/task/chain"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+type apiClientProvider interface {
+	GetNexusTaskClientFromNexusRef(
+		ctx context.Context,
+		namespace string,
+		ref common.HasNexusRef,
+	) (*nexus.TaskClient, error)
+}
+
+// NexusTaskReconciler reconciles a NexusTask object.
+type NexusTaskReconciler struct {
+	client            client.Client
+	apiClientProvider apiClientProvider
+	resyncInterval    time.Duration
+	recorder          record.EventRecorder
+}
+
+func NewNexusTaskReconciler(
+	k8sClient client.Client,
+	apiClientProvider apiClientProvider,
+	resyncInterval time.Duration,
+) *NexusTaskReconciler {
+	return &NexusTaskReconciler{
+		client:            k8sClient,
+		apiClientProvider: apiClientProvider,
+		resyncInterval:    resyncInterval,
+	}
+}
+
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexustasks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexustasks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexustasks/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *NexusTaskReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling NexusTask")
+
+	task := &nexusApi.NexusTask{}
+	if err := r.client.Get(ctx, req.NamespacedName, task); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get NexusTask: %w", err)
+	}
+
+	oldStatus := task.Status.DeepCopy()
+
+	ownership := controllers.NewOwnershipRecord(r.client, task.Namespace, task.Spec.NexusRef)
+
+	if task.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(task, controllers.NexusOperatorFinalizer) {
+			deleteFromNexus := false
+
+			if task.Status.TaskID != "" {
+				var err error
+
+				deleteFromNexus, err = ownership.CanDelete(ctx, task, task.Spec.ManagementPolicy, task.Status.TaskID)
+				if err != nil {
+					log.Error(err, "An error has occurred while checking ownership of NexusTask")
+
+					return controllers.RequeueOnError(err), nil
+				}
+			}
+
+			if deleteFromNexus && controllers.ShouldDeleteFromNexus(ctx, r.recorder, task, task.Spec.DeletionPolicy) {
+				nexusApiClient, err := r.apiClientProvider.GetNexusTaskClientFromNexusRef(ctx, task.Namespace, task)
+				if err != nil {
+					log.Error(err, "An error has occurred while getting nexus api client")
+
+					controllers.SetDependenciesNotResolved(&task.Status.ReconcileStatus, task.Generation, err)
+
+					if statusErr := r.updateNexusTaskStatus(ctx, task, oldStatus); statusErr != nil {
+						return ctrl.Result{}, statusErr
+					}
+
+					return ctrl.Result{
+						RequeueAfter: controllers.ErrorRequeueTime,
+					}, nil
+				}
+
+				if err = chain.NewRemoveTask(nexusApiClient).ServeRequest(ctx, task); err != nil {
+					log.Error(err, "An error has occurred while deleting NexusTask")
+
+					return controllers.RequeueOnError(err), nil
+				}
+			}
+
+			if task.Status.TaskID != "" {
+				if err := ownership.Release(ctx, task, task.Status.TaskID); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+
+			controllerutil.RemoveFinalizer(task, controllers.NexusOperatorFinalizer)
+
+			if err := r.client.Update(ctx, task); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to update NexusTask: %w", err)
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	nexusApiClient, err := r.apiClientProvider.GetNexusTaskClientFromNexusRef(ctx, task.Namespace, task)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&task.Status.ReconcileStatus, task.Generation, err)
+
+		if statusErr := r.updateNexusTaskStatus(ctx, task, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
+	if controllerutil.AddFinalizer(task, controllers.NexusOperatorFinalizer) {
+		err = r.client.Update(ctx, task)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update NexusTask: %w", err)
+		}
+	}
+
+	if err = chain.NewCreateTask(nexusApiClient, ownership).ServeRequest(ctx, task); err != nil {
+		log.Error(err, "An error has occurred while handling NexusTask")
+
+		task.Status.Value = common.StatusError
+		task.Status.Error = err.Error()
+		controllers.SetSyncFailed(&task.Status.ReconcileStatus, task.Generation, err)
+
+		if statusErr := r.updateNexusTaskStatus(ctx, task, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return controllers.RequeueOnError(err), nil
+	}
+
+	task.Status.Value = common.StatusCreated
+	task.Status.Error = ""
+	controllers.SetSynced(&task.Status.ReconcileStatus, task.Generation)
+
+	if err = r.updateNexusTaskStatus(ctx, task, oldStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return controllers.ResyncResult(ctx, task, r.resyncInterval), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NexusTaskReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor(controllers.EventRecorderName)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&nexusApi.NexusTask{}).
+		Complete(r)
+
+	if err != nil {
+		return fmt.Errorf("failed to setup NexusTask controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *NexusTaskReconciler) updateNexusTaskStatus(
+	ctx context.Context,
+	task *nexusApi.NexusTask,
+	oldStatus *nexusApi.NexusTaskStatus,
+) error {
+	if equality.Semantic.DeepEqual(&task.Status, oldStatus) {
+		return nil
+	}
+
+	if err := r.client.Status().Update(ctx, task); err != nil {
+		return fmt.Errorf("failed to update NexusTask status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package task
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+var _ = Describe("NexusTask controller", func() {
+	nexusTaskCRName := "nexus-task"
+	It("Should create NexusTask object", func() {
+		By("By creating a new NexusTask object")
+		newNexusTask := &nexusApi.NexusTask{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nexusTaskCRName,
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusTaskSpec{
+				Name:    "test-compact-task",
+				Type:    "blobstore.compact",
+				Enabled: true,
+				Properties: map[string]string{
+					"blobstoreName": "default",
+				},
+				Schedule: nexusApi.TaskSchedule{
+					Cron: "0 0 1 * * ?",
+				},
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusTask)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusTask := &nexusApi.NexusTask{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusTaskCRName, Namespace: namespace}, createdNexusTask)
+			if err != nil {
+				return false
+			}
+
+			return createdNexusTask.Status.Value == common.StatusCreated &&
+				createdNexusTask.Status.Error == "" &&
+				createdNexusTask.Status.TaskID != ""
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should update NexusTask object", func() {
+		By("Getting NexusTask object")
+		createdNexusTask := &nexusApi.NexusTask{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nexusTaskCRName, Namespace: namespace}, createdNexusTask)).
+			Should(Succeed())
+
+		By("Updating NexusTask object")
+		createdNexusTask.Spec.Schedule = nexusApi.TaskSchedule{
+			Interval: nexusApi.TaskIntervalDaily,
+		}
+
+		Expect(k8sClient.Update(ctx, createdNexusTask)).Should(Succeed())
+		Eventually(func() bool {
+			updatedNexusTask := &nexusApi.NexusTask{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusTaskCRName, Namespace: namespace}, updatedNexusTask)
+			if err != nil {
+				return false
+			}
+
+			return updatedNexusTask.Status.ObservedGeneration == updatedNexusTask.Generation &&
+				updatedNexusTask.Status.Value == common.StatusCreated &&
+				updatedNexusTask.Status.NextRun != nil
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should delete NexusTask object", func() {
+		By("Getting NexusTask object")
+		createdNexusTask := &nexusApi.NexusTask{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nexusTaskCRName, Namespace: namespace}, createdNexusTask)).
+			Should(Succeed())
+
+		By("Deleting NexusTask object")
+		Expect(k8sClient.Delete(ctx, createdNexusTask)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusTask := &nexusApi.NexusTask{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusTaskCRName, Namespace: namespace}, createdNexusTask)
+			return k8sErrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+})
@@ -0,0 +1,101 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+const (
+	taskStateRunning = "RUNNING"
+	taskResultOK     = "OK"
+)
+
+type RunTask struct {
+	apiClient nexus.TaskManager
+}
+
+func NewRunTask(apiClient nexus.TaskManager) *RunTask {
+	return &RunTask{apiClient: apiClient}
+}
+
+// ServeRequest starts the task from the status if it was not started yet,
+// otherwise it checks if the run is finished and records its outcome.
+func (c *RunTask) ServeRequest(ctx context.Context, run *nexusApi.NexusTaskRun) error {
+	if run.Status.StartTime == nil {
+		return c.startTask(ctx, run)
+	}
+
+	return c.checkTask(ctx, run)
+}
+
+func (c *RunTask) startTask(ctx context.Context, run *nexusApi.NexusTaskRun) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("id", run.Status.TaskID)
+	log.Info("Starting task")
+
+	task, err := c.apiClient.Get(ctx, run.Status.TaskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+
+	if err = c.apiClient.Run(ctx, run.Status.TaskID); err != nil {
+		return fmt.Errorf("failed to run task: %w", err)
+	}
+
+	now := metav1.Now()
+	run.Status.StartTime = &now
+	run.Status.PreviousRun = controllers.ToMetaTime(task.LastRun)
+	run.Status.Value = nexusApi.TaskRunStatusRunning
+
+	log.Info("Task has been started")
+
+	return nil
+}
+
+func (c *RunTask) checkTask(ctx context.Context, run *nexusApi.NexusTaskRun) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("id", run.Status.TaskID)
+
+	task, err := c.apiClient.Get(ctx, run.Status.TaskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+
+	// Nexus updates the last run of the task when the run starts,
+	// so the run is finished when the last run is changed and the task is not running.
+	if task.CurrentState == taskStateRunning || sameTime(controllers.ToMetaTime(task.LastRun), run.Status.PreviousRun) {
+		log.Info("Task is still running")
+
+		run.Status.Value = nexusApi.TaskRunStatusRunning
+
+		return nil
+	}
+
+	now := metav1.Now()
+	run.Status.CompletionTime = &now
+	run.Status.Duration = now.Sub(run.Status.StartTime.Time).Round(time.Second).String()
+	run.Status.Result = task.LastRunResult
+
+	run.Status.Value = nexusApi.TaskRunStatusFailed
+	if task.LastRunResult == taskResultOK {
+		run.Status.Value = nexusApi.TaskRunStatusSucceeded
+	}
+
+	log.Info("Task has been finished", "result", task.LastRunResult, "duration", run.Status.Duration)
+
+	return nil
+}
+
+func sameTime(a, b *metav1.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(b)
+}
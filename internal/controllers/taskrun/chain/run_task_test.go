@@ -0,0 +1,197 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus/mocks"
+)
+
+func TestRunTask_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	previousRun := time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC)
+	lastRun := time.Date(2026, 1, 2, 1, 0, 0, 0, time.UTC)
+	startTime := metav1.NewTime(time.Now().Add(-time.Minute))
+
+	startedRun := func() *nexusApi.NexusTaskRun {
+		return &nexusApi.NexusTaskRun{
+			Status: nexusApi.NexusTaskRunStatus{
+				TaskID:      "task-id",
+				StartTime:   &startTime,
+				PreviousRun: &metav1.Time{Time: previousRun},
+				Value:       nexusApi.TaskRunStatusRunning,
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		run        *nexusApi.NexusTaskRun
+		apiClient  func(t *testing.T) nexus.TaskManager
+		wantErr    require.ErrorAssertionFunc
+		wantStatus func(t *testing.T, status *nexusApi.NexusTaskRunStatus)
+	}{
+		{
+			name: "task is started",
+			run: &nexusApi.NexusTaskRun{
+				Status: nexusApi.NexusTaskRunStatus{
+					TaskID: "task-id",
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Get", mock.Anything, "task-id").
+					Return(&nexus.Task{ID: "task-id", CurrentState: "WAITING", LastRun: &previousRun}, nil)
+				m.On("Run", mock.Anything, "task-id").
+					Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantStatus: func(t *testing.T, status *nexusApi.NexusTaskRunStatus) {
+				assert.NotNil(t, status.StartTime)
+				require.NotNil(t, status.PreviousRun)
+				assert.True(t, status.PreviousRun.Time.Equal(previousRun))
+				assert.Equal(t, nexusApi.TaskRunStatusRunning, status.Value)
+			},
+		},
+		{
+			name: "failed to start task",
+			run: &nexusApi.NexusTaskRun{
+				Status: nexusApi.NexusTaskRunStatus{
+					TaskID: "task-id",
+				},
+			},
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Get", mock.Anything, "task-id").
+					Return(&nexus.Task{ID: "task-id", CurrentState: "RUNNING"}, nil)
+				m.On("Run", mock.Anything, "task-id").
+					Return(errors.New("task is already running"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to run task")
+			},
+			wantStatus: func(t *testing.T, status *nexusApi.NexusTaskRunStatus) {
+				assert.Nil(t, status.StartTime)
+			},
+		},
+		{
+			name: "task is still running",
+			run:  startedRun(),
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Get", mock.Anything, "task-id").
+					Return(&nexus.Task{ID: "task-id", CurrentState: "RUNNING", LastRun: &lastRun}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantStatus: func(t *testing.T, status *nexusApi.NexusTaskRunStatus) {
+				assert.Nil(t, status.CompletionTime)
+				assert.Equal(t, nexusApi.TaskRunStatusRunning, status.Value)
+			},
+		},
+		{
+			name: "task run is not registered in nexus yet",
+			run:  startedRun(),
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Get", mock.Anything, "task-id").
+					Return(&nexus.Task{ID: "task-id", CurrentState: "WAITING", LastRun: &previousRun}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantStatus: func(t *testing.T, status *nexusApi.NexusTaskRunStatus) {
+				assert.Nil(t, status.CompletionTime)
+				assert.Equal(t, nexusApi.TaskRunStatusRunning, status.Value)
+			},
+		},
+		{
+			name: "task finished successfully",
+			run:  startedRun(),
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Get", mock.Anything, "task-id").
+					Return(&nexus.Task{ID: "task-id", CurrentState: "WAITING", LastRun: &lastRun, LastRunResult: "OK"}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantStatus: func(t *testing.T, status *nexusApi.NexusTaskRunStatus) {
+				assert.NotNil(t, status.CompletionTime)
+				assert.Equal(t, "OK", status.Result)
+				assert.Equal(t, "1m0s", status.Duration)
+				assert.Equal(t, nexusApi.TaskRunStatusSucceeded, status.Value)
+			},
+		},
+		{
+			name: "task failed",
+			run:  startedRun(),
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Get", mock.Anything, "task-id").
+					Return(&nexus.Task{ID: "task-id", CurrentState: "DONE", LastRun: &lastRun, LastRunResult: "FAILED"}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantStatus: func(t *testing.T, status *nexusApi.NexusTaskRunStatus) {
+				assert.Equal(t, "FAILED", status.Result)
+				assert.Equal(t, nexusApi.TaskRunStatusFailed, status.Value)
+			},
+		},
+		{
+			name: "failed to get task",
+			run:  startedRun(),
+			apiClient: func(t *testing.T) nexus.TaskManager {
+				m := mocks.NewMockTaskManager(t)
+
+				m.On("Get", mock.Anything, "task-id").
+					Return(nil, nexus.ErrNotFound)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, nexus.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewRunTask(tt.apiClient(t))
+			err := c.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.run)
+
+			tt.wantErr(t, err)
+
+			if tt.wantStatus != nil {
+				tt.wantStatus(t, &tt.run.Status)
+			}
+		})
+	}
+}
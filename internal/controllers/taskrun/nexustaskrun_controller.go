@@ -0,0 +1,293 @@
+package taskrun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+	"github.com/epam/edp-nexus-operator/internal/controllers/taskrun/chain"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+const (
+	// pollInterval is the interval of checking if the running task is finished.
+	pollInterval = time.Second * 5
+	// defaultTimeout is used for the runs created before the timeout was added to the spec.
+	defaultTimeout = time.Hour
+
+	reasonTaskSucceeded = "TaskSucceeded"
+	reasonTaskFailed    = "TaskFailed"
+	reasonTimeout       = "Timeout"
+)
+
+var errTaskNotFound = errors.New("task not found")
+
+type apiClientProvider interface {
+	GetNexusTaskClientFromNexusRef(
+		ctx context.Context,
+		namespace string,
+		ref common.HasNexusRef,
+	) (*nexus.TaskClient, error)
+}
+
+// NexusTaskRunReconciler reconciles a NexusTaskRun object.
+type NexusTaskRunReconciler struct {
+	client            client.Client
+	apiClientProvider apiClientProvider
+}
+
+func NewNexusTaskRunReconciler(k8sClient client.Client, apiClientProvider apiClientProvider) *NexusTaskRunReconciler {
+	return &NexusTaskRunReconciler{
+		client:            k8sClient,
+		apiClientProvider: apiClientProvider,
+	}
+}
+
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexustaskruns,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexustaskruns/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexustasks,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *NexusTaskRunReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling NexusTaskRun")
+
+	run := &nexusApi.NexusTaskRun{}
+	if err := r.client.Get(ctx, req.NamespacedName, run); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get NexusTaskRun: %w", err)
+	}
+
+	if controllers.IsCompleted(&run.Status.ReconcileStatus) {
+		log.Info("NexusTaskRun is already completed")
+
+		return ctrl.Result{}, nil
+	}
+
+	oldStatus := run.Status.DeepCopy()
+
+	timeout := runTimeout(run)
+
+	if time.Until(run.CreationTimestamp.Add(timeout)) <= 0 {
+		log.Info("NexusTaskRun has timed out", "timeout", timeout.String())
+
+		run.Status.Value = nexusApi.TaskRunStatusFailed
+		run.Status.Error = fmt.Sprintf("task didn't finish in %s", timeout)
+		controllers.SetCompleted(&run.Status.ReconcileStatus, run.Generation, false, reasonTimeout, run.Status.Error)
+
+		if err := r.updateNexusTaskRunStatus(ctx, run, oldStatus); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	taskID, nexusRef, err := r.getTask(ctx, run)
+	if err != nil {
+		if !errors.Is(err, errTaskNotFound) {
+			return ctrl.Result{}, err
+		}
+
+		log.Info("Task is not available yet", "reason", err.Error())
+
+		controllers.SetDependencyNotFound(&run.Status.ReconcileStatus, run.Generation, err)
+
+		if statusErr := r.updateNexusTaskRunStatus(ctx, run, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return requeueUntilTimeout(run, ctrl.Result{RequeueAfter: controllers.ErrorRequeueTime}), nil
+	}
+
+	nexusApiClient, err := r.apiClientProvider.GetNexusTaskClientFromNexusRef(ctx, run.Namespace, nexusRef)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&run.Status.ReconcileStatus, run.Generation, err)
+
+		if statusErr := r.updateNexusTaskRunStatus(ctx, run, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return requeueUntilTimeout(run, ctrl.Result{RequeueAfter: controllers.ErrorRequeueTime}), nil
+	}
+
+	run.Status.TaskID = taskID
+
+	if err = chain.NewRunTask(nexusApiClient).ServeRequest(ctx, run); err != nil {
+		log.Error(err, "An error has occurred while handling NexusTaskRun")
+
+		run.Status.Value = common.StatusError
+		run.Status.Error = err.Error()
+		controllers.SetSyncFailed(&run.Status.ReconcileStatus, run.Generation, err)
+
+		if statusErr := r.updateNexusTaskRunStatus(ctx, run, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return requeueUntilTimeout(run, controllers.RequeueOnError(err)), nil
+	}
+
+	run.Status.Error = ""
+	controllers.SetSynced(&run.Status.ReconcileStatus, run.Generation)
+
+	switch run.Status.Value {
+	case nexusApi.TaskRunStatusSucceeded:
+		controllers.SetCompleted(&run.Status.ReconcileStatus, run.Generation, true, reasonTaskSucceeded,
+			fmt.Sprintf("Task finished with result %s in %s", run.Status.Result, run.Status.Duration))
+	case nexusApi.TaskRunStatusFailed:
+		controllers.SetCompleted(&run.Status.ReconcileStatus, run.Generation, false, reasonTaskFailed,
+			fmt.Sprintf("Task finished with result %s in %s", run.Status.Result, run.Status.Duration))
+	}
+
+	if err = r.updateNexusTaskRunStatus(ctx, run, oldStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if controllers.IsCompleted(&run.Status.ReconcileStatus) {
+		return ctrl.Result{}, nil
+	}
+
+	return requeueUntilTimeout(run, ctrl.Result{RequeueAfter: pollInterval}), nil
+}
+
+// runTimeout returns the timeout of the run from the spec or the default one.
+func runTimeout(run *nexusApi.NexusTaskRun) time.Duration {
+	if run.Spec.Timeout == nil || run.Spec.Timeout.Duration <= 0 {
+		return defaultTimeout
+	}
+
+	return run.Spec.Timeout.Duration
+}
+
+// requeueUntilTimeout makes sure the run is reconciled when the timeout is over,
+// even if the error is not requeued, so the run doesn't stay incomplete forever.
+func requeueUntilTimeout(run *nexusApi.NexusTaskRun, result ctrl.Result) ctrl.Result {
+	left := time.Until(run.CreationTimestamp.Add(runTimeout(run)))
+	if left < 0 {
+		left = 0
+	}
+
+	if result.RequeueAfter == 0 || result.RequeueAfter > left {
+		// requeue right after the deadline, so the timeout is detected on the next reconciliation
+		result.RequeueAfter = left + time.Second
+	}
+
+	return result
+}
+
+// getTask returns the id of the task to run and the resource that refers to the Nexus instance of the task.
+// The task id is taken from the status when the task was already started,
+// so the run is not affected by the changes of NexusTask.
+func (r *NexusTaskRunReconciler) getTask(
+	ctx context.Context,
+	run *nexusApi.NexusTaskRun,
+) (string, common.HasNexusRef, error) {
+	if run.Spec.TaskRef == nil {
+		return run.Spec.TaskID, run, nil
+	}
+
+	task := &nexusApi.NexusTask{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: run.Namespace, Name: run.Spec.TaskRef.Name}, task); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return "", nil, fmt.Errorf("%w: NexusTask %s doesn't exist", errTaskNotFound, run.Spec.TaskRef.Name)
+		}
+
+		return "", nil, fmt.Errorf("failed to get NexusTask: %w", err)
+	}
+
+	if run.Status.StartTime != nil {
+		return run.Status.TaskID, task, nil
+	}
+
+	if task.Status.TaskID == "" {
+		return "", nil, fmt.Errorf("%w: NexusTask %s is not created in nexus yet", errTaskNotFound, task.Name)
+	}
+
+	return task.Status.TaskID, task, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NexusTaskRunReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&nexusApi.NexusTaskRun{}).
+		Watches(
+			// Watch for NexusTasks to start runs that wait for the task to be created in nexus.
+			&nexusApi.NexusTask{},
+			handler.EnqueueRequestsFromMapFunc(r.mapTaskToNexusTaskRun),
+			builder.WithPredicates(predicate.Funcs{
+				DeleteFunc: func(_ event.DeleteEvent) bool {
+					return false
+				},
+			}),
+		).
+		Complete(r)
+
+	if err != nil {
+		return fmt.Errorf("failed to setup NexusTaskRun controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *NexusTaskRunReconciler) mapTaskToNexusTaskRun(ctx context.Context, obj client.Object) []reconcile.Request {
+	task, ok := obj.(*nexusApi.NexusTask)
+	if !ok {
+		return nil
+	}
+
+	log := ctrl.LoggerFrom(ctx).WithValues("task", task.Name)
+	runList := &nexusApi.NexusTaskRunList{}
+
+	if err := r.client.List(ctx, runList, client.InNamespace(task.Namespace)); err != nil {
+		log.Error(err, "Failed to get NexusTaskRun list")
+
+		return nil
+	}
+
+	var requests []reconcile.Request
+
+	for i := range runList.Items {
+		run := &runList.Items[i]
+
+		if run.Spec.TaskRef != nil && run.Spec.TaskRef.Name == task.Name && run.Status.StartTime == nil {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(run)})
+		}
+	}
+
+	return requests
+}
+
+func (r *NexusTaskRunReconciler) updateNexusTaskRunStatus(
+	ctx context.Context,
+	run *nexusApi.NexusTaskRun,
+	oldStatus *nexusApi.NexusTaskRunStatus,
+) error {
+	if equality.Semantic.DeepEqual(&run.Status, oldStatus) {
+		return nil
+	}
+
+	if err := r.client.Status().Update(ctx, run); err != nil {
+		return fmt.Errorf("failed to update NexusTaskRun status: %w", err)
+	}
+
+	return nil
+}
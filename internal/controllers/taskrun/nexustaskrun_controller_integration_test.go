@@ -0,0 +1,106 @@
+package taskrun
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+var _ = Describe("NexusTaskRun controller", func() {
+	nexusTaskCRName := "nexus-task"
+	nexusTaskRunCRName := "nexus-task-run"
+	It("Should run NexusTask", func() {
+		By("By creating a new NexusTask object")
+		newNexusTask := &nexusApi.NexusTask{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nexusTaskCRName,
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusTaskSpec{
+				Name:    "test-run-compact-task",
+				Type:    "blobstore.compact",
+				Enabled: true,
+				Properties: map[string]string{
+					"blobstoreName": "default",
+				},
+				Schedule: nexusApi.TaskSchedule{
+					Interval: nexusApi.TaskIntervalManual,
+				},
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusTask)).Should(Succeed())
+
+		By("By creating a new NexusTaskRun object")
+		newNexusTaskRun := &nexusApi.NexusTaskRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nexusTaskRunCRName,
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusTaskRunSpec{
+				TaskRef: &nexusApi.TaskRef{
+					Name: nexusTaskCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusTaskRun)).Should(Succeed())
+		Eventually(func() bool {
+			createdNexusTaskRun := &nexusApi.NexusTaskRun{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: nexusTaskRunCRName, Namespace: namespace}, createdNexusTaskRun)
+			if err != nil {
+				return false
+			}
+
+			return meta.IsStatusConditionTrue(createdNexusTaskRun.Status.Conditions, common.ConditionSucceeded) &&
+				createdNexusTaskRun.Status.Value == nexusApi.TaskRunStatusSucceeded &&
+				createdNexusTaskRun.Status.Duration != ""
+		}, time.Minute, interval).Should(BeTrue())
+	})
+	It("Should not allow to change NexusTaskRun", func() {
+		By("Getting NexusTaskRun object")
+		createdNexusTaskRun := &nexusApi.NexusTaskRun{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nexusTaskRunCRName, Namespace: namespace}, createdNexusTaskRun)).
+			Should(Succeed())
+
+		By("Updating NexusTaskRun object")
+		createdNexusTaskRun.Spec.TaskRef.Name = "other-task"
+		Expect(k8sClient.Update(ctx, createdNexusTaskRun)).ShouldNot(Succeed())
+	})
+	It("Should fail NexusTaskRun when the task doesn't start in time", func() {
+		By("By creating a new NexusTaskRun object that refers to the missing NexusTask")
+		newNexusTaskRun := &nexusApi.NexusTaskRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "nexus-task-run-timeout",
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusTaskRunSpec{
+				TaskRef: &nexusApi.TaskRef{
+					Name: "missing-nexus-task",
+				},
+				Timeout: &metav1.Duration{Duration: 2 * time.Second},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusTaskRun)).Should(Succeed())
+		Eventually(func(g Gomega) {
+			createdNexusTaskRun := &nexusApi.NexusTaskRun{}
+			g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: newNexusTaskRun.Name, Namespace: namespace}, createdNexusTaskRun)).
+				Should(Succeed())
+
+			failed := meta.FindStatusCondition(createdNexusTaskRun.Status.Conditions, common.ConditionFailed)
+			g.Expect(failed).ShouldNot(BeNil())
+			g.Expect(failed.Status).Should(Equal(metav1.ConditionTrue))
+			g.Expect(failed.Reason).Should(Equal("Timeout"))
+			g.Expect(createdNexusTaskRun.Status.Value).Should(Equal(nexusApi.TaskRunStatusFailed))
+		}).WithPolling(time.Second).WithTimeout(time.Minute).Should(Succeed())
+	})
+})
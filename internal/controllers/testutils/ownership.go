@@ -0,0 +1,31 @@
+package testutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
+)
+
+// NewOwnershipRecord returns the ownership record of the "nexus" Nexus in the "default" namespace
+// backed by the fake client with the given objects.
+func NewOwnershipRecord(t testing.TB, objects ...client.Object) *controllers.OwnershipRecord {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, nexusApi.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	return controllers.NewOwnershipRecord(
+		fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		"default",
+		common.NexusRef{Name: "nexus"},
+	)
+}
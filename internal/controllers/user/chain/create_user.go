@@ -2,28 +2,54 @@ package chain
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"golang.org/x/crypto/argon2"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/internal/controllers"
 	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
+const (
+	userKind = "NexusUser"
+
+	// ReasonPasswordRotated is the reason of the event recorded when the user password is changed in nexus.
+	ReasonPasswordRotated = "PasswordRotated"
+
+	// argon2id parameters of the password hash stored in the status, see RFC 9106.
+	passwordSaltSize = 16
+	argon2Memory     = 19 * 1024
+	argon2Iterations = 2
+	argon2Threads    = 1
+	argon2KeyLength  = 32
+)
+
+// ErrExternalUserNotFound is returned when the user doesn't exist in the external user source.
+var ErrExternalUserNotFound = errors.New("user doesn't exist in the user source")
+
 // CreateUser is a handler for creating user.
 type CreateUser struct {
 	nexusUserApiClient nexus.User
 	client             client.Client
+	recorder           record.EventRecorder
 }
 
 // NewCreateUser creates an instance of CreateUser handler.
-func NewCreateUser(nexusUserApiClient nexus.User, k8sClient client.Client) *CreateUser {
-	return &CreateUser{nexusUserApiClient: nexusUserApiClient, client: k8sClient}
+func NewCreateUser(nexusUserApiClient nexus.User, k8sClient client.Client, recorder record.EventRecorder) *CreateUser {
+	return &CreateUser{nexusUserApiClient: nexusUserApiClient, client: k8sClient, recorder: recorder}
 }
 
 // ServeRequest implements the logic of creating user.
@@ -31,6 +57,10 @@ func (c *CreateUser) ServeRequest(ctx context.Context, user *nexusApi.NexusUser)
 	log := ctrl.LoggerFrom(ctx).WithValues("id", user.Spec.ID)
 	log.Info("Start creating user")
 
+	if IsExternalUser(user) {
+		return c.syncExternalUser(ctx, user)
+	}
+
 	nexusUser, err := c.nexusUserApiClient.Get(user.Spec.ID)
 	if err != nil {
 		return fmt.Errorf("failed to get user: %w", err)
@@ -43,18 +73,28 @@ func (c *CreateUser) ServeRequest(ctx context.Context, user *nexusApi.NexusUser)
 	}
 
 	if nexusUser == nil {
-		log.Info("User doesn't exist, creating new one")
+		if !controllers.ReportDrift(ctx, userKind, user, &user.Status.ReconcileStatus,
+			"User was deleted in nexus, recreating it", "id", user.Spec.ID) {
+			log.Info("User doesn't exist, creating new one")
+		}
 
 		if err = c.nexusUserApiClient.Create(specToUser(&user.Spec, pass)); err != nil {
 			return fmt.Errorf("failed to create user: %w", err)
 		}
 
+		if err = setAppliedPassword(user, pass); err != nil {
+			return err
+		}
+
 		log.Info("User has been created")
 
 		return nil
 	}
 
 	if userChanged(&user.Spec, nexusUser) {
+		controllers.ReportDrift(ctx, userKind, user, &user.Status.ReconcileStatus,
+			"User was changed in nexus, reverting changes", "id", user.Spec.ID)
+
 		log.Info("Updating user")
 
 		updateUserFields(&user.Spec, nexusUser)
@@ -66,23 +106,143 @@ func (c *CreateUser) ServeRequest(ctx context.Context, user *nexusApi.NexusUser)
 		log.Info("User has been updated")
 	}
 
+	if passwordApplied(user, pass) {
+		return nil
+	}
+
 	log.Info("Configuring password")
 
 	if err = c.nexusUserApiClient.ChangePassword(nexusUser.UserID, pass); err != nil {
 		return fmt.Errorf("failed to change password: %w", err)
 	}
 
+	rotated := user.Status.PasswordHash != ""
+
+	if err = setAppliedPassword(user, pass); err != nil {
+		return err
+	}
+
+	if rotated {
+		c.recorder.Event(user, corev1.EventTypeNormal, ReasonPasswordRotated, "User password was changed in nexus")
+	}
+
 	log.Info("Password has been configured")
 
 	return nil
 }
 
+// passwordApplied checks if the password matches the hash of the password applied to nexus.
+// A hash of an unknown format doesn't match, so the password is applied again and the hash is replaced.
+func passwordApplied(user *nexusApi.NexusUser, password string) bool {
+	var (
+		version            int
+		memory, iterations uint32
+		threads            uint8
+	)
+
+	// the hash is "$argon2id$v=19$m=<memory>,t=<iterations>,p=<threads>$<salt>$<key>"
+	parts := strings.Split(user.Status.PasswordHash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return false
+	}
+
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &iterations, &threads); err != nil ||
+		memory == 0 || iterations == 0 || threads == 0 {
+		return false
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return false
+	}
+
+	actual := argon2.IDKey([]byte(password), salt, iterations, memory, threads, uint32(len(key)))
+
+	return subtle.ConstantTimeCompare(actual, key) == 1
+}
+
+// setAppliedPassword stores the salted hash of the password applied to nexus and the time it was applied.
+func setAppliedPassword(user *nexusApi.NexusUser, password string) error {
+	salt := make([]byte, passwordSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("failed to generate password salt: %w", err)
+	}
+
+	now := metav1.Now()
+
+	user.Status.PasswordHash = hashPassword(salt, password)
+	user.Status.PasswordRotatedAt = &now
+
+	return nil
+}
+
+// hashPassword returns the argon2id hash of the password in the PHC string format
+// "$argon2id$v=19$m=<memory>,t=<iterations>,p=<threads>$<salt>$<key>", the salt and the key are base64 encoded.
+// argon2id is used instead of a fast hash, so the password can't be brute-forced from the status
+// by anyone who can read the NexusUser.
+func hashPassword(salt []byte, password string) string {
+	key := argon2.IDKey([]byte(password), salt, argon2Iterations, argon2Memory, argon2Threads, argon2KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, argon2Memory, argon2Iterations, argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+// syncExternalUser updates roles of the user of the external source.
+// The user itself and its password are managed by the source.
+func (c *CreateUser) syncExternalUser(ctx context.Context, user *nexusApi.NexusUser) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("id", user.Spec.ID, "source", user.Spec.Source)
+
+	nexusUser, err := c.nexusUserApiClient.GetBySource(user.Spec.ID, user.Spec.Source)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if nexusUser == nil {
+		return fmt.Errorf("%w: %s in %s source", ErrExternalUserNotFound, user.Spec.ID, user.Spec.Source)
+	}
+
+	if controllers.EqualUnordered(user.Spec.Roles, nexusUser.Roles) {
+		return nil
+	}
+
+	if !controllers.ReportDrift(ctx, userKind, user, &user.Status.ReconcileStatus,
+		"User roles were changed in nexus, reverting changes", "id", user.Spec.ID) {
+		log.Info("Updating user roles")
+	}
+
+	nexusUser.Roles = slices.Clone(user.Spec.Roles)
+	nexusUser.Source = user.Spec.Source
+
+	if err = c.nexusUserApiClient.Update(user.Spec.ID, *nexusUser); err != nil {
+		return fmt.Errorf("failed to update user roles: %w", err)
+	}
+
+	log.Info("User roles have been updated")
+
+	return nil
+}
+
+// IsExternalUser returns true if the user comes from the external user source, e.g. LDAP.
+func IsExternalUser(user *nexusApi.NexusUser) bool {
+	return user.Spec.Source != "" && user.Spec.Source != nexusApi.UserSourceDefault
+}
+
 func userChanged(spec *nexusApi.NexusUserSpec, nexusUser *security.User) bool {
 	if spec.FirstName != nexusUser.FirstName ||
 		spec.LastName != nexusUser.LastName ||
 		spec.Email != nexusUser.EmailAddress ||
 		spec.Status != nexusUser.Status ||
-		!slices.Equal(spec.Roles, nexusUser.Roles) {
+		!controllers.EqualUnordered(spec.Roles, nexusUser.Roles) {
 		return true
 	}
 
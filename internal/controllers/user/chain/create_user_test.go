@@ -3,14 +3,19 @@ package chain
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -43,6 +48,7 @@ func TestCreateUser_ServeRequest(t *testing.T) {
 		k8sClient      func(t *testing.T) client.Client
 		nexusApiClient func(t *testing.T) nexus.User
 		wantErr        require.ErrorAssertionFunc
+		wantEvents     []string
 	}{
 		{
 			name: "user doesn't exist, creating new one",
@@ -139,6 +145,169 @@ func TestCreateUser_ServeRequest(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "password is already applied, skipping password change",
+			user: &nexusApi.NexusUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "user",
+					Namespace: "default",
+				},
+				Spec: nexusApi.NexusUserSpec{
+					ID:        "user-id",
+					FirstName: "user-name",
+					Secret:    userSecretRef,
+					Status:    nexusApi.UserStatusActive,
+					Roles:     []string{"nx-admin"},
+				},
+				Status: nexusApi.NexusUserStatus{
+					PasswordHash:      hashPassword([]byte("salt"), "user-password"),
+					PasswordRotatedAt: &metav1.Time{Time: time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)},
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(userSecret).
+					Build()
+			},
+			nexusApiClient: func(t *testing.T) nexus.User {
+				m := mocks.NewMockUser(t)
+
+				m.On("Get", "user-id").Return(&security.User{
+					UserID:    "user-id",
+					FirstName: "user-name",
+					Status:    nexusApi.UserStatusActive,
+					Roles:     []string{"nx-admin"},
+				}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "user roles in different order, no changes",
+			user: &nexusApi.NexusUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "user",
+					Namespace: "default",
+				},
+				Spec: nexusApi.NexusUserSpec{
+					ID:        "user-id",
+					FirstName: "user-name",
+					Secret:    userSecretRef,
+					Status:    nexusApi.UserStatusActive,
+					Roles:     []string{"nx-admin", "nx-developer"},
+				},
+				Status: nexusApi.NexusUserStatus{
+					PasswordHash:      hashPassword([]byte("salt"), "user-password"),
+					PasswordRotatedAt: &metav1.Time{Time: time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)},
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(userSecret).
+					Build()
+			},
+			nexusApiClient: func(t *testing.T) nexus.User {
+				m := mocks.NewMockUser(t)
+
+				m.On("Get", "user-id").Return(&security.User{
+					UserID:    "user-id",
+					FirstName: "user-name",
+					Status:    nexusApi.UserStatusActive,
+					Roles:     []string{"nx-developer", "nx-admin"},
+				}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "secret was changed, rotating password",
+			user: &nexusApi.NexusUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "user",
+					Namespace: "default",
+				},
+				Spec: nexusApi.NexusUserSpec{
+					ID:        "user-id",
+					FirstName: "user-name",
+					Secret:    userSecretRef,
+					Status:    nexusApi.UserStatusActive,
+					Roles:     []string{"nx-admin"},
+				},
+				Status: nexusApi.NexusUserStatus{
+					PasswordHash: hashPassword([]byte("salt"), "old-password"),
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(userSecret).
+					Build()
+			},
+			nexusApiClient: func(t *testing.T) nexus.User {
+				m := mocks.NewMockUser(t)
+
+				m.On("Get", "user-id").Return(&security.User{
+					UserID:    "user-id",
+					FirstName: "user-name",
+					Status:    nexusApi.UserStatusActive,
+					Roles:     []string{"nx-admin"},
+				}, nil)
+
+				m.On("ChangePassword", "user-id", "user-password").
+					Return(nil)
+
+				return m
+			},
+			wantErr:    require.NoError,
+			wantEvents: []string{"Normal PasswordRotated User password was changed in nexus"},
+		},
+		{
+			name: "password hash of unknown format, applying password again",
+			user: &nexusApi.NexusUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "user",
+					Namespace: "default",
+				},
+				Spec: nexusApi.NexusUserSpec{
+					ID:        "user-id",
+					FirstName: "user-name",
+					Secret:    userSecretRef,
+					Status:    nexusApi.UserStatusActive,
+					Roles:     []string{"nx-admin"},
+				},
+				Status: nexusApi.NexusUserStatus{
+					// sha256 of salt and password
+					PasswordHash: "73616c74:c8c76a8d0a1e0e9e0fcc8d36b9a44ae1a42dbe4ad2d8a91d3c6bd2fa2c3c1d2e",
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(userSecret).
+					Build()
+			},
+			nexusApiClient: func(t *testing.T) nexus.User {
+				m := mocks.NewMockUser(t)
+
+				m.On("Get", "user-id").Return(&security.User{
+					UserID:    "user-id",
+					FirstName: "user-name",
+					Status:    nexusApi.UserStatusActive,
+					Roles:     []string{"nx-admin"},
+				}, nil)
+
+				m.On("ChangePassword", "user-id", "user-password").
+					Return(nil)
+
+				return m
+			},
+			wantErr:    require.NoError,
+			wantEvents: []string{"Normal PasswordRotated User password was changed in nexus"},
+		},
 		{
 			name: "failed to update user password",
 			user: &nexusApi.NexusUser{
@@ -339,16 +508,178 @@ func TestCreateUser_ServeRequest(t *testing.T) {
 				require.Contains(t, err.Error(), "failed to get user")
 			},
 		},
+		{
+			name: "LDAP user roles are updated without password",
+			user: &nexusApi.NexusUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ldap-user",
+					Namespace: "default",
+				},
+				Spec: nexusApi.NexusUserSpec{
+					ID:     "john.doe",
+					Source: nexusApi.UserSourceLdap,
+					Roles:  []string{"nx-developer"},
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().Build()
+			},
+			nexusApiClient: func(t *testing.T) nexus.User {
+				m := mocks.NewMockUser(t)
+
+				m.On("GetBySource", "john.doe", nexusApi.UserSourceLdap).Return(&security.User{
+					UserID:       "john.doe",
+					FirstName:    "John",
+					LastName:     "Doe",
+					EmailAddress: "john.doe@example.com",
+					Status:       nexusApi.UserStatusActive,
+					Source:       nexusApi.UserSourceLdap,
+					Roles:        []string{},
+				}, nil)
+
+				m.On("Update", "john.doe", security.User{
+					UserID:       "john.doe",
+					FirstName:    "John",
+					LastName:     "Doe",
+					EmailAddress: "john.doe@example.com",
+					Status:       nexusApi.UserStatusActive,
+					Source:       nexusApi.UserSourceLdap,
+					Roles:        []string{"nx-developer"},
+				}).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "LDAP user roles are up to date",
+			user: &nexusApi.NexusUser{
+				Spec: nexusApi.NexusUserSpec{
+					ID:     "john.doe",
+					Source: nexusApi.UserSourceLdap,
+					Roles:  []string{"nx-developer"},
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().Build()
+			},
+			nexusApiClient: func(t *testing.T) nexus.User {
+				m := mocks.NewMockUser(t)
+
+				m.On("GetBySource", "john.doe", nexusApi.UserSourceLdap).Return(&security.User{
+					UserID: "john.doe",
+					Source: nexusApi.UserSourceLdap,
+					Roles:  []string{"nx-developer"},
+				}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "LDAP user roles in different order are up to date",
+			user: &nexusApi.NexusUser{
+				Spec: nexusApi.NexusUserSpec{
+					ID:     "john.doe",
+					Source: nexusApi.UserSourceLdap,
+					Roles:  []string{"nx-developer", "nx-viewer"},
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().Build()
+			},
+			nexusApiClient: func(t *testing.T) nexus.User {
+				m := mocks.NewMockUser(t)
+
+				m.On("GetBySource", "john.doe", nexusApi.UserSourceLdap).Return(&security.User{
+					UserID: "john.doe",
+					Source: nexusApi.UserSourceLdap,
+					Roles:  []string{"nx-viewer", "nx-developer"},
+				}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "SAML user doesn't exist",
+			user: &nexusApi.NexusUser{
+				Spec: nexusApi.NexusUserSpec{
+					ID:     "john.doe",
+					Source: nexusApi.UserSourceSaml,
+					Roles:  []string{"nx-developer"},
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().Build()
+			},
+			nexusApiClient: func(t *testing.T) nexus.User {
+				m := mocks.NewMockUser(t)
+
+				m.On("GetBySource", "john.doe", nexusApi.UserSourceSaml).Return(nil, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrExternalUserNotFound)
+				require.ErrorContains(t, err, "john.doe in SAML source")
+			},
+		},
+		{
+			name: "failed to update LDAP user roles",
+			user: &nexusApi.NexusUser{
+				Spec: nexusApi.NexusUserSpec{
+					ID:     "john.doe",
+					Source: nexusApi.UserSourceLdap,
+					Roles:  []string{"nx-developer"},
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().Build()
+			},
+			nexusApiClient: func(t *testing.T) nexus.User {
+				m := mocks.NewMockUser(t)
+
+				m.On("GetBySource", "john.doe", nexusApi.UserSourceLdap).Return(&security.User{
+					UserID: "john.doe",
+					Source: nexusApi.UserSourceLdap,
+				}, nil)
+
+				m.On("Update", "john.doe", mock.Anything).Return(errors.New("role not found"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to update user roles")
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			h := NewCreateUser(tt.nexusApiClient(t), tt.k8sClient(t))
+			recorder := record.NewFakeRecorder(10)
+			h := NewCreateUser(tt.nexusApiClient(t), tt.k8sClient(t), recorder)
 			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.user)
 
 			tt.wantErr(t, err)
+
+			close(recorder.Events)
+
+			var events []string
+			for e := range recorder.Events {
+				events = append(events, e)
+			}
+
+			assert.Equal(t, tt.wantEvents, events)
+
+			if err == nil && !IsExternalUser(tt.user) {
+				assert.True(t, strings.HasPrefix(tt.user.Status.PasswordHash, "$argon2id$v=19$m=19456,t=2,p=1$"))
+				assert.True(t, passwordApplied(tt.user, "user-password"), "applied password should be stored")
+				assert.False(t, passwordApplied(tt.user, "other-password"))
+				assert.NotNil(t, tt.user.Status.PasswordRotatedAt)
+			}
 		})
 	}
 }
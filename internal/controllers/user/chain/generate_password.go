@@ -0,0 +1,252 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math/big"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+const (
+	// PasswordGeneratedAtAnnotation contains the time when the password in the generated Secret was generated.
+	PasswordGeneratedAtAnnotation = "edp.epam.com/password-generated-at"
+
+	// Keys of the generated Secret, they can be used by pipelines directly.
+	SecretKeyUsername     = "username"
+	SecretKeyPassword     = "password"
+	SecretKeyNpmAuthToken = "npmAuthToken"
+
+	defaultPasswordLength = 32
+	passwordAlphabet      = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_.~!@#%^*+="
+)
+
+// GeneratePassword is a handler for generating the user password into the Secret owned by the user.
+type GeneratePassword struct {
+	client client.Client
+	scheme *runtime.Scheme
+	now    func() time.Time
+}
+
+// NewGeneratePassword creates an instance of GeneratePassword handler.
+func NewGeneratePassword(k8sClient client.Client, scheme *runtime.Scheme) *GeneratePassword {
+	return &GeneratePassword{client: k8sClient, scheme: scheme, now: time.Now}
+}
+
+// ServeRequest creates the Secret with the generated password if it doesn't exist
+// and rotates the password when the rotation period is over.
+// It returns the time left until the next rotation, zero if the password is not rotated.
+func (h *GeneratePassword) ServeRequest(ctx context.Context, user *nexusApi.NexusUser) (time.Duration, error) {
+	generation := user.Spec.PasswordGeneration
+	if generation == nil || IsExternalUser(user) {
+		return 0, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx).WithValues("id", user.Spec.ID)
+
+	name, key, err := ParseSecretRef(user.Spec.Secret)
+	if err != nil {
+		return 0, err
+	}
+
+	secret := &corev1.Secret{}
+
+	err = h.client.Get(ctx, client.ObjectKey{Namespace: user.Namespace, Name: name}, secret)
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return 0, fmt.Errorf("failed to get secret %s: %w", name, err)
+	}
+
+	if k8sErrors.IsNotFound(err) {
+		log.Info("Generating user password", "secret", name)
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: user.Namespace,
+			},
+			Type: corev1.SecretTypeOpaque,
+		}
+
+		// the type of the Secret is immutable, so it is chosen only when the Secret is created
+		if len(generation.DockerRegistries) > 0 {
+			secret.Type = corev1.SecretTypeDockerConfigJson
+		}
+
+		password, err := generatePassword(generation)
+		if err != nil {
+			return 0, err
+		}
+
+		if err = h.fillSecret(secret, user, key, password); err != nil {
+			return 0, err
+		}
+
+		if err = controllerutil.SetControllerReference(user, secret, h.scheme); err != nil {
+			return 0, fmt.Errorf("failed to set owner reference: %w", err)
+		}
+
+		if err = h.client.Create(ctx, secret); err != nil {
+			return 0, fmt.Errorf("failed to create secret %s: %w", name, err)
+		}
+
+		log.Info("Secret with the generated password has been created", "secret", name)
+
+		left, _ := h.timeToRotation(generation, secret)
+
+		return left, nil
+	}
+
+	if !metav1.IsControlledBy(secret, user) {
+		// the Secret is provided by the user, the password is not managed by the operator
+		return 0, nil
+	}
+
+	password := string(secret.Data[key])
+	original := secret.DeepCopy()
+
+	if left, rotate := h.timeToRotation(generation, secret); password == "" || (rotate && left <= 0) {
+		log.Info("Rotating user password", "secret", name)
+
+		if password, err = generatePassword(generation); err != nil {
+			return 0, err
+		}
+
+		delete(secret.Annotations, PasswordGeneratedAtAnnotation)
+	}
+
+	if err = h.fillSecret(secret, user, key, password); err != nil {
+		return 0, err
+	}
+
+	if !maps.EqualFunc(original.Data, secret.Data, bytes.Equal) ||
+		!maps.Equal(original.Annotations, secret.Annotations) {
+		if err = h.client.Update(ctx, secret); err != nil {
+			return 0, fmt.Errorf("failed to update secret %s: %w", name, err)
+		}
+
+		log.Info("Secret with the generated password has been updated", "secret", name)
+	}
+
+	left, _ := h.timeToRotation(generation, secret)
+
+	return left, nil
+}
+
+// fillSecret sets the password and the keys derived from it to the Secret.
+// The password is written to the referenced key last, so a derived key with the same name never replaces it.
+// The generation time is set only if it is missing, so it is kept while the password is not changed.
+func (h *GeneratePassword) fillSecret(secret *corev1.Secret, user *nexusApi.NexusUser, key, password string) error {
+	data := map[string][]byte{
+		SecretKeyUsername:     []byte(user.Spec.ID),
+		SecretKeyPassword:     []byte(password),
+		SecretKeyNpmAuthToken: []byte(basicAuth(user.Spec.ID, password)),
+	}
+
+	// the docker config is required by the Secret of the docker config type even if the registries were removed
+	if registries := user.Spec.PasswordGeneration.DockerRegistries; len(registries) > 0 ||
+		secret.Type == corev1.SecretTypeDockerConfigJson {
+		dockerConfig, err := dockerConfigJSON(registries, user.Spec.ID, password)
+		if err != nil {
+			return err
+		}
+
+		data[corev1.DockerConfigJsonKey] = dockerConfig
+	}
+
+	data[key] = []byte(password)
+
+	secret.Data = data
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+
+	if secret.Annotations[PasswordGeneratedAtAnnotation] == "" {
+		secret.Annotations[PasswordGeneratedAtAnnotation] = h.now().UTC().Format(time.RFC3339)
+	}
+
+	return nil
+}
+
+// timeToRotation returns the time left until the password rotation and true if the password is rotated.
+// The rotation is overdue if the time left is not positive.
+func (h *GeneratePassword) timeToRotation(
+	generation *nexusApi.UserPasswordGeneration,
+	secret *corev1.Secret,
+) (time.Duration, bool) {
+	if generation.RotationPeriod == nil || generation.RotationPeriod.Duration <= 0 {
+		return 0, false
+	}
+
+	generatedAt, err := time.Parse(time.RFC3339, secret.Annotations[PasswordGeneratedAtAnnotation])
+	if err != nil {
+		// the annotation is missing or broken, rotate the password to restore it
+		return 0, true
+	}
+
+	return generatedAt.Add(generation.RotationPeriod.Duration).Sub(h.now()), true
+}
+
+func generatePassword(generation *nexusApi.UserPasswordGeneration) (string, error) {
+	length := int(generation.Length)
+	if length <= 0 {
+		length = defaultPasswordLength
+	}
+
+	alphabetSize := big.NewInt(int64(len(passwordAlphabet)))
+	password := make([]byte, length)
+
+	for i := range password {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate password: %w", err)
+		}
+
+		password[i] = passwordAlphabet[n.Int64()]
+	}
+
+	return string(password), nil
+}
+
+func dockerConfigJSON(registries []string, username, password string) ([]byte, error) {
+	type dockerAuth struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Auth     string `json:"auth"`
+	}
+
+	auths := make(map[string]dockerAuth, len(registries))
+
+	for _, registry := range registries {
+		auths[registry] = dockerAuth{
+			Username: username,
+			Password: password,
+			Auth:     basicAuth(username, password),
+		}
+	}
+
+	config, err := json.Marshal(map[string]any{"auths": auths})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal docker config: %w", err)
+	}
+
+	return config, nil
+}
+
+func basicAuth(username, password string) string {
+	return base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+}
@@ -0,0 +1,265 @@
+package chain
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+func TestGeneratePassword_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, nexusApi.AddToScheme(scheme))
+
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	newUser := func(generation *nexusApi.UserPasswordGeneration) *nexusApi.NexusUser {
+		return &nexusApi.NexusUser{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ci-user",
+				Namespace: "default",
+				UID:       "ci-user-uid",
+			},
+			Spec: nexusApi.NexusUserSpec{
+				ID:                 "ci",
+				Secret:             "$ci-user:token",
+				PasswordGeneration: generation,
+			},
+		}
+	}
+
+	ownedSecret := func(t *testing.T, user *nexusApi.NexusUser, password, generatedAt string) *corev1.Secret {
+		t.Helper()
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "ci-user",
+				Namespace:   "default",
+				Annotations: map[string]string{PasswordGeneratedAtAnnotation: generatedAt},
+			},
+			Data: map[string][]byte{
+				"token":               []byte(password),
+				SecretKeyUsername:     []byte("ci"),
+				SecretKeyPassword:     []byte(password),
+				SecretKeyNpmAuthToken: []byte(basicAuth("ci", password)),
+			},
+		}
+		require.NoError(t, controllerutil.SetControllerReference(user, secret, scheme))
+
+		return secret
+	}
+
+	tests := []struct {
+		name       string
+		user       *nexusApi.NexusUser
+		objects    func(t *testing.T, user *nexusApi.NexusUser) []client.Object
+		wantErr    require.ErrorAssertionFunc
+		wantLeft   time.Duration
+		wantSecret func(t *testing.T, secret *corev1.Secret)
+	}{
+		{
+			name: "secret doesn't exist, generating password",
+			user: newUser(&nexusApi.UserPasswordGeneration{
+				Length:           20,
+				DockerRegistries: []string{"docker.example.com"},
+			}),
+			wantErr: require.NoError,
+			wantSecret: func(t *testing.T, secret *corev1.Secret) {
+				password := string(secret.Data["token"])
+
+				assert.Len(t, password, 20)
+				assert.Equal(t, password, string(secret.Data[SecretKeyPassword]))
+				assert.Equal(t, "ci", string(secret.Data[SecretKeyUsername]))
+				assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("ci:"+password)),
+					string(secret.Data[SecretKeyNpmAuthToken]))
+				assert.Contains(t, string(secret.Data[corev1.DockerConfigJsonKey]), `"docker.example.com":{"username":"ci"`)
+				assert.Equal(t, "2026-01-10T12:00:00Z", secret.Annotations[PasswordGeneratedAtAnnotation])
+				assert.Equal(t, corev1.SecretTypeDockerConfigJson, secret.Type)
+				require.Len(t, secret.OwnerReferences, 1)
+				assert.Equal(t, "ci-user", secret.OwnerReferences[0].Name)
+			},
+		},
+		{
+			name: "secret is generated with rotation",
+			user: newUser(&nexusApi.UserPasswordGeneration{
+				RotationPeriod: &metav1.Duration{Duration: 24 * time.Hour},
+			}),
+			wantErr:  require.NoError,
+			wantLeft: 24 * time.Hour,
+			wantSecret: func(t *testing.T, secret *corev1.Secret) {
+				assert.Len(t, secret.Data["token"], defaultPasswordLength)
+				assert.NotContains(t, secret.Data, corev1.DockerConfigJsonKey)
+				assert.Equal(t, corev1.SecretTypeOpaque, secret.Type)
+			},
+		},
+		{
+			name: "password is not rotated before the rotation period is over",
+			user: newUser(&nexusApi.UserPasswordGeneration{
+				RotationPeriod: &metav1.Duration{Duration: 24 * time.Hour},
+			}),
+			objects: func(t *testing.T, user *nexusApi.NexusUser) []client.Object {
+				return []client.Object{ownedSecret(t, user, "old-password", "2026-01-10T00:00:00Z")}
+			},
+			wantErr:  require.NoError,
+			wantLeft: 12 * time.Hour,
+			wantSecret: func(t *testing.T, secret *corev1.Secret) {
+				assert.Equal(t, "old-password", string(secret.Data["token"]))
+				assert.Equal(t, "2026-01-10T00:00:00Z", secret.Annotations[PasswordGeneratedAtAnnotation])
+			},
+		},
+		{
+			name: "password is rotated after the rotation period",
+			user: newUser(&nexusApi.UserPasswordGeneration{
+				RotationPeriod: &metav1.Duration{Duration: 24 * time.Hour},
+			}),
+			objects: func(t *testing.T, user *nexusApi.NexusUser) []client.Object {
+				return []client.Object{ownedSecret(t, user, "old-password", "2026-01-09T00:00:00Z")}
+			},
+			wantErr:  require.NoError,
+			wantLeft: 24 * time.Hour,
+			wantSecret: func(t *testing.T, secret *corev1.Secret) {
+				password := string(secret.Data["token"])
+
+				assert.NotEqual(t, "old-password", password)
+				assert.Equal(t, password, string(secret.Data[SecretKeyPassword]))
+				assert.Equal(t, "2026-01-10T12:00:00Z", secret.Annotations[PasswordGeneratedAtAnnotation])
+			},
+		},
+		{
+			name: "docker config is added to the generated secret",
+			user: newUser(&nexusApi.UserPasswordGeneration{
+				DockerRegistries: []string{"docker.example.com"},
+			}),
+			objects: func(t *testing.T, user *nexusApi.NexusUser) []client.Object {
+				return []client.Object{ownedSecret(t, user, "old-password", "2026-01-09T00:00:00Z")}
+			},
+			wantErr: require.NoError,
+			wantSecret: func(t *testing.T, secret *corev1.Secret) {
+				assert.Equal(t, "old-password", string(secret.Data["token"]))
+				assert.Contains(t, string(secret.Data[corev1.DockerConfigJsonKey]), `"password":"old-password"`)
+			},
+		},
+		{
+			name: "docker config is kept in the secret of docker config type without registries",
+			user: newUser(&nexusApi.UserPasswordGeneration{}),
+			objects: func(t *testing.T, user *nexusApi.NexusUser) []client.Object {
+				secret := ownedSecret(t, user, "old-password", "2026-01-09T00:00:00Z")
+				secret.Type = corev1.SecretTypeDockerConfigJson
+				secret.Data[corev1.DockerConfigJsonKey] = []byte(`{"auths":{"docker.example.com":{}}}`)
+
+				return []client.Object{secret}
+			},
+			wantErr: require.NoError,
+			wantSecret: func(t *testing.T, secret *corev1.Secret) {
+				assert.Equal(t, corev1.SecretTypeDockerConfigJson, secret.Type)
+				assert.JSONEq(t, `{"auths":{}}`, string(secret.Data[corev1.DockerConfigJsonKey]))
+			},
+		},
+		{
+			name: "secret provided by the user is not changed",
+			user: newUser(&nexusApi.UserPasswordGeneration{
+				RotationPeriod: &metav1.Duration{Duration: time.Hour},
+			}),
+			objects: func(t *testing.T, _ *nexusApi.NexusUser) []client.Object {
+				return []client.Object{&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "ci-user", Namespace: "default"},
+					Data:       map[string][]byte{"token": []byte("user-password")},
+				}}
+			},
+			wantErr: require.NoError,
+			wantSecret: func(t *testing.T, secret *corev1.Secret) {
+				assert.Equal(t, map[string][]byte{"token": []byte("user-password")}, secret.Data)
+				assert.Empty(t, secret.Annotations)
+			},
+		},
+		{
+			name:    "password generation is disabled",
+			user:    newUser(nil),
+			wantErr: require.NoError,
+			wantSecret: func(t *testing.T, secret *corev1.Secret) {
+				assert.Nil(t, secret)
+			},
+		},
+		{
+			name: "password is not replaced by the derived key with the same name",
+			user: func() *nexusApi.NexusUser {
+				user := newUser(&nexusApi.UserPasswordGeneration{Length: 20})
+				user.Spec.Secret = "$ci-user:" + SecretKeyUsername
+
+				return user
+			}(),
+			wantErr: require.NoError,
+			wantSecret: func(t *testing.T, secret *corev1.Secret) {
+				password := string(secret.Data[SecretKeyPassword])
+
+				assert.Len(t, password, 20)
+				assert.Equal(t, password, string(secret.Data[SecretKeyUsername]))
+			},
+		},
+		{
+			name: "invalid secret reference",
+			user: func() *nexusApi.NexusUser {
+				user := newUser(&nexusApi.UserPasswordGeneration{})
+				user.Spec.Secret = "ci-user"
+
+				return user
+			}(),
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "invalid config secret reference")
+			},
+			wantSecret: func(t *testing.T, secret *corev1.Secret) {
+				assert.Nil(t, secret)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var objects []client.Object
+			if tt.objects != nil {
+				objects = tt.objects(t, tt.user)
+			}
+
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+			h := NewGeneratePassword(k8sClient, scheme)
+			h.now = func() time.Time {
+				return now
+			}
+
+			left, err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.user)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantLeft, left)
+
+			secret := &corev1.Secret{}
+			if err = k8sClient.Get(context.Background(), types.NamespacedName{
+				Name:      "ci-user",
+				Namespace: "default",
+			}, secret); err != nil {
+				secret = nil
+			}
+
+			tt.wantSecret(t, secret)
+		})
+	}
+}
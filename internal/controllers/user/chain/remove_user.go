@@ -25,6 +25,10 @@ func (c RemoveUser) ServeRequest(ctx context.Context, user *nexusApi.NexusUser)
 	log := ctrl.LoggerFrom(ctx).WithValues("id", user.Spec.ID)
 	log.Info("Start removing user")
 
+	if IsExternalUser(user) {
+		return c.removeExternalUserRoles(ctx, user)
+	}
+
 	if err := c.nexusUserApiClient.Delete(user.Spec.ID); err != nil {
 		if !nexus.IsErrNotFound(err) {
 			return fmt.Errorf("failed to delete user: %w", err)
@@ -37,3 +41,31 @@ func (c RemoveUser) ServeRequest(ctx context.Context, user *nexusApi.NexusUser)
 
 	return nil
 }
+
+// removeExternalUserRoles removes roles granted to the user of the external source.
+// The user itself is managed by the source, so it is not deleted.
+func (c RemoveUser) removeExternalUserRoles(ctx context.Context, user *nexusApi.NexusUser) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("id", user.Spec.ID, "source", user.Spec.Source)
+
+	nexusUser, err := c.nexusUserApiClient.GetBySource(user.Spec.ID, user.Spec.Source)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if nexusUser == nil {
+		log.Info("User doesn't exist, skipping removal")
+
+		return nil
+	}
+
+	nexusUser.Roles = []string{}
+	nexusUser.Source = user.Spec.Source
+
+	if err = c.nexusUserApiClient.Update(user.Spec.ID, *nexusUser); err != nil {
+		return fmt.Errorf("failed to remove user roles: %w", err)
+	}
+
+	log.Info("User roles have been removed")
+
+	return nil
+}
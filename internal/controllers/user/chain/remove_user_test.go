@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -51,7 +52,7 @@ func TestRemoveUser_ServeRequest(t *testing.T) {
 				m := mocks.NewMockUser(t)
 
 				m.On("Delete", "user-id").
-					Return(errors.New("not found"))
+					Return(nexus.ErrNotFound)
 
 				return m
 			},
@@ -77,6 +78,50 @@ func TestRemoveUser_ServeRequest(t *testing.T) {
 				require.Contains(t, err.Error(), "failed to remove user")
 			},
 		},
+		{
+			name: "removing roles of LDAP user",
+			user: &nexusApi.NexusUser{
+				Spec: nexusApi.NexusUserSpec{
+					ID:     "john.doe",
+					Source: nexusApi.UserSourceLdap,
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.User {
+				m := mocks.NewMockUser(t)
+
+				m.On("GetBySource", "john.doe", nexusApi.UserSourceLdap).Return(&security.User{
+					UserID: "john.doe",
+					Source: nexusApi.UserSourceLdap,
+					Roles:  []string{"nx-developer"},
+				}, nil)
+
+				m.On("Update", "john.doe", security.User{
+					UserID: "john.doe",
+					Source: nexusApi.UserSourceLdap,
+					Roles:  []string{},
+				}).Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "LDAP user doesn't exist, skipping removal",
+			user: &nexusApi.NexusUser{
+				Spec: nexusApi.NexusUserSpec{
+					ID:     "john.doe",
+					Source: nexusApi.UserSourceLdap,
+				},
+			},
+			nexusApiClient: func(t *testing.T) nexus.User {
+				m := mocks.NewMockUser(t)
+
+				m.On("GetBySource", "john.doe", nexusApi.UserSourceLdap).Return(nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -2,11 +2,15 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -20,6 +24,7 @@ import (
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
 	"github.com/epam/edp-nexus-operator/internal/controllers"
 	"github.com/epam/edp-nexus-operator/internal/controllers/user/chain"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
 // ctrlLog instance ca be used when we can't get logger from context.
@@ -30,16 +35,29 @@ type NexusUserReconciler struct {
 	client            client.Client
 	scheme            *runtime.Scheme
 	apiClientProvider controllers.ApiClientProvider
+	resyncInterval    time.Duration
+	recorder          record.EventRecorder
 }
 
-func NewNexusUserReconciler(k8sClient client.Client, scheme *runtime.Scheme, apiClientProvider controllers.ApiClientProvider) *NexusUserReconciler {
-	return &NexusUserReconciler{client: k8sClient, scheme: scheme, apiClientProvider: apiClientProvider}
+func NewNexusUserReconciler(
+	k8sClient client.Client,
+	scheme *runtime.Scheme,
+	apiClientProvider controllers.ApiClientProvider,
+	resyncInterval time.Duration,
+) *NexusUserReconciler {
+	return &NexusUserReconciler{
+		client:            k8sClient,
+		scheme:            scheme,
+		apiClientProvider: apiClientProvider,
+		resyncInterval:    resyncInterval,
+	}
 }
 
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexususers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexususers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=nexususers/finalizers,verbs=update
-// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch;create;update
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -56,30 +74,40 @@ func (r *NexusUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, fmt.Errorf("failed to get NexusUser: %w", err)
 	}
 
-	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, user.Namespace, user)
-	if err != nil {
-		log.Error(err, "An error has occurred while getting nexus api client")
-
-		return ctrl.Result{
-			RequeueAfter: controllers.ErrorRequeueTime,
-		}, nil
-	}
+	oldStatus := user.Status.DeepCopy()
 
 	if user.GetDeletionTimestamp() != nil {
 		if controllerutil.ContainsFinalizer(user, controllers.NexusOperatorFinalizer) {
 			log.Info("Deleting NexusUser")
 
-			if err = chain.NewRemoveUser(nexusApiClient.Security.User).ServeRequest(ctx, user); err != nil {
-				log.Error(err, "An error has occurred while deleting NexusUser")
+			if controllers.ShouldDeleteFromNexus(ctx, r.recorder, user, user.Spec.DeletionPolicy) {
+				nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, user.Namespace, user)
+				if err != nil {
+					log.Error(err, "An error has occurred while getting nexus api client")
+
+					controllers.SetDependenciesNotResolved(&user.Status.ReconcileStatus, user.Generation, err)
+
+					if statusErr := r.updateNexusUserStatus(ctx, user, oldStatus); statusErr != nil {
+						return ctrl.Result{}, statusErr
+					}
+
+					return ctrl.Result{
+						RequeueAfter: controllers.ErrorRequeueTime,
+					}, nil
+				}
+
+				userApiClient := nexus.NewUserClient(nexusApiClient.Security.User)
 
-				return ctrl.Result{
-					RequeueAfter: controllers.ErrorRequeueTime,
-				}, nil
+				if err = chain.NewRemoveUser(userApiClient).ServeRequest(ctx, user); err != nil {
+					log.Error(err, "An error has occurred while deleting NexusUser")
+
+					return controllers.RequeueOnError(err), nil
+				}
 			}
 
 			controllerutil.RemoveFinalizer(user, controllers.NexusOperatorFinalizer)
 
-			if err = r.client.Update(ctx, user); err != nil {
+			if err := r.client.Update(ctx, user); err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to update NexusUser: %w", err)
 			}
 		}
@@ -89,6 +117,23 @@ func (r *NexusUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, nil
 	}
 
+	nexusApiClient, err := r.apiClientProvider.GetNexusApiClientFromNexusRef(ctx, user.Namespace, user)
+	if err != nil {
+		log.Error(err, "An error has occurred while getting nexus api client")
+
+		controllers.SetDependenciesNotResolved(&user.Status.ReconcileStatus, user.Generation, err)
+
+		if statusErr := r.updateNexusUserStatus(ctx, user, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{
+			RequeueAfter: controllers.ErrorRequeueTime,
+		}, nil
+	}
+
+	userApiClient := nexus.NewUserClient(nexusApiClient.Security.User)
+
 	if controllerutil.AddFinalizer(user, controllers.NexusOperatorFinalizer) {
 		err = r.client.Update(ctx, user)
 		if err != nil {
@@ -96,25 +141,42 @@ func (r *NexusUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		}
 	}
 
-	oldStatus := user.Status
-
-	if err = chain.NewCreateUser(nexusApiClient.Security.User, r.client).ServeRequest(ctx, user); err != nil {
-		log.Error(err, "An error has occurred while handling NexusUser")
+	rotateAfter, err := chain.NewGeneratePassword(r.client, r.scheme).ServeRequest(ctx, user)
+	if err == nil {
+		err = chain.NewCreateUser(userApiClient, r.client, r.recorder).ServeRequest(ctx, user)
+	}
 
+	if err != nil {
 		user.Status.Value = common.StatusError
 		user.Status.Error = err.Error()
 
-		if err = r.updateNexusUserStatus(ctx, user, oldStatus); err != nil {
-			return ctrl.Result{}, err
+		if errors.Is(err, chain.ErrExternalUserNotFound) {
+			// external users appear in nexus after the user source is configured
+			log.Info("Waiting for the external user", "reason", err.Error())
+
+			controllers.SetDependencyNotFound(&user.Status.ReconcileStatus, user.Generation, err)
+
+			if statusErr := r.updateNexusUserStatus(ctx, user, oldStatus); statusErr != nil {
+				return ctrl.Result{}, statusErr
+			}
+
+			return ctrl.Result{RequeueAfter: controllers.ErrorRequeueTime}, nil
 		}
 
-		return ctrl.Result{
-			RequeueAfter: controllers.ErrorRequeueTime,
-		}, nil
+		log.Error(err, "An error has occurred while handling NexusUser")
+
+		controllers.SetSyncFailed(&user.Status.ReconcileStatus, user.Generation, err)
+
+		if statusErr := r.updateNexusUserStatus(ctx, user, oldStatus); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return controllers.RequeueOnError(err), nil
 	}
 
 	user.Status.Value = common.StatusCreated
 	user.Status.Error = ""
+	controllers.SetSynced(&user.Status.ReconcileStatus, user.Generation)
 
 	if err = r.updateNexusUserStatus(ctx, user, oldStatus); err != nil {
 		return ctrl.Result{}, err
@@ -122,11 +184,20 @@ func (r *NexusUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	log.Info("Reconciling NexusUser has been finished")
 
-	return ctrl.Result{}, nil
+	result := controllers.ResyncResult(ctx, user, r.resyncInterval)
+
+	// the generated password must be rotated in time even if the resync is disabled
+	if rotateAfter > 0 && (result.RequeueAfter == 0 || rotateAfter < result.RequeueAfter) {
+		result.RequeueAfter = rotateAfter
+	}
+
+	return result, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *NexusUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor(controllers.EventRecorderName)
+
 	err := ctrl.NewControllerManagedBy(mgr).
 		For(&nexusApi.NexusUser{}).
 		Watches(
@@ -152,9 +223,9 @@ func (r *NexusUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
 func (r *NexusUserReconciler) updateNexusUserStatus(
 	ctx context.Context,
 	user *nexusApi.NexusUser,
-	oldStatus nexusApi.NexusUserStatus,
+	oldStatus *nexusApi.NexusUserStatus,
 ) error {
-	if user.Status == oldStatus {
+	if equality.Semantic.DeepEqual(&user.Status, oldStatus) {
 		return nil
 	}
 
@@ -179,6 +250,10 @@ func (r *NexusUserReconciler) mapSecretToNexusUser(ctx context.Context, secret c
 	}
 
 	for i := range userList.Items {
+		if chain.IsExternalUser(&userList.Items[i]) {
+			continue
+		}
+
 		name, _, err := chain.ParseSecretRef(userList.Items[i].Spec.Secret)
 		if err != nil {
 			l.Error(
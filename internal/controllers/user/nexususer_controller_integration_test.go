@@ -149,4 +149,56 @@ var _ = Describe("NexusUser controller", func() {
 			g.Expect(createdNexusUser.Status.Error).ShouldNot(BeEmpty())
 		}).WithPolling(time.Second).WithTimeout(timeout).Should(Succeed())
 	})
+	It("Should generate user password", func() {
+		By("Creating a new NexusUser object without the secret")
+		newNexusUser := &nexusApi.NexusUser{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "nexus-user-generated",
+				Namespace: namespace,
+			},
+			Spec: nexusApi.NexusUserSpec{
+				ID:        "nexus-user-generated",
+				FirstName: "user-first-name",
+				LastName:  "user-last-name",
+				Email:     "user-email@gmail.com",
+				Secret:    "$nexus-user-generated:password",
+				Status:    nexusApi.UserStatusActive,
+				Roles:     []string{"nx-admin"},
+				PasswordGeneration: &nexusApi.UserPasswordGeneration{
+					DockerRegistries: []string{"docker.example.com"},
+				},
+				NexusRef: common.NexusRef{
+					Name: nexusCRName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newNexusUser)).Should(Succeed())
+		Eventually(func(g Gomega) {
+			createdNexusUser := &nexusApi.NexusUser{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: "nexus-user-generated", Namespace: namespace}, createdNexusUser)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(createdNexusUser.Status.Value).Should(Equal(common.StatusCreated))
+		}).WithPolling(time.Second).WithTimeout(timeout).Should(Succeed())
+
+		By("Checking the generated secret")
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "nexus-user-generated", Namespace: namespace}, secret)).
+			Should(Succeed())
+		Expect(secret.Data).Should(HaveKey(".dockerconfigjson"))
+		Expect(secret.Data).Should(HaveKey("npmAuthToken"))
+		Expect(secret.OwnerReferences).Should(HaveLen(1))
+
+		By("Checking that the user can login with the generated password")
+		Eventually(func(g Gomega) {
+			cl := nexus3.NewClient(nexus3client.Config{
+				URL:      nexusUrl,
+				Username: "nexus-user-generated",
+				Password: string(secret.Data["password"]),
+			})
+
+			_, err := cl.Security.User.Get("nexus-user-generated")
+
+			g.Expect(err).ShouldNot(HaveOccurred())
+		}).WithTimeout(time.Second * 10).WithPolling(time.Second).Should(Succeed())
+	})
 })
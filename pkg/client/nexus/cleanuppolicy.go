@@ -3,7 +3,6 @@ package nexus
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -19,11 +18,11 @@ type NexusCleanupPolicy struct {
 }
 
 type NexusCleanupPolicyClient struct {
-	config ClientConfig
+	client *resty.Client
 }
 
 func NewNexusCleanupPolicyClient(config ClientConfig) *NexusCleanupPolicyClient {
-	return &NexusCleanupPolicyClient{config: config}
+	return &NexusCleanupPolicyClient{client: newRestyClient(config)}
 }
 
 func (s *NexusCleanupPolicyClient) Get(ctx context.Context, name string) (*NexusCleanupPolicy, error) {
@@ -41,11 +40,7 @@ func (s *NexusCleanupPolicyClient) Get(ctx context.Context, name string) (*Nexus
 	}
 
 	if resp.IsError() {
-		if resp.StatusCode() == http.StatusNotFound {
-			return nil, ErrNotFound
-		}
-
-		return nil, fmt.Errorf("failed to get cleanup policy: %s", resp.String())
+		return nil, fmt.Errorf("failed to get cleanup policy: %w", NewAPIError(resp.StatusCode(), resp.Body()))
 	}
 
 	return res, nil
@@ -61,7 +56,7 @@ func (s *NexusCleanupPolicyClient) Create(ctx context.Context, policy *NexusClea
 	}
 
 	if resp.IsError() {
-		return fmt.Errorf("failed to create cleanup policy: %s", resp.String())
+		return fmt.Errorf("failed to create cleanup policy: %w", NewAPIError(resp.StatusCode(), resp.Body()))
 	}
 
 	return nil
@@ -80,7 +75,7 @@ func (s *NexusCleanupPolicyClient) Update(ctx context.Context, name string, poli
 	}
 
 	if resp.IsError() {
-		return fmt.Errorf("failed to update cleanup policy: %s", resp.String())
+		return fmt.Errorf("failed to update cleanup policy: %w", NewAPIError(resp.StatusCode(), resp.Body()))
 	}
 
 	return nil
@@ -98,20 +93,14 @@ func (s *NexusCleanupPolicyClient) Delete(ctx context.Context, name string) erro
 	}
 
 	if resp.IsError() {
-		if resp.StatusCode() == http.StatusNotFound {
-			return ErrNotFound
-		}
-
-		return fmt.Errorf("failed to delete cleanup policy: %s", resp.String())
+		return fmt.Errorf("failed to delete cleanup policy: %w", NewAPIError(resp.StatusCode(), resp.Body()))
 	}
 
 	return nil
 }
 
 func (s *NexusCleanupPolicyClient) r(ctx context.Context) *resty.Request {
-	return resty.New().
-		SetBaseURL(s.config.BaseURL).
-		SetBasicAuth(s.config.UserName, s.config.Password).
+	return s.client.
 		R().
 		ForceContentType("application/json").
 		SetContext(ctx)
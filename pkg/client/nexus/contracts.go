@@ -10,6 +10,7 @@ import (
 
 type User interface {
 	Get(id string) (*security.User, error)
+	GetBySource(id, source string) (*security.User, error)
 	Create(user security.User) error
 	Update(id string, user security.User) error
 	Delete(id string) error
@@ -21,10 +22,26 @@ type Role interface {
 	Create(role security.Role) error
 	Update(id string, role security.Role) error
 	Delete(id string) error
+	ListBySource(source string) ([]security.Role, error)
+}
+
+type Privilege interface {
+	Get(name string) (*security.Privilege, error)
+	Create(privilege security.Privilege) error
+	Update(name string, privilege security.Privilege) error
+	Delete(name string) error
+	ListByContentSelector(contentSelector string) ([]string, error)
+}
+
+type ContentSelector interface {
+	Get(name string) (*security.ContentSelector, error)
+	Create(contentSelector security.ContentSelector) error
+	Update(name string, contentSelector security.ContentSelector) error
+	Delete(name string) error
 }
 
 type Repository interface {
-	Get(ctx context.Context, id, format, repoType string) (interface{}, error)
+	Get(ctx context.Context, id, format, repoType string) (map[string]interface{}, error)
 	Create(ctx context.Context, format, repoType string, data interface{}) error
 	Update(ctx context.Context, id, format, repoType string, data interface{}) error
 	Delete(ctx context.Context, id string) error
@@ -38,6 +55,33 @@ type Script interface {
 	RunWithPayload(name, payload string) error
 }
 
+type RoutingRule interface {
+	Get(name string) (*schema.RoutingRule, error)
+	Create(rule *schema.RoutingRule) error
+	Update(rule *schema.RoutingRule) error
+	Delete(name string) error
+}
+
+type Realm interface {
+	Activate(ids []string) error
+	ListActive() ([]string, error)
+	ListAvailable() ([]security.Realm, error)
+}
+
+type AnonymousAccess interface {
+	Read() (*security.AnonymousAccessSettings, error)
+	Update(anonymous security.AnonymousAccessSettings) error
+}
+
+type Ldap interface {
+	List() ([]security.LDAP, error)
+	Get(name string) (*security.LDAP, error)
+	Create(ldap security.LDAP) error
+	Update(name string, ldap security.LDAP) error
+	Delete(name string) error
+	ChangeOrder(order []string) error
+}
+
 type FileBlobStore interface {
 	Get(name string) (*blobstore.File, error)
 	Create(bs *blobstore.File) error
@@ -58,3 +102,16 @@ type NexusCleanupPolicyManager interface {
 	Update(ctx context.Context, name string, policy *NexusCleanupPolicy) error
 	Delete(ctx context.Context, name string) error
 }
+
+type TaskManager interface {
+	Get(ctx context.Context, id string) (*Task, error)
+	List(ctx context.Context, taskType string) ([]Task, error)
+	Create(ctx context.Context, task *TaskTemplate) (string, error)
+	Update(ctx context.Context, id string, task *TaskTemplate) error
+	Run(ctx context.Context, id string) error
+	Delete(ctx context.Context, id string) error
+}
+
+type StatusManager interface {
+	GetStatus(ctx context.Context) (*SystemStatus, error)
+}
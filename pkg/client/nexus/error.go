@@ -1,23 +1,162 @@
 package nexus
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
 	"strings"
 )
 
-// IsErrNotFound checks if error is not found error.
-func IsErrNotFound(err error) bool {
-	if err == nil {
-		return false
+var (
+	// ErrNotFound is returned when the requested object doesn't exist in nexus.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned when the object conflicts with the existing one in nexus.
+	ErrConflict = errors.New("conflict")
+	// ErrUnauthorized is returned when nexus rejects the credentials or the user doesn't have permissions.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrValidation is returned when nexus rejects the request because of invalid data.
+	ErrValidation = errors.New("validation failed")
+	// ErrServer is returned when nexus fails to process the request because of an internal error.
+	ErrServer = errors.New("server error")
+	// ErrTooManyRequests is returned when nexus rate limits the requests.
+	ErrTooManyRequests = errors.New("too many requests")
+	// ErrTransport is returned when the request can't be sent to nexus or the response can't be received.
+	ErrTransport = errors.New("transport error")
+)
+
+// FieldError is a validation error of a single field returned by nexus.
+type FieldError struct {
+	// ID is the field identifier, e.g. "PARAMETER name" or "*" for errors not related to a field.
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
+// APIError is an error response returned by nexus.
+type APIError struct {
+	StatusCode int
+	// Message is the raw response body.
+	Message string
+	// FieldErrors contains parsed validation errors. It is set only for validation errors.
+	FieldErrors []FieldError
+
+	kind error
+}
+
+// NewAPIError creates an error from the nexus response.
+func NewAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Message:    strings.TrimSpace(string(body)),
+		kind:       errorKindFromStatusCode(statusCode),
 	}
 
-	if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "Unable to find") {
-		return true
+	if apiErr.kind == ErrValidation {
+		apiErr.FieldErrors = parseFieldErrors(body)
 	}
 
-	if errors.Is(err, ErrNotFound) {
-		return true
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if len(e.FieldErrors) > 0 {
+		fields := make([]string, 0, len(e.FieldErrors))
+		for _, f := range e.FieldErrors {
+			fields = append(fields, fmt.Sprintf("%s: %s", f.ID, f.Message))
+		}
+
+		return fmt.Sprintf("%s (HTTP %d): %s", e.kindMessage(), e.StatusCode, strings.Join(fields, "; "))
 	}
 
-	return false
+	if e.Message == "" {
+		return fmt.Sprintf("%s (HTTP %d)", e.kindMessage(), e.StatusCode)
+	}
+
+	return fmt.Sprintf("%s (HTTP %d): %s", e.kindMessage(), e.StatusCode, e.Message)
+}
+
+// Is allows to check the kind of the error with errors.Is, e.g. errors.Is(err, ErrNotFound).
+func (e *APIError) Is(target error) bool {
+	return e.kind != nil && e.kind == target
+}
+
+func (e *APIError) kindMessage() string {
+	if e.kind == nil {
+		return "unexpected response"
+	}
+
+	return e.kind.Error()
+}
+
+// TransportError is a network level error that occurred while communicating with nexus.
+type TransportError struct {
+	Err error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("%s: %v", ErrTransport, e.Err)
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// Is allows to check the error with errors.Is(err, ErrTransport).
+func (e *TransportError) Is(target error) bool {
+	return target == ErrTransport
+}
+
+func errorKindFromStatusCode(statusCode int) error {
+	switch {
+	case statusCode == http.StatusNotFound:
+		return ErrNotFound
+	case statusCode == http.StatusConflict:
+		return ErrConflict
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return ErrUnauthorized
+	case statusCode == http.StatusBadRequest || statusCode == http.StatusUnprocessableEntity:
+		return ErrValidation
+	case statusCode == http.StatusTooManyRequests:
+		return ErrTooManyRequests
+	case statusCode >= http.StatusInternalServerError:
+		return ErrServer
+	default:
+		return nil
+	}
+}
+
+// parseFieldErrors parses validation errors from the nexus response.
+// Nexus returns either a list of errors or a single error object.
+func parseFieldErrors(body []byte) []FieldError {
+	var fieldErrors []FieldError
+	if err := json.Unmarshal(body, &fieldErrors); err == nil {
+		return fieldErrors
+	}
+
+	var fieldErr FieldError
+	if err := json.Unmarshal(body, &fieldErr); err == nil && fieldErr.Message != "" {
+		return []FieldError{fieldErr}
+	}
+
+	return nil
+}
+
+// IsErrNotFound checks if error is not found error.
+func IsErrNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsErrTransient checks if error is temporary and the request may succeed if it is repeated.
+// TLS errors are not transient because they are caused by misconfiguration.
+func IsErrTransient(err error) bool {
+	if IsTLSError(err) {
+		return false
+	}
+
+	return errors.Is(err, ErrTransport) || errors.Is(err, ErrServer) || errors.Is(err, ErrTooManyRequests)
+}
+
+// IsErrPermanent checks if error can't be resolved without changing the request.
+func IsErrPermanent(err error) bool {
+	return errors.Is(err, ErrValidation)
 }
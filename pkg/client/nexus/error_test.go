@@ -1,10 +1,15 @@
 package nexus
 
 import (
+	"crypto/x509"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsErrNotFound(t *testing.T) {
@@ -22,12 +27,22 @@ func TestIsErrNotFound(t *testing.T) {
 		},
 		{
 			name: "err is not found",
-			err:  errors.New("not found error"),
+			err:  fmt.Errorf("failed to get role: %w", ErrNotFound),
 			want: true,
 		},
 		{
-			name: "err is not found",
-			err:  errors.New("some error"),
+			name: "err is not found response",
+			err:  &url.Error{Op: "Get", URL: "http://nexus", Err: NewAPIError(http.StatusNotFound, []byte("Role not found"))},
+			want: true,
+		},
+		{
+			name: "err is not typed",
+			err:  errors.New("not found error"),
+			want: false,
+		},
+		{
+			name: "err is server error",
+			err:  NewAPIError(http.StatusInternalServerError, nil),
 			want: false,
 		},
 	}
@@ -41,3 +56,142 @@ func TestIsErrNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestNewAPIError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantKind   error
+		wantFields []FieldError
+		wantMsg    string
+	}{
+		{
+			name:       "validation error list",
+			statusCode: http.StatusBadRequest,
+			body:       `[{"id":"PARAMETER name","message":"must not be empty"},{"id":"*","message":"invalid format"}]`,
+			wantKind:   ErrValidation,
+			wantFields: []FieldError{
+				{ID: "PARAMETER name", Message: "must not be empty"},
+				{ID: "*", Message: "invalid format"},
+			},
+			wantMsg: "validation failed (HTTP 400): PARAMETER name: must not be empty; *: invalid format",
+		},
+		{
+			name:       "validation error object",
+			statusCode: http.StatusBadRequest,
+			body:       `{"id":"*","message":"Repository already exists"}`,
+			wantKind:   ErrValidation,
+			wantFields: []FieldError{{ID: "*", Message: "Repository already exists"}},
+			wantMsg:    "validation failed (HTTP 400): *: Repository already exists",
+		},
+		{
+			name:       "validation error without details",
+			statusCode: http.StatusBadRequest,
+			body:       "bad request",
+			wantKind:   ErrValidation,
+			wantMsg:    "validation failed (HTTP 400): bad request",
+		},
+		{
+			name:       "conflict",
+			statusCode: http.StatusConflict,
+			wantKind:   ErrConflict,
+			wantMsg:    "conflict (HTTP 409)",
+		},
+		{
+			name:       "forbidden",
+			statusCode: http.StatusForbidden,
+			wantKind:   ErrUnauthorized,
+			wantMsg:    "unauthorized (HTTP 403)",
+		},
+		{
+			name:       "unauthorized",
+			statusCode: http.StatusUnauthorized,
+			wantKind:   ErrUnauthorized,
+			wantMsg:    "unauthorized (HTTP 401)",
+		},
+		{
+			name:       "server error",
+			statusCode: http.StatusBadGateway,
+			body:       "bad gateway",
+			wantKind:   ErrServer,
+			wantMsg:    "server error (HTTP 502): bad gateway",
+		},
+		{
+			name:       "unexpected status",
+			statusCode: http.StatusMethodNotAllowed,
+			wantMsg:    "unexpected response (HTTP 405)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := NewAPIError(tt.statusCode, []byte(tt.body))
+
+			if tt.wantKind != nil {
+				require.ErrorIs(t, err, tt.wantKind)
+			}
+
+			require.Equal(t, tt.wantFields, err.FieldErrors)
+			require.Equal(t, tt.wantMsg, err.Error())
+		})
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		err           error
+		wantTransient bool
+		wantPermanent bool
+	}{
+		{
+			name:          "transport error",
+			err:           &TransportError{Err: errors.New("connection refused")},
+			wantTransient: true,
+		},
+		{
+			name:          "TLS error",
+			err:           &TransportError{Err: x509.UnknownAuthorityError{}},
+			wantTransient: false,
+		},
+		{
+			name:          "server error",
+			err:           fmt.Errorf("failed: %w", NewAPIError(http.StatusServiceUnavailable, nil)),
+			wantTransient: true,
+		},
+		{
+			name:          "too many requests",
+			err:           NewAPIError(http.StatusTooManyRequests, nil),
+			wantTransient: true,
+		},
+		{
+			name:          "validation error",
+			err:           NewAPIError(http.StatusBadRequest, nil),
+			wantPermanent: true,
+		},
+		{
+			name: "unauthorized",
+			err:  NewAPIError(http.StatusUnauthorized, nil),
+		},
+		{
+			name: "untyped error",
+			err:  errors.New("some error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantTransient, IsErrTransient(tt.err))
+			assert.Equal(t, tt.wantPermanent, IsErrPermanent(tt.err))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAnonymousAccess creates a new instance of MockAnonymousAccess. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnonymousAccess(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnonymousAccess {
+	mock := &MockAnonymousAccess{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAnonymousAccess is an autogenerated mock type for the AnonymousAccess type
+type MockAnonymousAccess struct {
+	mock.Mock
+}
+
+type MockAnonymousAccess_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnonymousAccess) EXPECT() *MockAnonymousAccess_Expecter {
+	return &MockAnonymousAccess_Expecter{mock: &_m.Mock}
+}
+
+// Read provides a mock function for the type MockAnonymousAccess
+func (_mock *MockAnonymousAccess) Read() (*security.AnonymousAccessSettings, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 *security.AnonymousAccessSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*security.AnonymousAccessSettings, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *security.AnonymousAccessSettings); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*security.AnonymousAccessSettings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnonymousAccess_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockAnonymousAccess_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+func (_e *MockAnonymousAccess_Expecter) Read() *MockAnonymousAccess_Read_Call {
+	return &MockAnonymousAccess_Read_Call{Call: _e.mock.On("Read")}
+}
+
+func (_c *MockAnonymousAccess_Read_Call) Run(run func()) *MockAnonymousAccess_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAnonymousAccess_Read_Call) Return(anonymousAccessSettings *security.AnonymousAccessSettings, err error) *MockAnonymousAccess_Read_Call {
+	_c.Call.Return(anonymousAccessSettings, err)
+	return _c
+}
+
+func (_c *MockAnonymousAccess_Read_Call) RunAndReturn(run func() (*security.AnonymousAccessSettings, error)) *MockAnonymousAccess_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockAnonymousAccess
+func (_mock *MockAnonymousAccess) Update(anonymous security.AnonymousAccessSettings) error {
+	ret := _mock.Called(anonymous)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(security.AnonymousAccessSettings) error); ok {
+		r0 = returnFunc(anonymous)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAnonymousAccess_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockAnonymousAccess_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - anonymous security.AnonymousAccessSettings
+func (_e *MockAnonymousAccess_Expecter) Update(anonymous interface{}) *MockAnonymousAccess_Update_Call {
+	return &MockAnonymousAccess_Update_Call{Call: _e.mock.On("Update", anonymous)}
+}
+
+func (_c *MockAnonymousAccess_Update_Call) Run(run func(anonymous security.AnonymousAccessSettings)) *MockAnonymousAccess_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 security.AnonymousAccessSettings
+		if args[0] != nil {
+			arg0 = args[0].(security.AnonymousAccessSettings)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnonymousAccess_Update_Call) Return(err error) *MockAnonymousAccess_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAnonymousAccess_Update_Call) RunAndReturn(run func(anonymous security.AnonymousAccessSettings) error) *MockAnonymousAccess_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
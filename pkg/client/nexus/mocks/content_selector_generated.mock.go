@@ -0,0 +1,258 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockContentSelector creates a new instance of MockContentSelector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockContentSelector(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockContentSelector {
+	mock := &MockContentSelector{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockContentSelector is an autogenerated mock type for the ContentSelector type
+type MockContentSelector struct {
+	mock.Mock
+}
+
+type MockContentSelector_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockContentSelector) EXPECT() *MockContentSelector_Expecter {
+	return &MockContentSelector_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockContentSelector
+func (_mock *MockContentSelector) Create(contentSelector security.ContentSelector) error {
+	ret := _mock.Called(contentSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(security.ContentSelector) error); ok {
+		r0 = returnFunc(contentSelector)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContentSelector_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockContentSelector_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - contentSelector security.ContentSelector
+func (_e *MockContentSelector_Expecter) Create(contentSelector interface{}) *MockContentSelector_Create_Call {
+	return &MockContentSelector_Create_Call{Call: _e.mock.On("Create", contentSelector)}
+}
+
+func (_c *MockContentSelector_Create_Call) Run(run func(contentSelector security.ContentSelector)) *MockContentSelector_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 security.ContentSelector
+		if args[0] != nil {
+			arg0 = args[0].(security.ContentSelector)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContentSelector_Create_Call) Return(err error) *MockContentSelector_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContentSelector_Create_Call) RunAndReturn(run func(contentSelector security.ContentSelector) error) *MockContentSelector_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockContentSelector
+func (_mock *MockContentSelector) Delete(name string) error {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContentSelector_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockContentSelector_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - name string
+func (_e *MockContentSelector_Expecter) Delete(name interface{}) *MockContentSelector_Delete_Call {
+	return &MockContentSelector_Delete_Call{Call: _e.mock.On("Delete", name)}
+}
+
+func (_c *MockContentSelector_Delete_Call) Run(run func(name string)) *MockContentSelector_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContentSelector_Delete_Call) Return(err error) *MockContentSelector_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContentSelector_Delete_Call) RunAndReturn(run func(name string) error) *MockContentSelector_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockContentSelector
+func (_mock *MockContentSelector) Get(name string) (*security.ContentSelector, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *security.ContentSelector
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*security.ContentSelector, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *security.ContentSelector); ok {
+		r0 = returnFunc(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*security.ContentSelector)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockContentSelector_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockContentSelector_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - name string
+func (_e *MockContentSelector_Expecter) Get(name interface{}) *MockContentSelector_Get_Call {
+	return &MockContentSelector_Get_Call{Call: _e.mock.On("Get", name)}
+}
+
+func (_c *MockContentSelector_Get_Call) Run(run func(name string)) *MockContentSelector_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContentSelector_Get_Call) Return(contentSelector *security.ContentSelector, err error) *MockContentSelector_Get_Call {
+	_c.Call.Return(contentSelector, err)
+	return _c
+}
+
+func (_c *MockContentSelector_Get_Call) RunAndReturn(run func(name string) (*security.ContentSelector, error)) *MockContentSelector_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockContentSelector
+func (_mock *MockContentSelector) Update(name string, contentSelector security.ContentSelector) error {
+	ret := _mock.Called(name, contentSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, security.ContentSelector) error); ok {
+		r0 = returnFunc(name, contentSelector)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContentSelector_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockContentSelector_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - name string
+//   - contentSelector security.ContentSelector
+func (_e *MockContentSelector_Expecter) Update(name interface{}, contentSelector interface{}) *MockContentSelector_Update_Call {
+	return &MockContentSelector_Update_Call{Call: _e.mock.On("Update", name, contentSelector)}
+}
+
+func (_c *MockContentSelector_Update_Call) Run(run func(name string, contentSelector security.ContentSelector)) *MockContentSelector_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 security.ContentSelector
+		if args[1] != nil {
+			arg1 = args[1].(security.ContentSelector)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContentSelector_Update_Call) Return(err error) *MockContentSelector_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContentSelector_Update_Call) RunAndReturn(run func(name string, contentSelector security.ContentSelector) error) *MockContentSelector_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
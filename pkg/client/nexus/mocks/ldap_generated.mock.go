@@ -0,0 +1,364 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockLdap creates a new instance of MockLdap. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLdap(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLdap {
+	mock := &MockLdap{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLdap is an autogenerated mock type for the Ldap type
+type MockLdap struct {
+	mock.Mock
+}
+
+type MockLdap_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLdap) EXPECT() *MockLdap_Expecter {
+	return &MockLdap_Expecter{mock: &_m.Mock}
+}
+
+// ChangeOrder provides a mock function for the type MockLdap
+func (_mock *MockLdap) ChangeOrder(order []string) error {
+	ret := _mock.Called(order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeOrder")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = returnFunc(order)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLdap_ChangeOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeOrder'
+type MockLdap_ChangeOrder_Call struct {
+	*mock.Call
+}
+
+// ChangeOrder is a helper method to define mock.On call
+//   - order []string
+func (_e *MockLdap_Expecter) ChangeOrder(order interface{}) *MockLdap_ChangeOrder_Call {
+	return &MockLdap_ChangeOrder_Call{Call: _e.mock.On("ChangeOrder", order)}
+}
+
+func (_c *MockLdap_ChangeOrder_Call) Run(run func(order []string)) *MockLdap_ChangeOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLdap_ChangeOrder_Call) Return(err error) *MockLdap_ChangeOrder_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLdap_ChangeOrder_Call) RunAndReturn(run func(order []string) error) *MockLdap_ChangeOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockLdap
+func (_mock *MockLdap) Create(ldap security.LDAP) error {
+	ret := _mock.Called(ldap)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(security.LDAP) error); ok {
+		r0 = returnFunc(ldap)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLdap_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockLdap_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ldap security.LDAP
+func (_e *MockLdap_Expecter) Create(ldap interface{}) *MockLdap_Create_Call {
+	return &MockLdap_Create_Call{Call: _e.mock.On("Create", ldap)}
+}
+
+func (_c *MockLdap_Create_Call) Run(run func(ldap security.LDAP)) *MockLdap_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 security.LDAP
+		if args[0] != nil {
+			arg0 = args[0].(security.LDAP)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLdap_Create_Call) Return(err error) *MockLdap_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLdap_Create_Call) RunAndReturn(run func(ldap security.LDAP) error) *MockLdap_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockLdap
+func (_mock *MockLdap) Delete(name string) error {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLdap_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockLdap_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - name string
+func (_e *MockLdap_Expecter) Delete(name interface{}) *MockLdap_Delete_Call {
+	return &MockLdap_Delete_Call{Call: _e.mock.On("Delete", name)}
+}
+
+func (_c *MockLdap_Delete_Call) Run(run func(name string)) *MockLdap_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLdap_Delete_Call) Return(err error) *MockLdap_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLdap_Delete_Call) RunAndReturn(run func(name string) error) *MockLdap_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockLdap
+func (_mock *MockLdap) Get(name string) (*security.LDAP, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *security.LDAP
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*security.LDAP, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *security.LDAP); ok {
+		r0 = returnFunc(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*security.LDAP)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLdap_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockLdap_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - name string
+func (_e *MockLdap_Expecter) Get(name interface{}) *MockLdap_Get_Call {
+	return &MockLdap_Get_Call{Call: _e.mock.On("Get", name)}
+}
+
+func (_c *MockLdap_Get_Call) Run(run func(name string)) *MockLdap_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLdap_Get_Call) Return(lDAP *security.LDAP, err error) *MockLdap_Get_Call {
+	_c.Call.Return(lDAP, err)
+	return _c
+}
+
+func (_c *MockLdap_Get_Call) RunAndReturn(run func(name string) (*security.LDAP, error)) *MockLdap_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockLdap
+func (_mock *MockLdap) List() ([]security.LDAP, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []security.LDAP
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]security.LDAP, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []security.LDAP); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]security.LDAP)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLdap_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockLdap_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+func (_e *MockLdap_Expecter) List() *MockLdap_List_Call {
+	return &MockLdap_List_Call{Call: _e.mock.On("List")}
+}
+
+func (_c *MockLdap_List_Call) Run(run func()) *MockLdap_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLdap_List_Call) Return(lDAPs []security.LDAP, err error) *MockLdap_List_Call {
+	_c.Call.Return(lDAPs, err)
+	return _c
+}
+
+func (_c *MockLdap_List_Call) RunAndReturn(run func() ([]security.LDAP, error)) *MockLdap_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockLdap
+func (_mock *MockLdap) Update(name string, ldap security.LDAP) error {
+	ret := _mock.Called(name, ldap)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, security.LDAP) error); ok {
+		r0 = returnFunc(name, ldap)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLdap_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockLdap_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - name string
+//   - ldap security.LDAP
+func (_e *MockLdap_Expecter) Update(name interface{}, ldap interface{}) *MockLdap_Update_Call {
+	return &MockLdap_Update_Call{Call: _e.mock.On("Update", name, ldap)}
+}
+
+func (_c *MockLdap_Update_Call) Run(run func(name string, ldap security.LDAP)) *MockLdap_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 security.LDAP
+		if args[1] != nil {
+			arg1 = args[1].(security.LDAP)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLdap_Update_Call) Return(err error) *MockLdap_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLdap_Update_Call) RunAndReturn(run func(name string, ldap security.LDAP) error) *MockLdap_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
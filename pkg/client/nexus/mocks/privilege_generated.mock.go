@@ -0,0 +1,320 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockPrivilege creates a new instance of MockPrivilege. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPrivilege(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPrivilege {
+	mock := &MockPrivilege{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPrivilege is an autogenerated mock type for the Privilege type
+type MockPrivilege struct {
+	mock.Mock
+}
+
+type MockPrivilege_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPrivilege) EXPECT() *MockPrivilege_Expecter {
+	return &MockPrivilege_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockPrivilege
+func (_mock *MockPrivilege) Create(privilege security.Privilege) error {
+	ret := _mock.Called(privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(security.Privilege) error); ok {
+		r0 = returnFunc(privilege)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPrivilege_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPrivilege_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - privilege security.Privilege
+func (_e *MockPrivilege_Expecter) Create(privilege interface{}) *MockPrivilege_Create_Call {
+	return &MockPrivilege_Create_Call{Call: _e.mock.On("Create", privilege)}
+}
+
+func (_c *MockPrivilege_Create_Call) Run(run func(privilege security.Privilege)) *MockPrivilege_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 security.Privilege
+		if args[0] != nil {
+			arg0 = args[0].(security.Privilege)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPrivilege_Create_Call) Return(err error) *MockPrivilege_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPrivilege_Create_Call) RunAndReturn(run func(privilege security.Privilege) error) *MockPrivilege_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockPrivilege
+func (_mock *MockPrivilege) Delete(name string) error {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPrivilege_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockPrivilege_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - name string
+func (_e *MockPrivilege_Expecter) Delete(name interface{}) *MockPrivilege_Delete_Call {
+	return &MockPrivilege_Delete_Call{Call: _e.mock.On("Delete", name)}
+}
+
+func (_c *MockPrivilege_Delete_Call) Run(run func(name string)) *MockPrivilege_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPrivilege_Delete_Call) Return(err error) *MockPrivilege_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPrivilege_Delete_Call) RunAndReturn(run func(name string) error) *MockPrivilege_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockPrivilege
+func (_mock *MockPrivilege) Get(name string) (*security.Privilege, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *security.Privilege
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*security.Privilege, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *security.Privilege); ok {
+		r0 = returnFunc(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*security.Privilege)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPrivilege_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockPrivilege_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - name string
+func (_e *MockPrivilege_Expecter) Get(name interface{}) *MockPrivilege_Get_Call {
+	return &MockPrivilege_Get_Call{Call: _e.mock.On("Get", name)}
+}
+
+func (_c *MockPrivilege_Get_Call) Run(run func(name string)) *MockPrivilege_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPrivilege_Get_Call) Return(privilege *security.Privilege, err error) *MockPrivilege_Get_Call {
+	_c.Call.Return(privilege, err)
+	return _c
+}
+
+func (_c *MockPrivilege_Get_Call) RunAndReturn(run func(name string) (*security.Privilege, error)) *MockPrivilege_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByContentSelector provides a mock function for the type MockPrivilege
+func (_mock *MockPrivilege) ListByContentSelector(contentSelector string) ([]string, error) {
+	ret := _mock.Called(contentSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByContentSelector")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return returnFunc(contentSelector)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = returnFunc(contentSelector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(contentSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPrivilege_ListByContentSelector_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByContentSelector'
+type MockPrivilege_ListByContentSelector_Call struct {
+	*mock.Call
+}
+
+// ListByContentSelector is a helper method to define mock.On call
+//   - contentSelector string
+func (_e *MockPrivilege_Expecter) ListByContentSelector(contentSelector interface{}) *MockPrivilege_ListByContentSelector_Call {
+	return &MockPrivilege_ListByContentSelector_Call{Call: _e.mock.On("ListByContentSelector", contentSelector)}
+}
+
+func (_c *MockPrivilege_ListByContentSelector_Call) Run(run func(contentSelector string)) *MockPrivilege_ListByContentSelector_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPrivilege_ListByContentSelector_Call) Return(strings []string, err error) *MockPrivilege_ListByContentSelector_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockPrivilege_ListByContentSelector_Call) RunAndReturn(run func(contentSelector string) ([]string, error)) *MockPrivilege_ListByContentSelector_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockPrivilege
+func (_mock *MockPrivilege) Update(name string, privilege security.Privilege) error {
+	ret := _mock.Called(name, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, security.Privilege) error); ok {
+		r0 = returnFunc(name, privilege)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPrivilege_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockPrivilege_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - name string
+//   - privilege security.Privilege
+func (_e *MockPrivilege_Expecter) Update(name interface{}, privilege interface{}) *MockPrivilege_Update_Call {
+	return &MockPrivilege_Update_Call{Call: _e.mock.On("Update", name, privilege)}
+}
+
+func (_c *MockPrivilege_Update_Call) Run(run func(name string, privilege security.Privilege)) *MockPrivilege_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 security.Privilege
+		if args[1] != nil {
+			arg1 = args[1].(security.Privilege)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPrivilege_Update_Call) Return(err error) *MockPrivilege_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPrivilege_Update_Call) RunAndReturn(run func(name string, privilege security.Privilege) error) *MockPrivilege_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,198 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockRealm creates a new instance of MockRealm. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRealm(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRealm {
+	mock := &MockRealm{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRealm is an autogenerated mock type for the Realm type
+type MockRealm struct {
+	mock.Mock
+}
+
+type MockRealm_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRealm) EXPECT() *MockRealm_Expecter {
+	return &MockRealm_Expecter{mock: &_m.Mock}
+}
+
+// Activate provides a mock function for the type MockRealm
+func (_mock *MockRealm) Activate(ids []string) error {
+	ret := _mock.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Activate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = returnFunc(ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRealm_Activate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Activate'
+type MockRealm_Activate_Call struct {
+	*mock.Call
+}
+
+// Activate is a helper method to define mock.On call
+//   - ids []string
+func (_e *MockRealm_Expecter) Activate(ids interface{}) *MockRealm_Activate_Call {
+	return &MockRealm_Activate_Call{Call: _e.mock.On("Activate", ids)}
+}
+
+func (_c *MockRealm_Activate_Call) Run(run func(ids []string)) *MockRealm_Activate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRealm_Activate_Call) Return(err error) *MockRealm_Activate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRealm_Activate_Call) RunAndReturn(run func(ids []string) error) *MockRealm_Activate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActive provides a mock function for the type MockRealm
+func (_mock *MockRealm) ListActive() ([]string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActive")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRealm_ListActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActive'
+type MockRealm_ListActive_Call struct {
+	*mock.Call
+}
+
+// ListActive is a helper method to define mock.On call
+func (_e *MockRealm_Expecter) ListActive() *MockRealm_ListActive_Call {
+	return &MockRealm_ListActive_Call{Call: _e.mock.On("ListActive")}
+}
+
+func (_c *MockRealm_ListActive_Call) Run(run func()) *MockRealm_ListActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRealm_ListActive_Call) Return(strings []string, err error) *MockRealm_ListActive_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockRealm_ListActive_Call) RunAndReturn(run func() ([]string, error)) *MockRealm_ListActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAvailable provides a mock function for the type MockRealm
+func (_mock *MockRealm) ListAvailable() ([]security.Realm, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAvailable")
+	}
+
+	var r0 []security.Realm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]security.Realm, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []security.Realm); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]security.Realm)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRealm_ListAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAvailable'
+type MockRealm_ListAvailable_Call struct {
+	*mock.Call
+}
+
+// ListAvailable is a helper method to define mock.On call
+func (_e *MockRealm_Expecter) ListAvailable() *MockRealm_ListAvailable_Call {
+	return &MockRealm_ListAvailable_Call{Call: _e.mock.On("ListAvailable")}
+}
+
+func (_c *MockRealm_ListAvailable_Call) Run(run func()) *MockRealm_ListAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRealm_ListAvailable_Call) Return(realms []security.Realm, err error) *MockRealm_ListAvailable_Call {
+	_c.Call.Return(realms, err)
+	return _c
+}
+
+func (_c *MockRealm_ListAvailable_Call) RunAndReturn(run func() ([]security.Realm, error)) *MockRealm_ListAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -164,23 +164,23 @@ func (_c *MockRepository_Delete_Call) RunAndReturn(run func(ctx context.Context,
 }
 
 // Get provides a mock function for the type MockRepository
-func (_mock *MockRepository) Get(ctx context.Context, id string, format string, repoType string) (interface{}, error) {
+func (_mock *MockRepository) Get(ctx context.Context, id string, format string, repoType string) (map[string]interface{}, error) {
 	ret := _mock.Called(ctx, id, format, repoType)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
 	}
 
-	var r0 interface{}
+	var r0 map[string]interface{}
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (interface{}, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (map[string]interface{}, error)); ok {
 		return returnFunc(ctx, id, format, repoType)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) interface{}); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) map[string]interface{}); ok {
 		r0 = returnFunc(ctx, id, format, repoType)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(map[string]interface{})
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
@@ -233,12 +233,12 @@ func (_c *MockRepository_Get_Call) Run(run func(ctx context.Context, id string,
 	return _c
 }
 
-func (_c *MockRepository_Get_Call) Return(ifaceVal interface{}, err error) *MockRepository_Get_Call {
-	_c.Call.Return(ifaceVal, err)
+func (_c *MockRepository_Get_Call) Return(stringToIfaceVal map[string]interface{}, err error) *MockRepository_Get_Call {
+	_c.Call.Return(stringToIfaceVal, err)
 	return _c
 }
 
-func (_c *MockRepository_Get_Call) RunAndReturn(run func(ctx context.Context, id string, format string, repoType string) (interface{}, error)) *MockRepository_Get_Call {
+func (_c *MockRepository_Get_Call) RunAndReturn(run func(ctx context.Context, id string, format string, repoType string) (map[string]interface{}, error)) *MockRepository_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
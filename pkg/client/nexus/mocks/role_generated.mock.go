@@ -200,6 +200,68 @@ func (_c *MockRole_Get_Call) RunAndReturn(run func(id string) (*security.Role, e
 	return _c
 }
 
+// ListBySource provides a mock function for the type MockRole
+func (_mock *MockRole) ListBySource(source string) ([]security.Role, error) {
+	ret := _mock.Called(source)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBySource")
+	}
+
+	var r0 []security.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]security.Role, error)); ok {
+		return returnFunc(source)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []security.Role); ok {
+		r0 = returnFunc(source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]security.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(source)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRole_ListBySource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBySource'
+type MockRole_ListBySource_Call struct {
+	*mock.Call
+}
+
+// ListBySource is a helper method to define mock.On call
+//   - source string
+func (_e *MockRole_Expecter) ListBySource(source interface{}) *MockRole_ListBySource_Call {
+	return &MockRole_ListBySource_Call{Call: _e.mock.On("ListBySource", source)}
+}
+
+func (_c *MockRole_ListBySource_Call) Run(run func(source string)) *MockRole_ListBySource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRole_ListBySource_Call) Return(roles []security.Role, err error) *MockRole_ListBySource_Call {
+	_c.Call.Return(roles, err)
+	return _c
+}
+
+func (_c *MockRole_ListBySource_Call) RunAndReturn(run func(source string) ([]security.Role, error)) *MockRole_ListBySource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockRole
 func (_mock *MockRole) Update(id string, role security.Role) error {
 	ret := _mock.Called(id, role)
@@ -0,0 +1,252 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockRoutingRule creates a new instance of MockRoutingRule. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoutingRule(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoutingRule {
+	mock := &MockRoutingRule{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoutingRule is an autogenerated mock type for the RoutingRule type
+type MockRoutingRule struct {
+	mock.Mock
+}
+
+type MockRoutingRule_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoutingRule) EXPECT() *MockRoutingRule_Expecter {
+	return &MockRoutingRule_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockRoutingRule
+func (_mock *MockRoutingRule) Create(rule *schema.RoutingRule) error {
+	ret := _mock.Called(rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*schema.RoutingRule) error); ok {
+		r0 = returnFunc(rule)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRoutingRule_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRoutingRule_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - rule *schema.RoutingRule
+func (_e *MockRoutingRule_Expecter) Create(rule interface{}) *MockRoutingRule_Create_Call {
+	return &MockRoutingRule_Create_Call{Call: _e.mock.On("Create", rule)}
+}
+
+func (_c *MockRoutingRule_Create_Call) Run(run func(rule *schema.RoutingRule)) *MockRoutingRule_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *schema.RoutingRule
+		if args[0] != nil {
+			arg0 = args[0].(*schema.RoutingRule)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoutingRule_Create_Call) Return(err error) *MockRoutingRule_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRoutingRule_Create_Call) RunAndReturn(run func(rule *schema.RoutingRule) error) *MockRoutingRule_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockRoutingRule
+func (_mock *MockRoutingRule) Delete(name string) error {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRoutingRule_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRoutingRule_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - name string
+func (_e *MockRoutingRule_Expecter) Delete(name interface{}) *MockRoutingRule_Delete_Call {
+	return &MockRoutingRule_Delete_Call{Call: _e.mock.On("Delete", name)}
+}
+
+func (_c *MockRoutingRule_Delete_Call) Run(run func(name string)) *MockRoutingRule_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoutingRule_Delete_Call) Return(err error) *MockRoutingRule_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRoutingRule_Delete_Call) RunAndReturn(run func(name string) error) *MockRoutingRule_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockRoutingRule
+func (_mock *MockRoutingRule) Get(name string) (*schema.RoutingRule, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *schema.RoutingRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*schema.RoutingRule, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *schema.RoutingRule); ok {
+		r0 = returnFunc(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.RoutingRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoutingRule_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRoutingRule_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - name string
+func (_e *MockRoutingRule_Expecter) Get(name interface{}) *MockRoutingRule_Get_Call {
+	return &MockRoutingRule_Get_Call{Call: _e.mock.On("Get", name)}
+}
+
+func (_c *MockRoutingRule_Get_Call) Run(run func(name string)) *MockRoutingRule_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoutingRule_Get_Call) Return(routingRule *schema.RoutingRule, err error) *MockRoutingRule_Get_Call {
+	_c.Call.Return(routingRule, err)
+	return _c
+}
+
+func (_c *MockRoutingRule_Get_Call) RunAndReturn(run func(name string) (*schema.RoutingRule, error)) *MockRoutingRule_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockRoutingRule
+func (_mock *MockRoutingRule) Update(rule *schema.RoutingRule) error {
+	ret := _mock.Called(rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*schema.RoutingRule) error); ok {
+		r0 = returnFunc(rule)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRoutingRule_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRoutingRule_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - rule *schema.RoutingRule
+func (_e *MockRoutingRule_Expecter) Update(rule interface{}) *MockRoutingRule_Update_Call {
+	return &MockRoutingRule_Update_Call{Call: _e.mock.On("Update", rule)}
+}
+
+func (_c *MockRoutingRule_Update_Call) Run(run func(rule *schema.RoutingRule)) *MockRoutingRule_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *schema.RoutingRule
+		if args[0] != nil {
+			arg0 = args[0].(*schema.RoutingRule)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoutingRule_Update_Call) Return(err error) *MockRoutingRule_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRoutingRule_Update_Call) RunAndReturn(run func(rule *schema.RoutingRule) error) *MockRoutingRule_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockStatusManager creates a new instance of MockStatusManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStatusManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStatusManager {
+	mock := &MockStatusManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStatusManager is an autogenerated mock type for the StatusManager type
+type MockStatusManager struct {
+	mock.Mock
+}
+
+type MockStatusManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStatusManager) EXPECT() *MockStatusManager_Expecter {
+	return &MockStatusManager_Expecter{mock: &_m.Mock}
+}
+
+// GetStatus provides a mock function for the type MockStatusManager
+func (_mock *MockStatusManager) GetStatus(ctx context.Context) (*nexus.SystemStatus, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatus")
+	}
+
+	var r0 *nexus.SystemStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*nexus.SystemStatus, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *nexus.SystemStatus); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*nexus.SystemStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatusManager_GetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatus'
+type MockStatusManager_GetStatus_Call struct {
+	*mock.Call
+}
+
+// GetStatus is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStatusManager_Expecter) GetStatus(ctx interface{}) *MockStatusManager_GetStatus_Call {
+	return &MockStatusManager_GetStatus_Call{Call: _e.mock.On("GetStatus", ctx)}
+}
+
+func (_c *MockStatusManager_GetStatus_Call) Run(run func(ctx context.Context)) *MockStatusManager_GetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatusManager_GetStatus_Call) Return(systemStatus *nexus.SystemStatus, err error) *MockStatusManager_GetStatus_Call {
+	_c.Call.Return(systemStatus, err)
+	return _c
+}
+
+func (_c *MockStatusManager_GetStatus_Call) RunAndReturn(run func(ctx context.Context) (*nexus.SystemStatus, error)) *MockStatusManager_GetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
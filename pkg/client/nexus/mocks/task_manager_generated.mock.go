@@ -0,0 +1,418 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockTaskManager creates a new instance of MockTaskManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTaskManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTaskManager {
+	mock := &MockTaskManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTaskManager is an autogenerated mock type for the TaskManager type
+type MockTaskManager struct {
+	mock.Mock
+}
+
+type MockTaskManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTaskManager) EXPECT() *MockTaskManager_Expecter {
+	return &MockTaskManager_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockTaskManager
+func (_mock *MockTaskManager) Create(ctx context.Context, task *nexus.TaskTemplate) (string, error) {
+	ret := _mock.Called(ctx, task)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *nexus.TaskTemplate) (string, error)); ok {
+		return returnFunc(ctx, task)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *nexus.TaskTemplate) string); ok {
+		r0 = returnFunc(ctx, task)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *nexus.TaskTemplate) error); ok {
+		r1 = returnFunc(ctx, task)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTaskManager_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTaskManager_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - task *nexus.TaskTemplate
+func (_e *MockTaskManager_Expecter) Create(ctx interface{}, task interface{}) *MockTaskManager_Create_Call {
+	return &MockTaskManager_Create_Call{Call: _e.mock.On("Create", ctx, task)}
+}
+
+func (_c *MockTaskManager_Create_Call) Run(run func(ctx context.Context, task *nexus.TaskTemplate)) *MockTaskManager_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *nexus.TaskTemplate
+		if args[1] != nil {
+			arg1 = args[1].(*nexus.TaskTemplate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTaskManager_Create_Call) Return(s string, err error) *MockTaskManager_Create_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockTaskManager_Create_Call) RunAndReturn(run func(ctx context.Context, task *nexus.TaskTemplate) (string, error)) *MockTaskManager_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockTaskManager
+func (_mock *MockTaskManager) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTaskManager_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockTaskManager_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockTaskManager_Expecter) Delete(ctx interface{}, id interface{}) *MockTaskManager_Delete_Call {
+	return &MockTaskManager_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockTaskManager_Delete_Call) Run(run func(ctx context.Context, id string)) *MockTaskManager_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTaskManager_Delete_Call) Return(err error) *MockTaskManager_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskManager_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockTaskManager_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockTaskManager
+func (_mock *MockTaskManager) Get(ctx context.Context, id string) (*nexus.Task, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *nexus.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*nexus.Task, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *nexus.Task); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*nexus.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTaskManager_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockTaskManager_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockTaskManager_Expecter) Get(ctx interface{}, id interface{}) *MockTaskManager_Get_Call {
+	return &MockTaskManager_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *MockTaskManager_Get_Call) Run(run func(ctx context.Context, id string)) *MockTaskManager_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTaskManager_Get_Call) Return(task *nexus.Task, err error) *MockTaskManager_Get_Call {
+	_c.Call.Return(task, err)
+	return _c
+}
+
+func (_c *MockTaskManager_Get_Call) RunAndReturn(run func(ctx context.Context, id string) (*nexus.Task, error)) *MockTaskManager_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockTaskManager
+func (_mock *MockTaskManager) List(ctx context.Context, taskType string) ([]nexus.Task, error) {
+	ret := _mock.Called(ctx, taskType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []nexus.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]nexus.Task, error)); ok {
+		return returnFunc(ctx, taskType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []nexus.Task); ok {
+		r0 = returnFunc(ctx, taskType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]nexus.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, taskType)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTaskManager_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockTaskManager_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskType string
+func (_e *MockTaskManager_Expecter) List(ctx interface{}, taskType interface{}) *MockTaskManager_List_Call {
+	return &MockTaskManager_List_Call{Call: _e.mock.On("List", ctx, taskType)}
+}
+
+func (_c *MockTaskManager_List_Call) Run(run func(ctx context.Context, taskType string)) *MockTaskManager_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTaskManager_List_Call) Return(tasks []nexus.Task, err error) *MockTaskManager_List_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *MockTaskManager_List_Call) RunAndReturn(run func(ctx context.Context, taskType string) ([]nexus.Task, error)) *MockTaskManager_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function for the type MockTaskManager
+func (_mock *MockTaskManager) Run(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTaskManager_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type MockTaskManager_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockTaskManager_Expecter) Run(ctx interface{}, id interface{}) *MockTaskManager_Run_Call {
+	return &MockTaskManager_Run_Call{Call: _e.mock.On("Run", ctx, id)}
+}
+
+func (_c *MockTaskManager_Run_Call) Run(run func(ctx context.Context, id string)) *MockTaskManager_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTaskManager_Run_Call) Return(err error) *MockTaskManager_Run_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskManager_Run_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockTaskManager_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockTaskManager
+func (_mock *MockTaskManager) Update(ctx context.Context, id string, task *nexus.TaskTemplate) error {
+	ret := _mock.Called(ctx, id, task)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *nexus.TaskTemplate) error); ok {
+		r0 = returnFunc(ctx, id, task)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTaskManager_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockTaskManager_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - task *nexus.TaskTemplate
+func (_e *MockTaskManager_Expecter) Update(ctx interface{}, id interface{}, task interface{}) *MockTaskManager_Update_Call {
+	return &MockTaskManager_Update_Call{Call: _e.mock.On("Update", ctx, id, task)}
+}
+
+func (_c *MockTaskManager_Update_Call) Run(run func(ctx context.Context, id string, task *nexus.TaskTemplate)) *MockTaskManager_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *nexus.TaskTemplate
+		if args[2] != nil {
+			arg2 = args[2].(*nexus.TaskTemplate)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTaskManager_Update_Call) Return(err error) *MockTaskManager_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskManager_Update_Call) RunAndReturn(run func(ctx context.Context, id string, task *nexus.TaskTemplate) error) *MockTaskManager_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -257,6 +257,74 @@ func (_c *MockUser_Get_Call) RunAndReturn(run func(id string) (*security.User, e
 	return _c
 }
 
+// GetBySource provides a mock function for the type MockUser
+func (_mock *MockUser) GetBySource(id string, source string) (*security.User, error) {
+	ret := _mock.Called(id, source)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySource")
+	}
+
+	var r0 *security.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*security.User, error)); ok {
+		return returnFunc(id, source)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *security.User); ok {
+		r0 = returnFunc(id, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*security.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(id, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUser_GetBySource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBySource'
+type MockUser_GetBySource_Call struct {
+	*mock.Call
+}
+
+// GetBySource is a helper method to define mock.On call
+//   - id string
+//   - source string
+func (_e *MockUser_Expecter) GetBySource(id interface{}, source interface{}) *MockUser_GetBySource_Call {
+	return &MockUser_GetBySource_Call{Call: _e.mock.On("GetBySource", id, source)}
+}
+
+func (_c *MockUser_GetBySource_Call) Run(run func(id string, source string)) *MockUser_GetBySource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUser_GetBySource_Call) Return(user *security.User, err error) *MockUser_GetBySource_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUser_GetBySource_Call) RunAndReturn(run func(id string, source string) (*security.User, error)) *MockUser_GetBySource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockUser
 func (_mock *MockUser) Update(id string, user security.User) error {
 	ret := _mock.Called(id, user)
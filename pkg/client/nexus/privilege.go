@@ -0,0 +1,154 @@
+package nexus
+
+import (
+	"fmt"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/security/privilege"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+)
+
+// PrivilegeClient manages nexus privileges of all types.
+// go-nexus-client has a separate service for creating and updating each privilege type,
+// so the requests are dispatched by the type of the privilege.
+type PrivilegeClient struct {
+	service *privilege.SecurityPrivilegeService
+}
+
+func NewPrivilegeClient(service *privilege.SecurityPrivilegeService) *PrivilegeClient {
+	return &PrivilegeClient{service: service}
+}
+
+// Get returns the privilege by name.
+func (c *PrivilegeClient) Get(name string) (*security.Privilege, error) {
+	return c.service.Get(name)
+}
+
+// Create creates the privilege of the given type.
+func (c *PrivilegeClient) Create(p security.Privilege) error {
+	switch p.Type {
+	case security.PrivilegeTypeApplication:
+		return c.service.Application.Create(toPrivilegeApplication(p))
+	case security.PrivilegeTypeWildcard:
+		return c.service.Wildcard.Create(toPrivilegeWildcard(p))
+	case security.PrivilegeTypeRepositoryView:
+		return c.service.RepositoryView.Create(toPrivilegeRepositoryView(p))
+	case security.PrivilegeTypeRepositoryAdmin:
+		return c.service.RepositoryAdmin.Create(toPrivilegeRepositoryAdmin(p))
+	case security.PrivilegeTypeContentSelector:
+		return c.service.RepositoryContentSelector.Create(toPrivilegeRepositoryContentSelector(p))
+	case security.PrivilegeTypeScript:
+		return c.service.Script.Create(toPrivilegeScript(p))
+	default:
+		return fmt.Errorf("unsupported privilege type %s", p.Type)
+	}
+}
+
+// Update updates the privilege of the given type.
+func (c *PrivilegeClient) Update(name string, p security.Privilege) error {
+	switch p.Type {
+	case security.PrivilegeTypeApplication:
+		return c.service.Application.Update(name, toPrivilegeApplication(p))
+	case security.PrivilegeTypeWildcard:
+		return c.service.Wildcard.Update(name, toPrivilegeWildcard(p))
+	case security.PrivilegeTypeRepositoryView:
+		return c.service.RepositoryView.Update(name, toPrivilegeRepositoryView(p))
+	case security.PrivilegeTypeRepositoryAdmin:
+		return c.service.RepositoryAdmin.Update(name, toPrivilegeRepositoryAdmin(p))
+	case security.PrivilegeTypeContentSelector:
+		return c.service.RepositoryContentSelector.Update(name, toPrivilegeRepositoryContentSelector(p))
+	case security.PrivilegeTypeScript:
+		return c.service.Script.Update(name, toPrivilegeScript(p))
+	default:
+		return fmt.Errorf("unsupported privilege type %s", p.Type)
+	}
+}
+
+// ListByContentSelector returns names of repository-content-selector privileges that use the content selector.
+func (c *PrivilegeClient) ListByContentSelector(contentSelector string) ([]string, error) {
+	privileges, err := c.service.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get privileges: %w", err)
+	}
+
+	var names []string
+
+	for i := range privileges {
+		if privileges[i].Type == security.PrivilegeTypeContentSelector &&
+			privileges[i].ContentSelector == contentSelector {
+			names = append(names, privileges[i].Name)
+		}
+	}
+
+	return names, nil
+}
+
+// Delete deletes the privilege by name.
+func (c *PrivilegeClient) Delete(name string) error {
+	return c.service.Delete(name)
+}
+
+func toPrivilegeApplication(p security.Privilege) security.PrivilegeApplication {
+	return security.PrivilegeApplication{
+		Name:        p.Name,
+		Description: p.Description,
+		Actions:     toActions[security.SecurityPrivilegeApplicationActions](p.Actions),
+		Domain:      p.Domain,
+	}
+}
+
+func toPrivilegeWildcard(p security.Privilege) security.PrivilegeWildcard {
+	return security.PrivilegeWildcard{
+		Name:        p.Name,
+		Description: p.Description,
+		Pattern:     p.Pattern,
+	}
+}
+
+func toPrivilegeRepositoryView(p security.Privilege) security.PrivilegeRepositoryView {
+	return security.PrivilegeRepositoryView{
+		Name:        p.Name,
+		Description: p.Description,
+		Actions:     toActions[security.SecurityPrivilegeRepositoryViewActions](p.Actions),
+		Format:      p.Format,
+		Repository:  p.Repository,
+	}
+}
+
+func toPrivilegeRepositoryAdmin(p security.Privilege) security.PrivilegeRepositoryAdmin {
+	return security.PrivilegeRepositoryAdmin{
+		Name:        p.Name,
+		Description: p.Description,
+		Actions:     toActions[security.SecurityPrivilegeRepositoryAdminActions](p.Actions),
+		Format:      p.Format,
+		Repository:  p.Repository,
+	}
+}
+
+func toPrivilegeRepositoryContentSelector(p security.Privilege) security.PrivilegeRepositoryContentSelector {
+	return security.PrivilegeRepositoryContentSelector{
+		Name:            p.Name,
+		Description:     p.Description,
+		Actions:         toActions[security.SecurityPrivilegeRepositoryContentSelectorActions](p.Actions),
+		Format:          p.Format,
+		Repository:      p.Repository,
+		ContentSelector: p.ContentSelector,
+	}
+}
+
+func toPrivilegeScript(p security.Privilege) security.PrivilegeScript {
+	return security.PrivilegeScript{
+		Name:        p.Name,
+		Description: p.Description,
+		Actions:     toActions[security.SecurityPrivilegeScriptActions](p.Actions),
+		ScriptName:  p.ScriptName,
+	}
+}
+
+func toActions[T ~string](actions []string) []T {
+	result := make([]T, 0, len(actions))
+	for _, a := range actions {
+		result = append(result, T(a))
+	}
+
+	return result
+}
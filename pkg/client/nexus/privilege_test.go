@@ -0,0 +1,220 @@
+package nexus
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3"
+	nexus3client "github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivilegeClient_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		privilege security.Privilege
+		wantPath  string
+		wantBody  map[string]any
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "application privilege",
+			privilege: security.Privilege{
+				Name:    "users-read",
+				Type:    security.PrivilegeTypeApplication,
+				Actions: []string{"READ"},
+				Domain:  "users",
+			},
+			wantPath: "/service/rest/v1/security/privileges/application",
+			wantBody: map[string]any{"name": "users-read", "actions": []any{"READ"}, "domain": "users"},
+			wantErr:  require.NoError,
+		},
+		{
+			name: "wildcard privilege",
+			privilege: security.Privilege{
+				Name:    "all-read",
+				Type:    security.PrivilegeTypeWildcard,
+				Pattern: "nexus:*:*:read",
+			},
+			wantPath: "/service/rest/v1/security/privileges/wildcard",
+			wantBody: map[string]any{"name": "all-read", "pattern": "nexus:*:*:read"},
+			wantErr:  require.NoError,
+		},
+		{
+			name: "repository content selector privilege",
+			privilege: security.Privilege{
+				Name:            "team-a-deploy",
+				Type:            security.PrivilegeTypeContentSelector,
+				Description:     "Deploy team A artifacts",
+				Actions:         []string{"ADD", "EDIT"},
+				Format:          "maven2",
+				Repository:      "maven-releases",
+				ContentSelector: "team-a",
+			},
+			wantPath: "/service/rest/v1/security/privileges/repository-content-selector",
+			wantBody: map[string]any{
+				"name":            "team-a-deploy",
+				"description":     "Deploy team A artifacts",
+				"actions":         []any{"ADD", "EDIT"},
+				"format":          "maven2",
+				"repository":      "maven-releases",
+				"contentSelector": "team-a",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "script privilege",
+			privilege: security.Privilege{
+				Name:        "cleanup-run",
+				Type:        security.PrivilegeTypeScript,
+				Description: "Run cleanup",
+				Actions:     []string{"RUN"},
+				ScriptName:  "cleanup",
+			},
+			wantPath: "/service/rest/v1/security/privileges/script",
+			wantBody: map[string]any{
+				"name":        "cleanup-run",
+				"description": "Run cleanup",
+				"actions":     []any{"RUN"},
+				"scriptName":  "cleanup",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "unsupported privilege type",
+			privilege: security.Privilege{
+				Name: "privilege",
+				Type: "unknown",
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "unsupported privilege type unknown")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				gotPath string
+				gotBody map[string]any
+			)
+
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				_ = json.NewDecoder(r.Body).Decode(&gotBody)
+
+				rw.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			c := NewPrivilegeClient(nexus3.NewClient(nexus3client.Config{URL: server.URL}).Security.Privilege)
+
+			err := c.Create(tt.privilege)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantPath, gotPath)
+			assert.Equal(t, tt.wantBody, gotBody)
+		})
+	}
+}
+
+func TestPrivilegeClient_Update(t *testing.T) {
+	t.Parallel()
+
+	var gotMethod, gotPath string
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewPrivilegeClient(nexus3.NewClient(nexus3client.Config{URL: server.URL}).Security.Privilege)
+
+	err := c.Update("npm-admin", security.Privilege{
+		Name:       "npm-admin",
+		Type:       security.PrivilegeTypeRepositoryAdmin,
+		Actions:    []string{"BROWSE"},
+		Format:     "npm",
+		Repository: "*-npm",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, gotMethod)
+	assert.Equal(t, "/service/rest/v1/security/privileges/repository-admin/npm-admin", gotPath)
+}
+
+func TestPrivilegeClient_ListByContentSelector(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response func(rw http.ResponseWriter)
+		want     []string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name: "privileges using the content selector",
+			response: func(rw http.ResponseWriter) {
+				rw.Header().Set("Content-Type", "application/json")
+				_, _ = rw.Write([]byte(`[
+					{"name": "team-a-deploy", "type": "repository-content-selector", "contentSelector": "team-a"},
+					{"name": "team-b-deploy", "type": "repository-content-selector", "contentSelector": "team-b"},
+					{"name": "team-a-admin", "type": "repository-admin", "repository": "team-a"},
+					{"name": "team-a-read", "type": "repository-content-selector", "contentSelector": "team-a"}
+				]`))
+			},
+			want:    []string{"team-a-deploy", "team-a-read"},
+			wantErr: require.NoError,
+		},
+		{
+			name: "content selector is not used",
+			response: func(rw http.ResponseWriter) {
+				rw.Header().Set("Content-Type", "application/json")
+				_, _ = rw.Write([]byte(`[{"name": "all-read", "type": "wildcard", "pattern": "nexus:*:*:read"}]`))
+			},
+			want:    nil,
+			wantErr: require.NoError,
+		},
+		{
+			name: "failed to get privileges",
+			response: func(rw http.ResponseWriter) {
+				rw.WriteHeader(http.StatusInternalServerError)
+			},
+			want: nil,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to get privileges")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/service/rest/v1/security/privileges", r.URL.Path)
+
+				tt.response(rw)
+			}))
+			defer server.Close()
+
+			c := NewPrivilegeClient(nexus3.NewClient(nexus3client.Config{URL: server.URL}).Security.Privilege)
+
+			got, err := c.ListByContentSelector("team-a")
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
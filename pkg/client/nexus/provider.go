@@ -3,6 +3,8 @@ package nexus
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sync"
 
 	"github.com/datadrivers/go-nexus-client/nexus3"
 	nexus3client "github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
@@ -12,16 +14,51 @@ import (
 
 	"github.com/epam/edp-nexus-operator/api/common"
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/helper"
 )
 
 // ApiClientProvider is a struct for providing nexus api client.
+// Clients are cached per Nexus resource, so all resources that refer to the same Nexus share connections.
 type ApiClientProvider struct {
 	k8sClient client.Client
+	// operatorNamespace is the namespace where Secrets of ClusterNexus resources are stored.
+	operatorNamespace string
+
+	mu sync.Mutex
+	// clients contains clients cached by the name of Nexus or ClusterNexus resource.
+	// ClusterNexus is cluster-scoped, so its key doesn't contain the namespace.
+	clients map[types.NamespacedName]*nexusClients
 }
 
 // NewApiClientProvider returns a new instance of ApiClientProvider.
 func NewApiClientProvider(k8sClient client.Client) *ApiClientProvider {
-	return &ApiClientProvider{k8sClient: k8sClient}
+	return &ApiClientProvider{k8sClient: k8sClient, operatorNamespace: helper.GetOperatorNamespace()}
+}
+
+// nexusConnection contains data required to connect to a Nexus instance.
+type nexusConnection struct {
+	key types.NamespacedName
+	uid types.UID
+	// version changes when the Nexus resource or its Secret is changed.
+	version  string
+	url      string
+	user     string
+	password string
+	// tls is nil if the default TLS settings should be used.
+	tls *tlsMaterial
+}
+
+// nexusClients contains clients of a Nexus instance that share one http transport.
+type nexusClients struct {
+	uid                 types.UID
+	version             string
+	httpClient          *http.Client
+	apiClient           *nexus3.NexusClient
+	repoClient          *RepoClient
+	cleanupPolicyClient *NexusCleanupPolicyClient
+	taskClient          *TaskClient
+	statusClient        *StatusClient
+	realmClient         *RealmClient
 }
 
 // GetNexusApiClientFromNexus returns nexus api client from Nexus CR.
@@ -29,16 +66,71 @@ func (p *ApiClientProvider) GetNexusApiClientFromNexus(
 	ctx context.Context,
 	nexus *nexusApi.Nexus,
 ) (*nexus3.NexusClient, error) {
-	secret, err := p.getNexusSecret(ctx, nexus)
+	conn, err := p.getNexusConnection(ctx, nexus)
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := p.getClients(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return clients.apiClient, nil
+}
+
+// GetNexusApiClientFromClusterNexus returns nexus api client from ClusterNexus CR.
+func (p *ApiClientProvider) GetNexusApiClientFromClusterNexus(
+	ctx context.Context,
+	clusterNexus *nexusApi.ClusterNexus,
+) (*nexus3.NexusClient, error) {
+	conn, err := p.getClusterNexusConnection(ctx, clusterNexus)
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := p.getClients(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return clients.apiClient, nil
+}
+
+// GetNexusStatusClientFromNexus returns nexus status client from Nexus CR.
+func (p *ApiClientProvider) GetNexusStatusClientFromNexus(
+	ctx context.Context,
+	nexus *nexusApi.Nexus,
+) (*StatusClient, error) {
+	conn, err := p.getNexusConnection(ctx, nexus)
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := p.getClients(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return clients.statusClient, nil
+}
+
+// GetNexusStatusClientFromClusterNexus returns nexus status client from ClusterNexus CR.
+func (p *ApiClientProvider) GetNexusStatusClientFromClusterNexus(
+	ctx context.Context,
+	clusterNexus *nexusApi.ClusterNexus,
+) (*StatusClient, error) {
+	conn, err := p.getClusterNexusConnection(ctx, clusterNexus)
 	if err != nil {
 		return nil, err
 	}
 
-	return nexus3.NewClient(nexus3client.Config{
-		URL:      nexus.Spec.Url,
-		Username: string(secret.Data["user"]),
-		Password: string(secret.Data["password"]),
-	}), nil
+	clients, err := p.getClients(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return clients.statusClient, nil
 }
 
 func (p *ApiClientProvider) GetNexusApiClientFromNexusRef(
@@ -46,15 +138,12 @@ func (p *ApiClientProvider) GetNexusApiClientFromNexusRef(
 	namespace string,
 	ref common.HasNexusRef,
 ) (*nexus3.NexusClient, error) {
-	nexus := &nexusApi.Nexus{}
-	if err := p.k8sClient.Get(ctx, types.NamespacedName{
-		Name:      ref.GetNexusRef().Name,
-		Namespace: namespace,
-	}, nexus); err != nil {
-		return nil, fmt.Errorf("failed to get nexus instance: %w", err)
+	clients, err := p.getClientsFromRef(ctx, namespace, ref)
+	if err != nil {
+		return nil, err
 	}
 
-	return p.GetNexusApiClientFromNexus(ctx, nexus)
+	return clients.apiClient, nil
 }
 
 func (p *ApiClientProvider) GetNexusRepositoryClientFromNexusRef(
@@ -62,24 +151,12 @@ func (p *ApiClientProvider) GetNexusRepositoryClientFromNexusRef(
 	namespace string,
 	ref common.HasNexusRef,
 ) (*RepoClient, error) {
-	nexus := &nexusApi.Nexus{}
-	if err := p.k8sClient.Get(ctx, types.NamespacedName{
-		Name:      ref.GetNexusRef().Name,
-		Namespace: namespace,
-	}, nexus); err != nil {
-		return nil, fmt.Errorf("failed to get nexus instance: %w", err)
-	}
-
-	secret, err := p.getNexusSecret(ctx, nexus)
+	clients, err := p.getClientsFromRef(ctx, namespace, ref)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewRepoClient(ClientConfig{
-		BaseURL:  nexus.Spec.Url,
-		UserName: string(secret.Data["user"]),
-		Password: string(secret.Data["password"]),
-	}), nil
+	return clients.repoClient, nil
 }
 
 func (p *ApiClientProvider) GetNexusNexusCleanupPolicyClientFromNexusRef(
@@ -87,31 +164,204 @@ func (p *ApiClientProvider) GetNexusNexusCleanupPolicyClientFromNexusRef(
 	namespace string,
 	ref common.HasNexusRef,
 ) (*NexusCleanupPolicyClient, error) {
-	nexus := &nexusApi.Nexus{}
-	if err := p.k8sClient.Get(ctx, types.NamespacedName{
-		Name:      ref.GetNexusRef().Name,
-		Namespace: namespace,
-	}, nexus); err != nil {
-		return nil, fmt.Errorf("failed to get nexus instance: %w", err)
+	clients, err := p.getClientsFromRef(ctx, namespace, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return clients.cleanupPolicyClient, nil
+}
+
+func (p *ApiClientProvider) GetNexusTaskClientFromNexusRef(
+	ctx context.Context,
+	namespace string,
+	ref common.HasNexusRef,
+) (*TaskClient, error) {
+	clients, err := p.getClientsFromRef(ctx, namespace, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return clients.taskClient, nil
+}
+
+// GetNexusRealmClientFromNexusRef returns nexus realm client from the Nexus or ClusterNexus reference.
+func (p *ApiClientProvider) GetNexusRealmClientFromNexusRef(
+	ctx context.Context,
+	namespace string,
+	ref common.HasNexusRef,
+) (*RealmClient, error) {
+	clients, err := p.getClientsFromRef(ctx, namespace, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return clients.realmClient, nil
+}
+
+func (p *ApiClientProvider) getClientsFromRef(
+	ctx context.Context,
+	namespace string,
+	ref common.HasNexusRef,
+) (*nexusClients, error) {
+	conn, err := p.getNexusConnectionFromRef(ctx, namespace, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.getClients(conn)
+}
+
+// EvictNexusClients removes cached clients of the deleted Nexus and closes their idle connections.
+func (p *ApiClientProvider) EvictNexusClients(namespace, name string) {
+	p.evictClients(types.NamespacedName{Namespace: namespace, Name: name})
+}
+
+// EvictClusterNexusClients removes cached clients of the deleted ClusterNexus and closes their idle connections.
+func (p *ApiClientProvider) EvictClusterNexusClients(name string) {
+	p.evictClients(types.NamespacedName{Name: name})
+}
+
+func (p *ApiClientProvider) evictClients(key types.NamespacedName) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if cached, ok := p.clients[key]; ok {
+		cached.httpClient.CloseIdleConnections()
+		delete(p.clients, key)
 	}
+}
 
-	secret, err := p.getNexusSecret(ctx, nexus)
+// getClients returns cached clients for the connection.
+// Clients are rebuilt if the Nexus resource or its Secret is changed, or the resource is recreated.
+func (p *ApiClientProvider) getClients(conn nexusConnection) (*nexusClients, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.clients == nil {
+		p.clients = make(map[types.NamespacedName]*nexusClients)
+	}
+
+	cached, ok := p.clients[conn.key]
+	if ok && cached.uid == conn.uid && cached.version == conn.version {
+		return cached, nil
+	}
+
+	clients, err := newNexusClients(conn)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewNexusCleanupPolicyClient(ClientConfig{
-		BaseURL:  nexus.Spec.Url,
-		UserName: string(secret.Data["user"]),
-		Password: string(secret.Data["password"]),
-	}), nil
+	if ok {
+		cached.httpClient.CloseIdleConnections()
+	}
+
+	p.clients[conn.key] = clients
+
+	return clients, nil
+}
+
+// getNexusConnectionFromRef resolves Nexus or ClusterNexus from the reference.
+// Nexus is looked up in the given namespace, ClusterNexus is looked up cluster-wide.
+func (p *ApiClientProvider) getNexusConnectionFromRef(
+	ctx context.Context,
+	namespace string,
+	ref common.HasNexusRef,
+) (nexusConnection, error) {
+	nexusRef := ref.GetNexusRef()
+
+	switch nexusRef.Kind {
+	case "", common.NexusKind:
+		nexus := &nexusApi.Nexus{}
+		if err := p.k8sClient.Get(ctx, types.NamespacedName{
+			Name:      nexusRef.Name,
+			Namespace: namespace,
+		}, nexus); err != nil {
+			return nexusConnection{}, fmt.Errorf("failed to get nexus instance: %w", err)
+		}
+
+		return p.getNexusConnection(ctx, nexus)
+	case common.ClusterNexusKind:
+		clusterNexus := &nexusApi.ClusterNexus{}
+		if err := p.k8sClient.Get(ctx, types.NamespacedName{
+			Name: nexusRef.Name,
+		}, clusterNexus); err != nil {
+			return nexusConnection{}, fmt.Errorf("failed to get cluster nexus instance: %w", err)
+		}
+
+		if !clusterNexus.IsNamespaceAllowed(namespace) {
+			return nexusConnection{}, fmt.Errorf(
+				"namespace %s is not allowed to use ClusterNexus %s",
+				namespace,
+				clusterNexus.Name,
+			)
+		}
+
+		return p.getClusterNexusConnection(ctx, clusterNexus)
+	default:
+		return nexusConnection{}, fmt.Errorf("unsupported nexus kind %s", nexusRef.Kind)
+	}
+}
+
+func (p *ApiClientProvider) getNexusConnection(ctx context.Context, nexus *nexusApi.Nexus) (nexusConnection, error) {
+	secret, err := p.getNexusSecret(ctx, nexus.Spec.Secret, nexus.Namespace)
+	if err != nil {
+		return nexusConnection{}, err
+	}
+
+	material, err := p.getTLSMaterial(ctx, nexus.Spec.TLS, nexus.Namespace)
+	if err != nil {
+		return nexusConnection{}, err
+	}
+
+	return nexusConnection{
+		key:      types.NamespacedName{Namespace: nexus.Namespace, Name: nexus.Name},
+		uid:      nexus.UID,
+		version:  connectionVersion(nexus.Generation, &secret, material),
+		url:      nexus.Spec.Url,
+		user:     string(secret.Data["user"]),
+		password: string(secret.Data["password"]),
+		tls:      material,
+	}, nil
+}
+
+func (p *ApiClientProvider) getClusterNexusConnection(
+	ctx context.Context,
+	clusterNexus *nexusApi.ClusterNexus,
+) (nexusConnection, error) {
+	if p.operatorNamespace == "" {
+		return nexusConnection{}, fmt.Errorf(
+			"operator namespace is not defined, set %s to use ClusterNexus",
+			helper.OperatorNamespaceEnvVar,
+		)
+	}
+
+	secret, err := p.getNexusSecret(ctx, clusterNexus.Spec.Secret, p.operatorNamespace)
+	if err != nil {
+		return nexusConnection{}, err
+	}
+
+	material, err := p.getTLSMaterial(ctx, clusterNexus.Spec.TLS, p.operatorNamespace)
+	if err != nil {
+		return nexusConnection{}, err
+	}
+
+	return nexusConnection{
+		key:      types.NamespacedName{Name: clusterNexus.Name},
+		uid:      clusterNexus.UID,
+		version:  connectionVersion(clusterNexus.Generation, &secret, material),
+		url:      clusterNexus.Spec.Url,
+		user:     string(secret.Data["user"]),
+		password: string(secret.Data["password"]),
+		tls:      material,
+	}, nil
 }
 
-func (p *ApiClientProvider) getNexusSecret(ctx context.Context, nexus *nexusApi.Nexus) (corev1.Secret, error) {
+func (p *ApiClientProvider) getNexusSecret(ctx context.Context, name, namespace string) (corev1.Secret, error) {
 	secret := corev1.Secret{}
 	if err := p.k8sClient.Get(ctx, types.NamespacedName{
-		Name:      nexus.Spec.Secret,
-		Namespace: nexus.Namespace,
+		Name:      name,
+		Namespace: namespace,
 	}, &secret); err != nil {
 		return corev1.Secret{}, fmt.Errorf("failed to get nexus secret: %w", err)
 	}
@@ -126,3 +376,54 @@ func (p *ApiClientProvider) getNexusSecret(ctx context.Context, nexus *nexusApi.
 
 	return secret, nil
 }
+
+// connectionVersion returns version of the connection data.
+// The version changes when spec of the Nexus resource, its Secret or TLS settings are changed.
+func connectionVersion(generation int64, secret *corev1.Secret, material *tlsMaterial) string {
+	return fmt.Sprintf("%d/%s/%s", generation, secret.ResourceVersion, material.fingerprint())
+}
+
+func newNexusClients(conn nexusConnection) (*nexusClients, error) {
+	tlsConfig, err := conn.tls.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	transport := newRetryTransport(newHTTPTransport(tlsConfig))
+	httpClient := &http.Client{
+		Timeout:   defaultNexusClientTimeout,
+		Transport: transport,
+	}
+
+	// go-nexus-client accepts TLS settings only as file paths and doesn't apply custom root CAs,
+	// so it uses the http client that honors TLS settings of the Nexus resource
+	// and shares connections with other clients of the same Nexus instance.
+	nexusClient := nexus3.NewClient(nexus3client.Config{
+		URL:      conn.url,
+		Username: conn.user,
+		Password: conn.password,
+		HTTPClient: &http.Client{
+			Timeout:   defaultNexusClientTimeout,
+			Transport: &errorTransport{next: transport},
+		},
+	})
+
+	clientConfig := ClientConfig{
+		BaseURL:    conn.url,
+		UserName:   conn.user,
+		Password:   conn.password,
+		HTTPClient: httpClient,
+	}
+
+	return &nexusClients{
+		uid:                 conn.uid,
+		version:             conn.version,
+		httpClient:          httpClient,
+		apiClient:           nexusClient,
+		repoClient:          NewRepoClient(clientConfig),
+		cleanupPolicyClient: NewNexusCleanupPolicyClient(clientConfig),
+		taskClient:          NewTaskClient(clientConfig),
+		statusClient:        NewStatusClient(clientConfig),
+		realmClient:         NewRealmClient(clientConfig),
+	}, nil
+}
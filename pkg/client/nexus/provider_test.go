@@ -200,13 +200,116 @@ func TestApiClientProvider_GetNexusApiClientFromNexusRef(t *testing.T) {
 				require.Contains(t, err.Error(), "failed to get nexus instance")
 			},
 		},
+		{
+			name: "successfully get nexus api client from cluster nexus",
+			ref: &nexusApi.NexusRole{
+				Spec: nexusApi.NexusRoleSpec{
+					NexusRef: common.NexusRef{
+						Kind: common.ClusterNexusKind,
+						Name: "cluster-nexus",
+					},
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "nexus-secret",
+							Namespace: "operator",
+						},
+						Data: map[string][]byte{
+							"user":     []byte("user"),
+							"password": []byte("password"),
+						},
+					},
+					&nexusApi.ClusterNexus{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "cluster-nexus",
+						},
+						Spec: nexusApi.ClusterNexusSpec{
+							Secret:            "nexus-secret",
+							AllowedNamespaces: []string{"def*"},
+						},
+					},
+				)
+			},
+			want:    require.NotNil,
+			wantErr: require.NoError,
+		},
+		{
+			name: "namespace is not allowed to use cluster nexus",
+			ref: &nexusApi.NexusRole{
+				Spec: nexusApi.NexusRoleSpec{
+					NexusRef: common.NexusRef{
+						Kind: common.ClusterNexusKind,
+						Name: "cluster-nexus",
+					},
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t,
+					&nexusApi.ClusterNexus{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "cluster-nexus",
+						},
+						Spec: nexusApi.ClusterNexusSpec{
+							Secret:            "nexus-secret",
+							AllowedNamespaces: []string{"team-a"},
+						},
+					},
+				)
+			},
+			want: require.Nil,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "namespace default is not allowed to use ClusterNexus cluster-nexus")
+			},
+		},
+		{
+			name: "failed to get cluster nexus instance",
+			ref: &nexusApi.NexusRole{
+				Spec: nexusApi.NexusRoleSpec{
+					NexusRef: common.NexusRef{
+						Kind: common.ClusterNexusKind,
+						Name: "cluster-nexus",
+					},
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t)
+			},
+			want: require.Nil,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get cluster nexus instance")
+			},
+		},
+		{
+			name: "unsupported nexus kind",
+			ref: &nexusApi.NexusRole{
+				Spec: nexusApi.NexusRoleSpec{
+					NexusRef: common.NexusRef{
+						Kind: "Unknown",
+						Name: "nexus",
+					},
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t)
+			},
+			want: require.Nil,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unsupported nexus kind Unknown")
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			p := NewApiClientProvider(tt.k8sClient(t))
+			p := &ApiClientProvider{k8sClient: tt.k8sClient(t), operatorNamespace: "operator"}
 			got, err := p.GetNexusApiClientFromNexusRef(context.Background(), "default", tt.ref)
 
 			tt.wantErr(t, err)
@@ -320,3 +423,210 @@ func TestApiClientProvider_GetNexusRepositoryClientFromNexusRef(t *testing.T) {
 		})
 	}
 }
+
+func TestApiClientProvider_GetNexusApiClientFromClusterNexus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		clusterNexus      *nexusApi.ClusterNexus
+		operatorNamespace string
+		k8sClient         func(t *testing.T) client.Client
+		want              require.ValueAssertionFunc
+		wantErr           require.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully get nexus api client",
+			clusterNexus: &nexusApi.ClusterNexus{
+				Spec: nexusApi.ClusterNexusSpec{
+					Secret: "nexus-secret",
+				},
+			},
+			operatorNamespace: "operator",
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "nexus-secret",
+							Namespace: "operator",
+						},
+						Data: map[string][]byte{
+							"user":     []byte("user"),
+							"password": []byte("password"),
+						},
+					},
+				)
+			},
+			want:    require.NotNil,
+			wantErr: require.NoError,
+		},
+		{
+			name: "operator namespace is not defined",
+			clusterNexus: &nexusApi.ClusterNexus{
+				Spec: nexusApi.ClusterNexusSpec{
+					Secret: "nexus-secret",
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t)
+			},
+			want: require.Nil,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "operator namespace is not defined")
+			},
+		},
+		{
+			name: "secret is not in the operator namespace",
+			clusterNexus: &nexusApi.ClusterNexus{
+				Spec: nexusApi.ClusterNexusSpec{
+					Secret: "nexus-secret",
+				},
+			},
+			operatorNamespace: "operator",
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "nexus-secret",
+							Namespace: "default",
+						},
+						Data: map[string][]byte{
+							"user":     []byte("user"),
+							"password": []byte("password"),
+						},
+					},
+				)
+			},
+			want: require.Nil,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get nexus secret")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &ApiClientProvider{k8sClient: tt.k8sClient(t), operatorNamespace: tt.operatorNamespace}
+			got, err := p.GetNexusApiClientFromClusterNexus(context.Background(), tt.clusterNexus)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestApiClientProvider_ClientsCache(t *testing.T) {
+	t.Parallel()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "nexus-secret", Namespace: "default"},
+		Data: map[string][]byte{
+			"user":     []byte("user"),
+			"password": []byte("password"),
+		},
+	}
+	nexus := &nexusApi.Nexus{
+		ObjectMeta: metav1.ObjectMeta{Name: "nexus", Namespace: "default", UID: "nexus-uid"},
+		Spec: nexusApi.NexusSpec{
+			Secret: "nexus-secret",
+			Url:    "http://nexus",
+		},
+	}
+	k8sClient := buildTestK8sClient(t, secret, nexus)
+	p := NewApiClientProvider(k8sClient)
+	ctx := context.Background()
+	ref := &nexusApi.NexusUser{Spec: nexusApi.NexusUserSpec{NexusRef: common.NexusRef{Name: "nexus"}}}
+
+	apiClient, err := p.GetNexusApiClientFromNexusRef(ctx, "default", ref)
+	require.NoError(t, err)
+
+	repoClient, err := p.GetNexusRepositoryClientFromNexusRef(ctx, "default", ref)
+	require.NoError(t, err)
+
+	apiClientFromNexus, err := p.GetNexusApiClientFromNexus(ctx, nexus)
+	require.NoError(t, err)
+	require.Same(t, apiClient, apiClientFromNexus, "clients should be reused for the same Nexus")
+
+	sameRepoClient, err := p.GetNexusRepositoryClientFromNexusRef(ctx, "default", ref)
+	require.NoError(t, err)
+	require.Same(t, repoClient, sameRepoClient, "clients should be reused for the same Nexus")
+	require.Same(t, repoClient.client.GetClient(), p.clients[client.ObjectKeyFromObject(nexus)].httpClient,
+		"clients of the same Nexus should share http client")
+
+	secret.Data["password"] = []byte("new-password")
+	require.NoError(t, k8sClient.Update(ctx, secret))
+
+	newApiClient, err := p.GetNexusApiClientFromNexusRef(ctx, "default", ref)
+	require.NoError(t, err)
+	require.NotSame(t, apiClient, newApiClient, "clients should be rebuilt when the Secret is changed")
+
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(nexus), nexus))
+	nexus.Generation++
+	nexus.Spec.Url = "http://new-nexus"
+	require.NoError(t, k8sClient.Update(ctx, nexus))
+
+	newRepoClient, err := p.GetNexusRepositoryClientFromNexusRef(ctx, "default", ref)
+	require.NoError(t, err)
+	require.NotSame(t, repoClient, newRepoClient, "clients should be rebuilt when the Nexus is changed")
+	require.Equal(t, "http://new-nexus", newRepoClient.client.BaseURL)
+	require.Len(t, p.clients, 1)
+
+	require.NoError(t, k8sClient.Delete(ctx, nexus))
+	nexus.ResourceVersion = ""
+	nexus.UID = "recreated-nexus-uid"
+	require.NoError(t, k8sClient.Create(ctx, nexus))
+
+	recreatedRepoClient, err := p.GetNexusRepositoryClientFromNexusRef(ctx, "default", ref)
+	require.NoError(t, err)
+	require.NotSame(t, newRepoClient, recreatedRepoClient, "clients should be rebuilt when the Nexus is recreated")
+}
+
+func TestApiClientProvider_EvictClients(t *testing.T) {
+	t.Parallel()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "nexus-secret", Namespace: "default"},
+		Data: map[string][]byte{
+			"user":     []byte("user"),
+			"password": []byte("password"),
+		},
+	}
+	nexus := &nexusApi.Nexus{
+		ObjectMeta: metav1.ObjectMeta{Name: "nexus", Namespace: "default", UID: "nexus-uid"},
+		Spec: nexusApi.NexusSpec{
+			Secret: "nexus-secret",
+			Url:    "http://nexus",
+		},
+	}
+	clusterNexus := &nexusApi.ClusterNexus{
+		ObjectMeta: metav1.ObjectMeta{Name: "nexus", UID: "cluster-nexus-uid"},
+		Spec: nexusApi.ClusterNexusSpec{
+			Secret: "nexus-secret",
+			Url:    "http://cluster-nexus",
+		},
+	}
+	p := NewApiClientProvider(buildTestK8sClient(t, secret, nexus, clusterNexus))
+	p.operatorNamespace = "default"
+	ctx := context.Background()
+
+	_, err := p.GetNexusApiClientFromNexus(ctx, nexus)
+	require.NoError(t, err)
+
+	_, err = p.GetNexusApiClientFromClusterNexus(ctx, clusterNexus)
+	require.NoError(t, err)
+	require.Len(t, p.clients, 2)
+
+	p.EvictNexusClients("default", "nexus")
+	require.Len(t, p.clients, 1)
+	require.Contains(t, p.clients, client.ObjectKeyFromObject(clusterNexus), "only clients of the Nexus should be evicted")
+
+	p.EvictClusterNexusClients("nexus")
+	require.Empty(t, p.clients)
+
+	p.EvictNexusClients("default", "missing")
+	require.Empty(t, p.clients)
+}
@@ -0,0 +1,41 @@
+package nexus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/go-resty/resty/v2"
+)
+
+// RealmClient reads security realms of nexus.
+// Requests are bound by the context and are not retried, so it can be used where the time is limited,
+// e.g. in admission webhooks.
+type RealmClient struct {
+	client *resty.Client
+}
+
+func NewRealmClient(config ClientConfig) *RealmClient {
+	return &RealmClient{client: newRestyClient(config)}
+}
+
+// ListAvailable returns realms that can be activated in nexus.
+func (s *RealmClient) ListAvailable(ctx context.Context) ([]security.Realm, error) {
+	var realms []security.Realm
+
+	resp, err := s.client.
+		R().
+		ForceContentType("application/json").
+		SetContext(withoutRetries(ctx)).
+		SetResult(&realms).
+		Get("/service/rest/v1/security/realms/available")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get available realms: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("failed to get available realms: %w", NewAPIError(resp.StatusCode(), resp.Body()))
+	}
+
+	return realms, nil
+}
@@ -0,0 +1,96 @@
+package nexus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRealmClient_ListAvailable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		handler   http.HandlerFunc
+		timeout   time.Duration
+		want      []security.Realm
+		wantCalls int32
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "realms are returned",
+			handler: func(rw http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/service/rest/v1/security/realms/available", r.URL.Path)
+
+				_, _ = rw.Write([]byte(`[{"id":"NexusAuthenticatingRealm","name":"Local Authenticating Realm"}]`))
+			},
+			want:      []security.Realm{{ID: "NexusAuthenticatingRealm", Name: "Local Authenticating Realm"}},
+			wantCalls: 1,
+			wantErr:   require.NoError,
+		},
+		{
+			name: "unavailable nexus is not retried",
+			handler: func(rw http.ResponseWriter, _ *http.Request) {
+				rw.WriteHeader(http.StatusServiceUnavailable)
+			},
+			wantCalls: 1,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrServer)
+			},
+		},
+		{
+			name: "request is bound by the context",
+			handler: func(_ http.ResponseWriter, r *http.Request) {
+				<-r.Context().Done()
+			},
+			timeout:   100 * time.Millisecond,
+			wantCalls: 1,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, context.DeadlineExceeded)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				tt.handler(rw, r)
+			}))
+			defer server.Close()
+
+			ctx := context.Background()
+
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			c := NewRealmClient(ClientConfig{
+				BaseURL: server.URL,
+				HTTPClient: &http.Client{
+					Transport: newRetryTransport(http.DefaultTransport),
+				},
+			})
+
+			got, err := c.ListAvailable(ctx)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCalls, calls.Load())
+		})
+	}
+}
@@ -2,30 +2,31 @@ package nexus
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/go-resty/resty/v2"
 )
 
-var ErrNotFound = errors.New("not found")
-
 type ClientConfig struct {
 	BaseURL  string
 	UserName string
 	Password string
+	// HTTPClient is used to send requests if it is set.
+	// It allows clients of the same Nexus instance to share connections and TLS settings.
+	HTTPClient *http.Client
 }
 
 type RepoClient struct {
-	config ClientConfig
+	client *resty.Client
 }
 
 func NewRepoClient(config ClientConfig) *RepoClient {
-	return &RepoClient{config: config}
+	return &RepoClient{client: newRestyClient(config)}
 }
 
-func (s *RepoClient) Get(ctx context.Context, id, format, repoType string) (interface{}, error) {
+// Get returns the repository as it is returned by nexus api.
+func (s *RepoClient) Get(ctx context.Context, id, format, repoType string) (map[string]interface{}, error) {
 	res := map[string]interface{}{}
 
 	resp, err := s.r(ctx).
@@ -42,11 +43,7 @@ func (s *RepoClient) Get(ctx context.Context, id, format, repoType string) (inte
 	}
 
 	if resp.IsError() {
-		if resp.StatusCode() == http.StatusNotFound {
-			return nil, fmt.Errorf("repository %s %w: %s", id, ErrNotFound, resp.String())
-		}
-
-		return nil, fmt.Errorf("failed to get repository: %s", resp.String())
+		return nil, fmt.Errorf("failed to get repository: %w", NewAPIError(resp.StatusCode(), resp.Body()))
 	}
 
 	return res, nil
@@ -66,7 +63,7 @@ func (s *RepoClient) Create(ctx context.Context, format, repoType string, data i
 	}
 
 	if resp.IsError() {
-		return fmt.Errorf("failed to create repository: %s", resp.String())
+		return fmt.Errorf("failed to create repository: %w", NewAPIError(resp.StatusCode(), resp.Body()))
 	}
 
 	return nil
@@ -87,7 +84,7 @@ func (s *RepoClient) Update(ctx context.Context, id, format, repoType string, da
 	}
 
 	if resp.IsError() {
-		return fmt.Errorf("failed to update repository: %s", resp.String())
+		return fmt.Errorf("failed to update repository: %w", NewAPIError(resp.StatusCode(), resp.Body()))
 	}
 
 	return nil
@@ -105,21 +102,27 @@ func (s *RepoClient) Delete(ctx context.Context, id string) error {
 	}
 
 	if resp.IsError() {
-		if resp.StatusCode() == http.StatusNotFound {
-			return fmt.Errorf("repository %s %w: %s", id, ErrNotFound, resp.String())
-		}
-
-		return fmt.Errorf("failed to delete repository: %s", resp.String())
+		return fmt.Errorf("failed to delete repository: %w", NewAPIError(resp.StatusCode(), resp.Body()))
 	}
 
 	return nil
 }
 
 func (s *RepoClient) r(ctx context.Context) *resty.Request {
-	return resty.New().
-		SetBaseURL(s.config.BaseURL).
-		SetBasicAuth(s.config.UserName, s.config.Password).
+	return s.client.
 		R().
 		ForceContentType("application/json").
 		SetContext(ctx)
 }
+
+// newRestyClient returns resty client configured to work with the Nexus instance.
+func newRestyClient(config ClientConfig) *resty.Client {
+	c := resty.New()
+	if config.HTTPClient != nil {
+		c = resty.NewWithClient(config.HTTPClient)
+	}
+
+	return c.
+		SetBaseURL(config.BaseURL).
+		SetBasicAuth(config.UserName, config.Password)
+}
@@ -36,7 +36,7 @@ func TestRepoClient_Get(t *testing.T) {
 	tests := []struct {
 		name    string
 		id      string
-		want    interface{}
+		want    map[string]interface{}
 		wantErr require.ErrorAssertionFunc
 	}{
 		{
@@ -0,0 +1,64 @@
+package nexus
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	nexus3client "github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	securityService "github.com/datadrivers/go-nexus-client/nexus3/pkg/security"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+)
+
+// RoleClient manages nexus roles.
+// go-nexus-client can't list roles of a user source, e.g. LDAP groups, so the request is made here.
+type RoleClient struct {
+	service *securityService.SecurityRoleService
+}
+
+func NewRoleClient(service *securityService.SecurityRoleService) *RoleClient {
+	return &RoleClient{service: service}
+}
+
+// Get returns the role by id.
+func (c *RoleClient) Get(id string) (*security.Role, error) {
+	return c.service.Get(id)
+}
+
+// Create creates the role.
+func (c *RoleClient) Create(role security.Role) error {
+	return c.service.Create(role)
+}
+
+// Update updates the role by id.
+func (c *RoleClient) Update(id string, role security.Role) error {
+	return c.service.Update(id, role)
+}
+
+// Delete deletes the role by id.
+func (c *RoleClient) Delete(id string) error {
+	return c.service.Delete(id)
+}
+
+// ListBySource returns roles of the user source, e.g. groups of LDAP servers for the LDAP source.
+func (c *RoleClient) ListBySource(source string) ([]security.Role, error) {
+	body, resp, err := c.service.Client.Get(
+		fmt.Sprintf("%sv1/security/roles?source=%s", nexus3client.BasePath, url.QueryEscape(source)),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles of source %s: %w", source, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get roles of source %s: %w", source, NewAPIError(resp.StatusCode, body))
+	}
+
+	var roles []security.Role
+	if err = json.Unmarshal(body, &roles); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal roles: %w", err)
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,70 @@
+package nexus
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3"
+	nexus3client "github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleClient_ListBySource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		response   string
+		want       []security.Role
+		wantErr    require.ErrorAssertionFunc
+	}{
+		{
+			name:       "LDAP groups",
+			statusCode: http.StatusOK,
+			response:   `[{"id":"developers","source":"LDAP","name":"developers","roles":[],"privileges":[]}]`,
+			want: []security.Role{
+				{ID: "developers", Name: "developers", Roles: []string{}, Privileges: []string{}},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:       "unknown source",
+			statusCode: http.StatusBadRequest,
+			response:   `[{"id":"*","message":"Source 'LDAP' not found"}]`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to get roles of source LDAP")
+				require.ErrorIs(t, err, ErrValidation)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotPath, gotSource string
+
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				gotSource = r.URL.Query().Get("source")
+
+				rw.WriteHeader(tt.statusCode)
+				_, _ = rw.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			c := NewRoleClient(nexus3.NewClient(nexus3client.Config{URL: server.URL}).Security.Role)
+
+			got, err := c.ListBySource("LDAP")
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, "/service/rest/v1/security/roles", gotPath)
+			assert.Equal(t, "LDAP", gotSource)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package nexus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// serverHeaderRegexp parses the Server header of nexus responses, e.g. "Nexus/3.61.0-02 (OSS)".
+var serverHeaderRegexp = regexp.MustCompile(`Nexus/(\S+)(?:\s+\((\w+)\))?`)
+
+// SystemStatus contains information about the nexus node.
+type SystemStatus struct {
+	// Version is the nexus version, e.g. 3.61.0-02.
+	Version string
+	// Edition is the nexus edition, e.g. OSS or PRO.
+	Edition string
+	// NodeID is the identifier of the nexus node. It is empty if nexus doesn't provide it.
+	NodeID string
+	// ReadOnly is true if the node doesn't accept write requests.
+	ReadOnly bool
+	// HealthChecks contains results of the system health checks by check name.
+	// It is nil if the user doesn't have permissions to read them.
+	HealthChecks map[string]HealthCheck
+}
+
+// HealthCheck is a result of the nexus system health check.
+type HealthCheck struct {
+	Healthy bool   `json:"healthy"`
+	Message string `json:"message"`
+}
+
+type nodeInformation struct {
+	NodeID string `json:"nodeId"`
+}
+
+// StatusClient reads status of the nexus node.
+type StatusClient struct {
+	client *resty.Client
+}
+
+func NewStatusClient(config ClientConfig) *StatusClient {
+	return &StatusClient{client: newRestyClient(config)}
+}
+
+// GetStatus returns status of the nexus node.
+func (s *StatusClient) GetStatus(ctx context.Context) (*SystemStatus, error) {
+	status := &SystemStatus{}
+
+	// writable endpoint returns 503 if the node is read-only, so the request must not be retried
+	resp, err := s.r(withoutRetries(ctx)).
+		Get("/service/rest/v1/status/writable")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nexus writable status: %w", err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	case http.StatusServiceUnavailable:
+		status.ReadOnly = true
+	default:
+		return nil, fmt.Errorf("failed to get nexus writable status: %w", NewAPIError(resp.StatusCode(), resp.Body()))
+	}
+
+	if m := serverHeaderRegexp.FindStringSubmatch(resp.Header().Get("Server")); m != nil {
+		status.Version = m[1]
+		status.Edition = m[2]
+	}
+
+	if status.NodeID, err = s.getNodeID(ctx); err != nil {
+		return nil, err
+	}
+
+	if status.HealthChecks, err = s.getHealthChecks(ctx); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (s *StatusClient) getNodeID(ctx context.Context) (string, error) {
+	node := &nodeInformation{}
+
+	resp, err := s.r(ctx).
+		SetResult(node).
+		Get("/service/rest/v1/system/node")
+	if err != nil {
+		return "", fmt.Errorf("failed to get nexus node information: %w", err)
+	}
+
+	if resp.IsError() {
+		apiErr := NewAPIError(resp.StatusCode(), resp.Body())
+
+		// node information is not available in old nexus versions or without permissions
+		if errors.Is(apiErr, ErrNotFound) || errors.Is(apiErr, ErrUnauthorized) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("failed to get nexus node information: %w", apiErr)
+	}
+
+	return node.NodeID, nil
+}
+
+func (s *StatusClient) getHealthChecks(ctx context.Context) (map[string]HealthCheck, error) {
+	checks := map[string]HealthCheck{}
+
+	resp, err := s.r(ctx).
+		SetResult(&checks).
+		Get("/service/rest/v1/status/check")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nexus health checks: %w", err)
+	}
+
+	if resp.IsError() {
+		apiErr := NewAPIError(resp.StatusCode(), resp.Body())
+
+		// health checks require nx-metrics-all privilege
+		if errors.Is(apiErr, ErrUnauthorized) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get nexus health checks: %w", apiErr)
+	}
+
+	return checks, nil
+}
+
+func (s *StatusClient) r(ctx context.Context) *resty.Request {
+	return s.client.
+		R().
+		ForceContentType("application/json").
+		SetContext(ctx)
+}
@@ -0,0 +1,116 @@
+package nexus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusClient_GetStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    *SystemStatus
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "writable nexus with health checks",
+			handler: func(rw http.ResponseWriter, r *http.Request) {
+				rw.Header().Set("Server", "Nexus/3.61.0-02 (OSS)")
+
+				switch r.URL.Path {
+				case "/service/rest/v1/status/writable":
+					rw.WriteHeader(http.StatusOK)
+				case "/service/rest/v1/system/node":
+					_, _ = rw.Write([]byte(`{"nodeId":"node-1"}`))
+				case "/service/rest/v1/status/check":
+					_, _ = rw.Write([]byte(`{
+						"Blob Stores":{"healthy":true,"message":"All blob stores are ready"},
+						"Available CPUs":{"healthy":false,"message":"The host system is allocating a maximum of 1 cores"}
+					}`))
+				}
+			},
+			want: &SystemStatus{
+				Version: "3.61.0-02",
+				Edition: "OSS",
+				NodeID:  "node-1",
+				HealthChecks: map[string]HealthCheck{
+					"Blob Stores":    {Healthy: true, Message: "All blob stores are ready"},
+					"Available CPUs": {Healthy: false, Message: "The host system is allocating a maximum of 1 cores"},
+				},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "read-only nexus without permissions to health checks",
+			handler: func(rw http.ResponseWriter, r *http.Request) {
+				rw.Header().Set("Server", "Nexus/3.70.1-02 (PRO)")
+
+				switch r.URL.Path {
+				case "/service/rest/v1/status/writable":
+					rw.WriteHeader(http.StatusServiceUnavailable)
+				case "/service/rest/v1/system/node":
+					rw.WriteHeader(http.StatusNotFound)
+				case "/service/rest/v1/status/check":
+					rw.WriteHeader(http.StatusForbidden)
+				}
+			},
+			want: &SystemStatus{
+				Version:  "3.70.1-02",
+				Edition:  "PRO",
+				ReadOnly: true,
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "failed to get health checks",
+			handler: func(rw http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/service/rest/v1/system/node":
+					_, _ = rw.Write([]byte(`{}`))
+				case "/service/rest/v1/status/check":
+					rw.WriteHeader(http.StatusMethodNotAllowed)
+				}
+			},
+			want: nil,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get nexus health checks")
+			},
+		},
+		{
+			name: "unauthorized",
+			handler: func(rw http.ResponseWriter, r *http.Request) {
+				rw.WriteHeader(http.StatusUnauthorized)
+			},
+			want: nil,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrUnauthorized)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			c := NewStatusClient(ClientConfig{
+				BaseURL:    server.URL,
+				HTTPClient: &http.Client{Transport: newTestRetryTransport()},
+			})
+
+			got, err := c.GetStatus(context.Background())
+
+			tt.wantErr(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
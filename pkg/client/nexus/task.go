@@ -0,0 +1,207 @@
+package nexus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Task is a scheduled task returned by nexus api.
+// Nexus doesn't return properties and schedule of the task.
+type Task struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Type          string     `json:"type"`
+	Message       string     `json:"message,omitempty"`
+	CurrentState  string     `json:"currentState,omitempty"`
+	LastRunResult string     `json:"lastRunResult,omitempty"`
+	NextRun       *time.Time `json:"nextRun,omitempty"`
+	LastRun       *time.Time `json:"lastRun,omitempty"`
+}
+
+// TaskTemplate is a request to create or update a scheduled task.
+type TaskTemplate struct {
+	Type                  string            `json:"type"`
+	Name                  string            `json:"name"`
+	Enabled               bool              `json:"enabled"`
+	AlertEmail            string            `json:"alertEmail,omitempty"`
+	NotificationCondition string            `json:"notificationCondition,omitempty"`
+	Frequency             TaskFrequency     `json:"frequency"`
+	Properties            map[string]string `json:"properties,omitempty"`
+}
+
+// TaskFrequency is a schedule of the task.
+// StartDate is a unix time in seconds.
+type TaskFrequency struct {
+	Schedule       string `json:"schedule"`
+	StartDate      int64  `json:"startDate,omitempty"`
+	TimeZoneOffset string `json:"timeZoneOffset,omitempty"`
+	RecurringDays  []int  `json:"recurringDays,omitempty"`
+	CronExpression string `json:"cronExpression,omitempty"`
+}
+
+type taskList struct {
+	Items             []Task `json:"items"`
+	ContinuationToken string `json:"continuationToken"`
+}
+
+type taskCreated struct {
+	ID string `json:"id"`
+}
+
+// TaskClient manages nexus scheduled tasks.
+type TaskClient struct {
+	client *resty.Client
+}
+
+func NewTaskClient(config ClientConfig) *TaskClient {
+	return &TaskClient{client: newRestyClient(config)}
+}
+
+// Get returns the task by id.
+func (s *TaskClient) Get(ctx context.Context, id string) (*Task, error) {
+	res := &Task{}
+
+	resp, err := s.r(ctx).
+		SetPathParams(map[string]string{
+			"id": id,
+		}).
+		SetResult(res).
+		Get("/service/rest/v1/tasks/{id}")
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("failed to get task: %w", NewAPIError(resp.StatusCode(), resp.Body()))
+	}
+
+	return res, nil
+}
+
+// List returns all tasks of the given type. All tasks are returned if the type is empty.
+func (s *TaskClient) List(ctx context.Context, taskType string) ([]Task, error) {
+	var (
+		tasks             []Task
+		continuationToken string
+	)
+
+	for {
+		res := &taskList{}
+		req := s.r(ctx).SetResult(res)
+
+		if taskType != "" {
+			req.SetQueryParam("type", taskType)
+		}
+
+		if continuationToken != "" {
+			req.SetQueryParam("continuationToken", continuationToken)
+		}
+
+		resp, err := req.Get("/service/rest/v1/tasks")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tasks: %w", err)
+		}
+
+		if resp.IsError() {
+			return nil, fmt.Errorf("failed to list tasks: %w", NewAPIError(resp.StatusCode(), resp.Body()))
+		}
+
+		tasks = append(tasks, res.Items...)
+
+		if res.ContinuationToken == "" {
+			return tasks, nil
+		}
+
+		continuationToken = res.ContinuationToken
+	}
+}
+
+// Create creates the task and returns its id.
+func (s *TaskClient) Create(ctx context.Context, task *TaskTemplate) (string, error) {
+	res := &taskCreated{}
+
+	resp, err := s.r(ctx).
+		SetBody(task).
+		SetResult(res).
+		Post("/service/rest/v1/tasks")
+
+	if err != nil {
+		return "", fmt.Errorf("failed to create task: %w", err)
+	}
+
+	if resp.IsError() {
+		return "", fmt.Errorf("failed to create task: %w", NewAPIError(resp.StatusCode(), resp.Body()))
+	}
+
+	return res.ID, nil
+}
+
+// Update updates the task by id.
+func (s *TaskClient) Update(ctx context.Context, id string, task *TaskTemplate) error {
+	resp, err := s.r(ctx).
+		SetPathParams(map[string]string{
+			"id": id,
+		}).
+		SetBody(task).
+		Put("/service/rest/v1/tasks/{id}")
+
+	if err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("failed to update task: %w", NewAPIError(resp.StatusCode(), resp.Body()))
+	}
+
+	return nil
+}
+
+// Run starts the task by id.
+// Nexus starts the task asynchronously, so the result of the run should be checked with Get.
+func (s *TaskClient) Run(ctx context.Context, id string) error {
+	resp, err := s.r(ctx).
+		SetPathParams(map[string]string{
+			"id": id,
+		}).
+		Post("/service/rest/v1/tasks/{id}/run")
+
+	if err != nil {
+		return fmt.Errorf("failed to run task: %w", err)
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("failed to run task: %w", NewAPIError(resp.StatusCode(), resp.Body()))
+	}
+
+	return nil
+}
+
+// Delete deletes the task by id.
+func (s *TaskClient) Delete(ctx context.Context, id string) error {
+	resp, err := s.r(ctx).
+		SetPathParams(map[string]string{
+			"id": id,
+		}).
+		Delete("/service/rest/v1/tasks/{id}")
+
+	if err != nil {
+		return fmt.Errorf("failed to delete task: %w", err)
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("failed to delete task: %w", NewAPIError(resp.StatusCode(), resp.Body()))
+	}
+
+	return nil
+}
+
+func (s *TaskClient) r(ctx context.Context) *resty.Request {
+	return s.client.
+		R().
+		ForceContentType("application/json").
+		SetContext(ctx)
+}
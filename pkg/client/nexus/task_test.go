@@ -0,0 +1,325 @@
+package nexus
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskClient_Get(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		// nolint:errcheck // we can skip err here
+		switch req.URL.Path {
+		case "/service/rest/v1/tasks/task-success":
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(`{"id":"task-success","name":"compact","type":"blobstore.compact","currentState":"WAITING"}`))
+		case "/service/rest/v1/tasks/task-not-found":
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"res":"not found"}`))
+		case "/service/rest/v1/tasks/task-error":
+			rw.WriteHeader(http.StatusInternalServerError)
+			rw.Write([]byte(`{"res":"error"}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		id      string
+		want    *Task
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			id:   "task-success",
+			want: &Task{
+				ID:           "task-success",
+				Name:         "compact",
+				Type:         "blobstore.compact",
+				CurrentState: "WAITING",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "not found",
+			id:   "task-not-found",
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrNotFound)
+			},
+		},
+		{
+			name: "error",
+			id:   "task-error",
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get task")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewTaskClient(ClientConfig{BaseURL: server.URL})
+
+			got, err := s.Get(context.Background(), tt.id)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTaskClient_List(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "/service/rest/v1/tasks", req.URL.Path)
+		require.Equal(t, "blobstore.compact", req.URL.Query().Get("type"))
+
+		// nolint:errcheck // we can skip err here
+		switch req.URL.Query().Get("continuationToken") {
+		case "":
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(`{"items":[{"id":"1","name":"first","type":"blobstore.compact"}],"continuationToken":"next"}`))
+		case "next":
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(`{"items":[{"id":"2","name":"second","type":"blobstore.compact"}]}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	s := NewTaskClient(ClientConfig{BaseURL: server.URL})
+
+	got, err := s.List(context.Background(), "blobstore.compact")
+
+	require.NoError(t, err)
+	assert.Equal(t, []Task{
+		{ID: "1", Name: "first", Type: "blobstore.compact"},
+		{ID: "2", Name: "second", Type: "blobstore.compact"},
+	}, got)
+}
+
+func TestTaskClient_Create(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.Equal(t, http.MethodPost, req.Method)
+		require.Equal(t, "/service/rest/v1/tasks", req.URL.Path)
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+
+		task := &TaskTemplate{}
+		require.NoError(t, json.Unmarshal(body, task))
+
+		// nolint:errcheck // we can skip err here
+		switch task.Name {
+		case "success":
+			rw.WriteHeader(http.StatusCreated)
+			rw.Write([]byte(`{"id":"new-task-id"}`))
+		default:
+			rw.WriteHeader(http.StatusBadRequest)
+			rw.Write([]byte(`{"res":"error"}`))
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		taskName string
+		want     string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "success",
+			taskName: "success",
+			want:     "new-task-id",
+			wantErr:  require.NoError,
+		},
+		{
+			name:     "error",
+			taskName: "error",
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to create task")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewTaskClient(ClientConfig{BaseURL: server.URL})
+
+			got, err := s.Create(context.Background(), &TaskTemplate{
+				Type:      "blobstore.compact",
+				Name:      tt.taskName,
+				Enabled:   true,
+				Frequency: TaskFrequency{Schedule: "manual"},
+			})
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTaskClient_Update(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.Equal(t, http.MethodPut, req.Method)
+
+		// nolint:errcheck // we can skip err here
+		switch req.URL.Path {
+		case "/service/rest/v1/tasks/task-success":
+			rw.WriteHeader(http.StatusNoContent)
+		case "/service/rest/v1/tasks/task-error":
+			rw.WriteHeader(http.StatusInternalServerError)
+			rw.Write([]byte(`{"res":"error"}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		id      string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:    "success",
+			id:      "task-success",
+			wantErr: require.NoError,
+		},
+		{
+			name: "error",
+			id:   "task-error",
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to update task")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewTaskClient(ClientConfig{BaseURL: server.URL})
+
+			err := s.Update(context.Background(), tt.id, &TaskTemplate{
+				Type:      "blobstore.compact",
+				Name:      "compact",
+				Frequency: TaskFrequency{Schedule: "manual"},
+			})
+
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestTaskClient_Run(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.Equal(t, http.MethodPost, req.Method)
+
+		// nolint:errcheck // we can skip err here
+		switch req.URL.Path {
+		case "/service/rest/v1/tasks/task-success/run":
+			rw.WriteHeader(http.StatusNoContent)
+		case "/service/rest/v1/tasks/task-disabled/run":
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			rw.Write([]byte(`{"res":"task is disabled"}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		id      string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:    "success",
+			id:      "task-success",
+			wantErr: require.NoError,
+		},
+		{
+			name: "task is disabled",
+			id:   "task-disabled",
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to run task")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewTaskClient(ClientConfig{BaseURL: server.URL})
+
+			tt.wantErr(t, s.Run(context.Background(), tt.id))
+		})
+	}
+}
+
+func TestTaskClient_Delete(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.Equal(t, http.MethodDelete, req.Method)
+
+		// nolint:errcheck // we can skip err here
+		switch req.URL.Path {
+		case "/service/rest/v1/tasks/task-success":
+			rw.WriteHeader(http.StatusNoContent)
+		case "/service/rest/v1/tasks/task-not-found":
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"res":"not found"}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		id      string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:    "success",
+			id:      "task-success",
+			wantErr: require.NoError,
+		},
+		{
+			name: "not found",
+			id:   "task-not-found",
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewTaskClient(ClientConfig{BaseURL: server.URL})
+
+			tt.wantErr(t, s.Delete(context.Background(), tt.id))
+		})
+	}
+}
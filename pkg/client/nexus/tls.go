@@ -0,0 +1,154 @@
+package nexus
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/helper"
+)
+
+// IsTLSError checks if error is caused by a failed TLS handshake with nexus.
+func IsTLSError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var (
+		unknownAuthorityErr   x509.UnknownAuthorityError
+		certificateInvalidErr x509.CertificateInvalidError
+		hostnameErr           x509.HostnameError
+		verificationErr       *tls.CertificateVerificationError
+		recordHeaderErr       tls.RecordHeaderError
+		alertErr              tls.AlertError
+	)
+
+	return errors.As(err, &unknownAuthorityErr) ||
+		errors.As(err, &certificateInvalidErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &verificationErr) ||
+		errors.As(err, &recordHeaderErr) ||
+		errors.As(err, &alertErr)
+}
+
+// tlsMaterial contains raw TLS settings of the Nexus resource.
+type tlsMaterial struct {
+	ca                 []byte
+	cert               []byte
+	key                []byte
+	insecureSkipVerify bool
+}
+
+// getTLSMaterial reads TLS settings from the NexusTLS spec.
+// ConfigMaps and Secrets are looked up in the given namespace.
+// It returns nil if TLS settings are not specified.
+func (p *ApiClientProvider) getTLSMaterial(
+	ctx context.Context,
+	spec *nexusApi.NexusTLS,
+	namespace string,
+) (*tlsMaterial, error) {
+	if spec == nil {
+		return nil, nil
+	}
+
+	material := &tlsMaterial{
+		insecureSkipVerify: spec.InsecureSkipVerify,
+	}
+
+	if spec.CA != nil {
+		ca, err := helper.GetValueFromSourceRef(ctx, spec.CA, namespace, p.k8sClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get nexus CA bundle: %w", err)
+		}
+
+		material.ca = []byte(ca)
+	}
+
+	if spec.ClientCertSecret != "" {
+		secret := &corev1.Secret{}
+		if err := p.k8sClient.Get(ctx, types.NamespacedName{
+			Name:      spec.ClientCertSecret,
+			Namespace: namespace,
+		}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get nexus client certificate secret: %w", err)
+		}
+
+		material.cert = secret.Data[corev1.TLSCertKey]
+		material.key = secret.Data[corev1.TLSPrivateKeyKey]
+	}
+
+	return material, nil
+}
+
+// fingerprint returns a hash of the TLS settings that changes when any of them is changed.
+func (m *tlsMaterial) fingerprint() string {
+	if m == nil {
+		return ""
+	}
+
+	h := sha256.New()
+
+	for _, b := range [][]byte{m.ca, m.cert, m.key, []byte(strconv.FormatBool(m.insecureSkipVerify))} {
+		// write length prefix to avoid collisions between fields
+		h.Write([]byte(strconv.Itoa(len(b)) + ":"))
+		h.Write(b)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// tlsConfig builds TLS config from the TLS settings.
+// It returns nil if TLS settings are not specified.
+func (m *tlsMaterial) tlsConfig() (*tls.Config, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: m.insecureSkipVerify,
+	}
+
+	if m.ca != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(m.ca) {
+			return nil, errors.New("nexus CA bundle doesn't contain valid PEM certificates")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if m.cert != nil || m.key != nil {
+		cert, err := tls.X509KeyPair(m.cert, m.key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load nexus client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// newHTTPTransport returns http transport with the given TLS config.
+// If the TLS config is nil, the default TLS settings are used.
+func newHTTPTransport(tlsConfig *tls.Config) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return transport
+}
@@ -0,0 +1,382 @@
+package nexus
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datadrivers/go-nexus-client/nexus3"
+	nexus3client "github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+func newTestTLSServer(t *testing.T) (*httptest.Server, string) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		// nolint:errcheck // we can skip err here
+		json.NewEncoder(w).Encode([]map[string]string{})
+	}))
+	t.Cleanup(server.Close)
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	return server, string(ca)
+}
+
+func newTestClientCert(t *testing.T) (certPEM, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "nexus-operator"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func TestApiClientProvider_getTLSMaterial(t *testing.T) {
+	t.Parallel()
+
+	_, ca := newTestTLSServer(t)
+	cert, key := newTestClientCert(t)
+
+	tests := []struct {
+		name      string
+		spec      *nexusApi.NexusTLS
+		k8sClient func(t *testing.T) client.Client
+		want      func(t *testing.T, got *tlsConfigSummary)
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "tls settings are not specified",
+			spec: nil,
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t)
+			},
+			want: func(t *testing.T, got *tlsConfigSummary) {
+				require.Nil(t, got)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "CA from ConfigMap and client certificate",
+			spec: &nexusApi.NexusTLS{
+				CA: &common.SourceRef{
+					ConfigMapKeyRef: &common.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "nexus-ca"},
+						Key:                  "ca.crt",
+					},
+				},
+				ClientCertSecret: "nexus-client-cert",
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t,
+					&corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "nexus-ca", Namespace: "default"},
+						Data:       map[string]string{"ca.crt": ca},
+					},
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "nexus-client-cert", Namespace: "default"},
+						Type:       corev1.SecretTypeTLS,
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       cert,
+							corev1.TLSPrivateKeyKey: key,
+						},
+					},
+				)
+			},
+			want: func(t *testing.T, got *tlsConfigSummary) {
+				require.NotNil(t, got)
+				require.True(t, got.hasRootCAs)
+				require.Equal(t, 1, got.certificates)
+				require.False(t, got.insecureSkipVerify)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "insecure skip verify",
+			spec: &nexusApi.NexusTLS{
+				InsecureSkipVerify: true,
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t)
+			},
+			want: func(t *testing.T, got *tlsConfigSummary) {
+				require.NotNil(t, got)
+				require.False(t, got.hasRootCAs)
+				require.True(t, got.insecureSkipVerify)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "invalid CA bundle",
+			spec: &nexusApi.NexusTLS{
+				CA: &common.SourceRef{
+					SecretKeyRef: &common.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "nexus-ca"},
+						Key:                  "ca.crt",
+					},
+				},
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "nexus-ca", Namespace: "default"},
+						Data:       map[string][]byte{"ca.crt": []byte("invalid")},
+					},
+				)
+			},
+			want: func(t *testing.T, got *tlsConfigSummary) {
+				require.Nil(t, got)
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "doesn't contain valid PEM certificates")
+			},
+		},
+		{
+			name: "client certificate secret not found",
+			spec: &nexusApi.NexusTLS{
+				ClientCertSecret: "nexus-client-cert",
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t)
+			},
+			want: func(t *testing.T, got *tlsConfigSummary) {
+				require.Nil(t, got)
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get nexus client certificate secret")
+			},
+		},
+		{
+			name: "invalid client certificate",
+			spec: &nexusApi.NexusTLS{
+				ClientCertSecret: "nexus-client-cert",
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return buildTestK8sClient(t,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "nexus-client-cert", Namespace: "default"},
+						Data: map[string][]byte{
+							corev1.TLSCertKey: cert,
+						},
+					},
+				)
+			},
+			want: func(t *testing.T, got *tlsConfigSummary) {
+				require.Nil(t, got)
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to load nexus client certificate")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &ApiClientProvider{k8sClient: tt.k8sClient(t)}
+
+			var got *tls.Config
+
+			material, err := p.getTLSMaterial(context.Background(), tt.spec, "default")
+			if err == nil {
+				got, err = material.tlsConfig()
+			}
+
+			tt.wantErr(t, err)
+
+			var summary *tlsConfigSummary
+			if got != nil {
+				summary = &tlsConfigSummary{
+					hasRootCAs:         got.RootCAs != nil,
+					certificates:       len(got.Certificates),
+					insecureSkipVerify: got.InsecureSkipVerify,
+				}
+			}
+
+			tt.want(t, summary)
+		})
+	}
+}
+
+type tlsConfigSummary struct {
+	hasRootCAs         bool
+	certificates       int
+	insecureSkipVerify bool
+}
+
+func TestApiClientProvider_TLSClients(t *testing.T) {
+	t.Parallel()
+
+	server, ca := newTestTLSServer(t)
+
+	objs := []client.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "nexus-secret", Namespace: "default"},
+			Data: map[string][]byte{
+				"user":     []byte("user"),
+				"password": []byte("password"),
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "nexus-ca", Namespace: "default"},
+			Data:       map[string]string{"ca.crt": ca},
+		},
+		&nexusApi.Nexus{
+			ObjectMeta: metav1.ObjectMeta{Name: "nexus", Namespace: "default"},
+			Spec: nexusApi.NexusSpec{
+				Secret: "nexus-secret",
+				Url:    server.URL,
+				TLS: &nexusApi.NexusTLS{
+					CA: &common.SourceRef{
+						ConfigMapKeyRef: &common.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "nexus-ca"},
+							Key:                  "ca.crt",
+						},
+					},
+				},
+			},
+		},
+		&nexusApi.Nexus{
+			ObjectMeta: metav1.ObjectMeta{Name: "nexus-default-tls", Namespace: "default"},
+			Spec: nexusApi.NexusSpec{
+				Secret: "nexus-secret",
+				Url:    server.URL,
+			},
+		},
+	}
+
+	p := NewApiClientProvider(buildTestK8sClient(t, objs...))
+	ctx := context.Background()
+	ref := &nexusApi.NexusUser{Spec: nexusApi.NexusUserSpec{NexusRef: common.NexusRef{Name: "nexus"}}}
+
+	apiClient, err := p.GetNexusApiClientFromNexusRef(ctx, "default", ref)
+	require.NoError(t, err)
+
+	_, err = apiClient.Security.User.Get("user")
+	require.NoError(t, err, "nexus api client should trust the custom CA")
+
+	repoClient, err := p.GetNexusRepositoryClientFromNexusRef(ctx, "default", ref)
+	require.NoError(t, err)
+
+	_, err = repoClient.r(ctx).Get("/service/rest/v1/repositories")
+	require.NoError(t, err, "repository client should trust the custom CA")
+
+	cleanupClient, err := p.GetNexusNexusCleanupPolicyClientFromNexusRef(ctx, "default", ref)
+	require.NoError(t, err)
+
+	_, err = cleanupClient.r(ctx).Get("/service/rest/v1/cleanup-policies")
+	require.NoError(t, err, "cleanup policy client should trust the custom CA")
+
+	defaultRef := &nexusApi.NexusUser{Spec: nexusApi.NexusUserSpec{NexusRef: common.NexusRef{Name: "nexus-default-tls"}}}
+
+	apiClient, err = p.GetNexusApiClientFromNexusRef(ctx, "default", defaultRef)
+	require.NoError(t, err)
+
+	_, err = apiClient.Security.User.Get("user")
+	require.Error(t, err)
+	require.True(t, IsTLSError(err), "unexpected error: %v", err)
+}
+
+func TestIsTLSError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "err is nil",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "unknown authority",
+			err:  &url.Error{Op: "Get", URL: "https://nexus", Err: x509.UnknownAuthorityError{}},
+			want: true,
+		},
+		{
+			name: "wrapped hostname error",
+			err:  fmt.Errorf("failed to connect: %w", x509.HostnameError{}),
+			want: true,
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, IsTLSError(tt.err))
+		})
+	}
+}
+
+func TestNexusClientHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	nexusClient := nexus3.NewClient(nexus3client.Config{
+		URL: server.URL,
+		HTTPClient: &http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				requests.Add(1)
+
+				return http.DefaultTransport.RoundTrip(req)
+			}),
+		},
+	})
+
+	_, err := nexusClient.Security.Realm.ListActive()
+	require.NoError(t, err)
+	require.Equal(t, int32(1), requests.Load(), "request should be sent by the configured http client")
+}
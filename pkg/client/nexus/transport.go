@@ -0,0 +1,211 @@
+package nexus
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	// defaultNexusClientTimeout is the same timeout go-nexus-client uses by default.
+	// It limits the request including retries.
+	defaultNexusClientTimeout = 30 * time.Second
+	defaultMaxRetries         = 3
+	defaultMinRetryWait       = 200 * time.Millisecond
+	defaultMaxRetryWait       = 3 * time.Second
+	maxErrorResponseBytes     = 64 * 1024
+)
+
+type noRetriesKey struct{}
+
+// withoutRetries returns context that disables retries of requests made with it.
+// It is used for requests where an error status is an expected answer.
+func withoutRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetriesKey{}, true)
+}
+
+// retryTransport retries requests that failed with transient errors using exponential backoff.
+// 429 and 503 responses and refused connections are retried for all requests because nexus didn't process them.
+// 500, 502 and 504 responses and connection resets are retried only for idempotent requests:
+// a proxy in front of nexus may return them when nexus has already processed the request,
+// so retrying POST requests may create objects or run tasks twice.
+// Network errors that are left after retries are wrapped into TransportError.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+}
+
+func newRetryTransport(next http.RoundTripper) *retryTransport {
+	return &retryTransport{
+		next:       next,
+		maxRetries: defaultMaxRetries,
+		minWait:    defaultMinRetryWait,
+		maxWait:    defaultMaxRetryWait,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attemptReq := req
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(attemptReq)
+
+		var nextReq *http.Request
+		if attempt < t.maxRetries && t.shouldRetry(req, resp, err) {
+			nextReq = cloneRequest(req)
+		}
+
+		if nextReq == nil {
+			if err != nil {
+				return nil, &TransportError{Err: err}
+			}
+
+			return resp, nil
+		}
+
+		attemptReq = nextReq
+
+		wait := t.backoff(attempt, resp)
+
+		if resp != nil {
+			// drain the body to reuse the connection
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorResponseBytes))
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+
+			return nil, &TransportError{Err: req.Context().Err()}
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if disabled, _ := req.Context().Value(noRetriesKey{}).(bool); disabled {
+		return false
+	}
+
+	if err != nil {
+		if req.Context().Err() != nil {
+			return false
+		}
+
+		if errors.Is(err, syscall.ECONNREFUSED) {
+			return true
+		}
+
+		return isIdempotent(req.Method) && errors.Is(err, syscall.ECONNRESET)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+// backoff returns the wait time before the next attempt.
+// Retry-After header is honored if it is present, but the wait time never exceeds maxWait.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, t.maxWait)
+		}
+	}
+
+	wait := t.minWait << attempt
+	if wait <= 0 || wait > t.maxWait {
+		wait = t.maxWait
+	}
+
+	// add jitter to avoid retrying many requests at the same time
+	return wait/2 + rand.N(wait/2+1)
+}
+
+// CloseIdleConnections closes idle connections of the underlying transport.
+func (t *retryTransport) CloseIdleConnections() {
+	if c, ok := t.next.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
+
+// errorTransport converts error responses of nexus into APIError.
+// go-nexus-client returns error responses as plain text errors without the status code,
+// so the response is converted before it reaches the client to keep the error type.
+type errorTransport struct {
+	next http.RoundTripper
+}
+
+func (t *errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < http.StatusBadRequest {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorResponseBytes))
+	if err != nil {
+		return nil, &TransportError{Err: err}
+	}
+
+	return nil, NewAPIError(resp.StatusCode, body)
+}
+
+// CloseIdleConnections closes idle connections of the underlying transport.
+func (t *errorTransport) CloseIdleConnections() {
+	if c, ok := t.next.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// cloneRequest returns a copy of the request that can be sent again.
+// It returns nil if the request body can't be read again.
+func cloneRequest(req *http.Request) *http.Request {
+	clone := req.Clone(req.Context())
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return clone
+	}
+
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+
+	clone.Body = body
+
+	return clone
+}
@@ -0,0 +1,249 @@
+package nexus
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRetryTransport() *retryTransport {
+	t := newRetryTransport(http.DefaultTransport)
+	t.minWait = time.Millisecond
+	t.maxWait = 5 * time.Millisecond
+
+	return t
+}
+
+func TestRetryTransport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		wantStatus   int
+		wantAttempts int32
+	}{
+		{
+			name:         "retry unavailable until success",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		{
+			name:         "retry POST on too many requests and unavailable",
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		{
+			name:         "retry GET on gateway timeout",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusGatewayTimeout, http.StatusBadGateway, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		{
+			name:         "don't retry POST on gateway timeout",
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusGatewayTimeout, http.StatusOK},
+			wantStatus:   http.StatusGatewayTimeout,
+			wantAttempts: 1,
+		},
+		{
+			name:         "don't retry POST on bad gateway",
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusBadGateway, http.StatusOK},
+			wantStatus:   http.StatusBadGateway,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retry PUT on internal server error",
+			method:       http.MethodPut,
+			statuses:     []int{http.StatusInternalServerError, http.StatusNoContent},
+			wantStatus:   http.StatusNoContent,
+			wantAttempts: 2,
+		},
+		{
+			name:         "don't retry POST on internal server error",
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusInternalServerError, http.StatusOK},
+			wantStatus:   http.StatusInternalServerError,
+			wantAttempts: 1,
+		},
+		{
+			name:         "don't retry client errors",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusBadRequest, http.StatusOK},
+			wantStatus:   http.StatusBadRequest,
+			wantAttempts: 1,
+		},
+		{
+			name:   "stop after max retries",
+			method: http.MethodGet,
+			statuses: []int{
+				http.StatusServiceUnavailable,
+				http.StatusServiceUnavailable,
+				http.StatusServiceUnavailable,
+				http.StatusServiceUnavailable,
+				http.StatusOK,
+			},
+			wantStatus:   http.StatusServiceUnavailable,
+			wantAttempts: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "payload", string(body), "body should be sent on every attempt")
+
+				n := attempts.Add(1)
+				w.WriteHeader(tt.statuses[n-1])
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader("payload"))
+			require.NoError(t, err)
+
+			resp, err := newTestRetryTransport().RoundTrip(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			require.Equal(t, tt.wantStatus, resp.StatusCode)
+			require.Equal(t, tt.wantAttempts, attempts.Load())
+		})
+	}
+}
+
+func TestRetryTransport_RoundTrip_TransportError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = newTestRetryTransport().RoundTrip(req)
+	require.ErrorIs(t, err, ErrTransport)
+	require.True(t, IsErrTransient(err))
+}
+
+func TestRetryTransport_RoundTrip_ConnectionRefused(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	var attempts atomic.Int32
+
+	tr := newTestRetryTransport()
+	tr.next = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts.Add(1)
+
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+
+	_, err = tr.RoundTrip(req)
+	require.ErrorIs(t, err, syscall.ECONNREFUSED)
+	require.Equal(t, int32(tr.maxRetries+1), attempts.Load(), "refused POST should be retried")
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryTransport_RoundTrip_ContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	tr := newTestRetryTransport()
+	tr.minWait = time.Minute
+	tr.maxWait = time.Minute
+
+	_, err = tr.RoundTrip(req)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	t.Parallel()
+
+	tr := newRetryTransport(http.DefaultTransport)
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := tr.backoff(attempt, nil)
+		require.Positive(t, wait)
+		require.LessOrEqual(t, wait, tr.maxWait)
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"1"}}}
+	require.Equal(t, time.Second, tr.backoff(0, resp))
+
+	resp.Header.Set("Retry-After", "120")
+	require.Equal(t, tr.maxWait, tr.backoff(0, resp))
+}
+
+func TestErrorTransport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`[{"id":"PARAMETER name","message":"must not be empty"}]`))
+		}
+	}))
+	defer server.Close()
+
+	c := &http.Client{Transport: &errorTransport{next: newTestRetryTransport()}}
+
+	resp, err := c.Get(server.URL + "/ok")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	_, err = c.Get(server.URL + "/missing")
+	require.True(t, IsErrNotFound(err))
+
+	_, err = c.Get(server.URL + "/invalid")
+	require.ErrorIs(t, err, ErrValidation)
+	require.True(t, IsErrPermanent(err))
+	require.Contains(t, err.Error(), "PARAMETER name: must not be empty")
+}
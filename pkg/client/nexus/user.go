@@ -0,0 +1,82 @@
+package nexus
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	nexus3client "github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	securityService "github.com/datadrivers/go-nexus-client/nexus3/pkg/security"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+)
+
+// UserClient manages nexus users.
+// go-nexus-client searches users in all sources, so users of a specific source are requested here.
+type UserClient struct {
+	service *securityService.SecurityUserService
+}
+
+func NewUserClient(service *securityService.SecurityUserService) *UserClient {
+	return &UserClient{service: service}
+}
+
+// Get returns the user by id. It returns nil if the user doesn't exist.
+func (c *UserClient) Get(id string) (*security.User, error) {
+	return c.service.Get(id)
+}
+
+// GetBySource returns the user of the user source by id, e.g. the LDAP user. It returns nil if the user doesn't exist.
+func (c *UserClient) GetBySource(id, source string) (*security.User, error) {
+	body, resp, err := c.service.Client.Get(
+		fmt.Sprintf(
+			"%sv1/security/users?userId=%s&source=%s",
+			nexus3client.BasePath,
+			url.QueryEscape(id),
+			url.QueryEscape(source),
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user of source %s: %w", source, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get user of source %s: %w", source, NewAPIError(resp.StatusCode, body))
+	}
+
+	var users []security.User
+	if err = json.Unmarshal(body, &users); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal users: %w", err)
+	}
+
+	// nexus searches users by id prefix
+	for i := range users {
+		if users[i].UserID == id {
+			return &users[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Create creates the user of the default source.
+func (c *UserClient) Create(user security.User) error {
+	return c.service.Create(user)
+}
+
+// Update updates the user by id.
+// For users of external sources, nexus updates only roles of the user.
+func (c *UserClient) Update(id string, user security.User) error {
+	return c.service.Update(id, user)
+}
+
+// Delete deletes the user by id.
+func (c *UserClient) Delete(id string) error {
+	return c.service.Delete(id)
+}
+
+// ChangePassword changes the password of the user of the default source.
+func (c *UserClient) ChangePassword(id, password string) error {
+	return c.service.ChangePassword(id, password)
+}
@@ -0,0 +1,83 @@
+package nexus
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3"
+	nexus3client "github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserClient_GetBySource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		response   string
+		want       *security.User
+		wantErr    require.ErrorAssertionFunc
+	}{
+		{
+			name:       "LDAP user",
+			statusCode: http.StatusOK,
+			response: `[
+				{"userId":"john.doe.admin","source":"LDAP","roles":[]},
+				{"userId":"john.doe","source":"LDAP","roles":["nx-developer"],"externalRoles":["developers"]}
+			]`,
+			want: &security.User{
+				UserID: "john.doe",
+				Source: "LDAP",
+				Roles:  []string{"nx-developer"},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:       "user doesn't exist",
+			statusCode: http.StatusOK,
+			response:   `[{"userId":"john.doe.admin","source":"LDAP","roles":[]}]`,
+			wantErr:    require.NoError,
+		},
+		{
+			name:       "unknown source",
+			statusCode: http.StatusNotFound,
+			response:   `"Source LDAP not found"`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to get user of source LDAP")
+				require.ErrorIs(t, err, ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotPath, gotUserID, gotSource string
+
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				gotUserID = r.URL.Query().Get("userId")
+				gotSource = r.URL.Query().Get("source")
+
+				rw.WriteHeader(tt.statusCode)
+				_, _ = rw.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			c := NewUserClient(nexus3.NewClient(nexus3client.Config{URL: server.URL}).Security.User)
+
+			got, err := c.GetBySource("john.doe", "LDAP")
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, "/service/rest/v1/security/users", gotPath)
+			assert.Equal(t, "john.doe", gotUserID)
+			assert.Equal(t, "LDAP", gotSource)
+		})
+	}
+}
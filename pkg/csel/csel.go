@@ -0,0 +1,248 @@
+// Package csel validates the syntax of Nexus content selector expressions (CSEL).
+//
+// CSEL is a subset of JEXL supported by Nexus for content selectors, e.g.
+//
+//	format == "maven2" and path =^ "/com/acme/teama/"
+//
+// An expression consists of comparisons of the format, path and coordinate.<name> identifiers
+// with string literals using the ==, !=, =~ (regex match) and =^ (starts with) operators.
+// Comparisons can be combined with and (&&), or (||) and grouped with parentheses.
+package csel
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenString
+	tokenOperator
+	tokenAnd
+	tokenOr
+	tokenLeftParen
+	tokenRightParen
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+var identifierRegexp = regexp.MustCompile(`^(format|path|coordinate\.[A-Za-z_][A-Za-z0-9_]*)$`)
+
+// Validate checks that the expression is a valid CSEL expression.
+// The error describes the position of the first syntax error.
+func Validate(expression string) error {
+	if strings.TrimSpace(expression) == "" {
+		return fmt.Errorf("expression is empty")
+	}
+
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return err
+	}
+
+	p := &parser{tokens: tokens}
+
+	if err = p.parseOr(); err != nil {
+		return err
+	}
+
+	if t := p.peek(); t.kind != tokenEOF {
+		return unexpectedToken(t)
+	}
+
+	return nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+
+	return t
+}
+
+// parseOr parses: and-expression { ( "or" | "||" ) and-expression }.
+func (p *parser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+
+	for p.peek().kind == tokenOr {
+		p.next()
+
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseAnd parses: term { ( "and" | "&&" ) term }.
+func (p *parser) parseAnd() error {
+	if err := p.parseTerm(); err != nil {
+		return err
+	}
+
+	for p.peek().kind == tokenAnd {
+		p.next()
+
+		if err := p.parseTerm(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseTerm parses: "(" or-expression ")" | identifier operator string.
+func (p *parser) parseTerm() error {
+	t := p.next()
+
+	switch t.kind {
+	case tokenLeftParen:
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+
+		if closing := p.next(); closing.kind != tokenRightParen {
+			return fmt.Errorf("missing closing parenthesis for the one at position %d", t.pos)
+		}
+
+		return nil
+	case tokenIdentifier:
+		if !identifierRegexp.MatchString(t.value) {
+			return fmt.Errorf("unknown identifier %q at position %d, allowed identifiers are format, path and coordinate.<name>",
+				t.value, t.pos)
+		}
+
+		if op := p.next(); op.kind != tokenOperator {
+			return fmt.Errorf("expected operator after %q at position %d", t.value, op.pos)
+		}
+
+		if value := p.next(); value.kind != tokenString {
+			return fmt.Errorf("expected string literal at position %d", value.pos)
+		}
+
+		return nil
+	default:
+		return unexpectedToken(t)
+	}
+}
+
+func unexpectedToken(t token) error {
+	if t.kind == tokenEOF {
+		return fmt.Errorf("unexpected end of expression")
+	}
+
+	return fmt.Errorf("unexpected %q at position %d", t.value, t.pos)
+}
+
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(expression); {
+		c := expression[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, value: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, value: ")", pos: i})
+			i++
+		case c == '"' || c == '\'':
+			end, err := stringEnd(expression, i)
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, token{kind: tokenString, value: expression[i : end+1], pos: i})
+			i = end + 1
+		case strings.HasPrefix(expression[i:], "&&"):
+			tokens = append(tokens, token{kind: tokenAnd, value: "&&", pos: i})
+			i += 2
+		case strings.HasPrefix(expression[i:], "||"):
+			tokens = append(tokens, token{kind: tokenOr, value: "||", pos: i})
+			i += 2
+		case c == '=' || c == '!':
+			op := operatorAt(expression, i)
+			if op == "" {
+				return nil, fmt.Errorf("unknown operator at position %d, allowed operators are ==, !=, =~ and =^", i)
+			}
+
+			tokens = append(tokens, token{kind: tokenOperator, value: op, pos: i})
+			i += len(op)
+		case isIdentifierChar(c):
+			start := i
+			for i < len(expression) && (isIdentifierChar(expression[i]) || expression[i] == '.') {
+				i++
+			}
+
+			word := expression[start:i]
+
+			switch word {
+			case "and":
+				tokens = append(tokens, token{kind: tokenAnd, value: word, pos: start})
+			case "or":
+				tokens = append(tokens, token{kind: tokenOr, value: word, pos: start})
+			default:
+				tokens = append(tokens, token{kind: tokenIdentifier, value: word, pos: start})
+			}
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(expression)}), nil
+}
+
+// stringEnd returns the position of the closing quote of the string literal started at start.
+func stringEnd(expression string, start int) (int, error) {
+	quote := expression[start]
+
+	for i := start + 1; i < len(expression); i++ {
+		switch expression[i] {
+		case '\\':
+			i++
+		case quote:
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unterminated string literal at position %d", start)
+}
+
+func operatorAt(expression string, i int) string {
+	for _, op := range []string{"==", "!=", "=~", "=^"} {
+		if strings.HasPrefix(expression[i:], op) {
+			return op
+		}
+	}
+
+	return ""
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,121 @@
+package csel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    require.ErrorAssertionFunc
+	}{
+		{
+			name:       "format and path",
+			expression: `format == "maven2" and path =^ "/com/acme/teama/"`,
+			wantErr:    require.NoError,
+		},
+		{
+			name:       "grouped expressions with single quotes",
+			expression: `format == 'npm' and (path =~ '^/@acme/.*' or coordinate.packageScope == 'acme')`,
+			wantErr:    require.NoError,
+		},
+		{
+			name:       "not equal and escaped quote",
+			expression: `format != "docker" or path == "/a\"b"`,
+			wantErr:    require.NoError,
+		},
+		{
+			name:       "symbolic logical operators",
+			expression: `format == "maven2" && path =^ "/com/acme/"`,
+			wantErr:    require.NoError,
+		},
+		{
+			name:       "mixed logical operators",
+			expression: `format == "npm" && (path =^ "/@acme/"||coordinate.packageScope == "acme") or format == "raw"`,
+			wantErr:    require.NoError,
+		},
+		{
+			name:       "empty expression",
+			expression: "  ",
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "expression is empty")
+			},
+		},
+		{
+			name:       "unknown identifier",
+			expression: `repository == "maven-releases"`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, `unknown identifier "repository" at position 0`)
+			},
+		},
+		{
+			name:       "unknown operator",
+			expression: `path = "/com/acme/"`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "unknown operator at position 5")
+			},
+		},
+		{
+			name:       "missing value",
+			expression: `format == and path =^ "/com"`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "expected string literal at position 10")
+			},
+		},
+		{
+			name:       "unterminated string",
+			expression: `format == "maven2`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "unterminated string literal at position 10")
+			},
+		},
+		{
+			name:       "missing closing parenthesis",
+			expression: `(format == "maven2" or format == "npm"`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "missing closing parenthesis for the one at position 0")
+			},
+		},
+		{
+			name:       "dangling operator",
+			expression: `format == "maven2" and`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "unexpected end of expression")
+			},
+		},
+		{
+			name:       "dangling symbolic operator",
+			expression: `format == "maven2" ||`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "unexpected end of expression")
+			},
+		},
+		{
+			name:       "single ampersand",
+			expression: `format == "maven2" & path =^ "/com"`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, `unexpected character '&' at position 19`)
+			},
+		},
+		{
+			name:       "unexpected token after expression",
+			expression: `format == "maven2" "npm"`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, `unexpected "\"npm\"" at position 19`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.wantErr(t, Validate(tt.expression))
+		})
+	}
+}
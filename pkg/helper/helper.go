@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -14,9 +15,10 @@ import (
 )
 
 const (
-	WatchNamespaceEnvVar   = "WATCH_NAMESPACE"
-	debugModeEnvVar        = "DEBUG_MODE"
-	inClusterNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+	WatchNamespaceEnvVar    = "WATCH_NAMESPACE"
+	OperatorNamespaceEnvVar = "OPERATOR_NAMESPACE"
+	debugModeEnvVar         = "DEBUG_MODE"
+	inClusterNamespacePath  = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
 )
 
 // GetWatchNamespace returns the namespace the operator should be watching for changes.
@@ -25,6 +27,23 @@ func GetWatchNamespace() string {
 	return os.Getenv(WatchNamespaceEnvVar)
 }
 
+// GetOperatorNamespace returns the namespace where the operator is deployed.
+// Cluster-scoped resources, e.g. ClusterNexus, keep their Secrets in this namespace.
+// If the environment variable is not set, the namespace of the service account is used.
+// If the operator is running outside the cluster and the value is not set, it returns an empty string.
+func GetOperatorNamespace() string {
+	if ns := os.Getenv(OperatorNamespaceEnvVar); ns != "" {
+		return ns
+	}
+
+	ns, err := os.ReadFile(inClusterNamespacePath)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(ns))
+}
+
 // GetDebugMode returns the debug mode value.
 func GetDebugMode() (bool, error) {
 	mode, found := os.LookupEnv(debugModeEnvVar)
@@ -46,6 +46,37 @@ func TestGetWatchNamespace(t *testing.T) {
 	}
 }
 
+func TestGetOperatorNamespace(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T)
+		want    string
+	}{
+		{
+			name: "namespace is set",
+			prepare: func(t *testing.T) {
+				t.Setenv(OperatorNamespaceEnvVar, "operator")
+			},
+			want: "operator",
+		},
+		{
+			name:    "namespace is not set",
+			prepare: func(t *testing.T) {},
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare(t)
+
+			got := GetOperatorNamespace()
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestGetDebugMode(t *testing.T) {
 	tests := []struct {
 		name    string
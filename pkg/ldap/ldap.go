@@ -0,0 +1,127 @@
+// Package ldap verifies connections to LDAP servers.
+//
+// The connection is verified with the LDAPv3 simple bind,
+// which is enough to check that the server is reachable and accepts the bind credentials,
+// the same way Nexus verifies the connection of the LDAP server.
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+const (
+	// ProtocolLdap is a plain LDAP connection.
+	ProtocolLdap = "ldap"
+	// ProtocolLdaps is an LDAP connection over TLS.
+	ProtocolLdaps = "ldaps"
+
+	defaultTimeout = 30 * time.Second
+)
+
+// ErrInvalidCredentials is returned when the server rejects the bind credentials.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// Connection contains the settings of the connection to the LDAP server.
+type Connection struct {
+	// Protocol is ldap or ldaps.
+	Protocol string
+	Host     string
+	Port     int32
+	// BindDN is the user to bind with. Anonymous bind is used if it is empty.
+	BindDN   string
+	Password string
+	// Timeout limits the whole verification. Default is 30 seconds.
+	Timeout time.Duration
+}
+
+// Address returns the host:port address of the server.
+func (c Connection) Address() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(int(c.Port)))
+}
+
+// Verifier verifies connections to LDAP servers.
+type Verifier struct {
+	// tlsConfig is used for ldaps connections. The server name is set from the connection host.
+	tlsConfig *tls.Config
+}
+
+// NewVerifier creates a verifier that trusts the system certificate authorities for ldaps connections.
+func NewVerifier() *Verifier {
+	return &Verifier{tlsConfig: &tls.Config{MinVersion: tls.VersionTLS12}}
+}
+
+// Verify connects to the LDAP server and binds with the connection credentials.
+// It returns ErrInvalidCredentials if the server rejects the credentials.
+func (v *Verifier) Verify(ctx context.Context, conn Connection) error {
+	timeout := conn.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	netConn, err := v.dial(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", conn.Address(), err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = netConn.SetDeadline(deadline); err != nil {
+			_ = netConn.Close()
+
+			return fmt.Errorf("failed to set deadline: %w", err)
+		}
+	}
+
+	ldapConn := goldap.NewConn(netConn, conn.Protocol == ProtocolLdaps)
+	ldapConn.SetTimeout(timeout)
+	ldapConn.Start()
+
+	defer ldapConn.Close()
+
+	// the empty password is sent to the server as is, so the server decides if the unauthenticated bind is allowed
+	_, err = ldapConn.SimpleBind(&goldap.SimpleBindRequest{
+		Username:           conn.BindDN,
+		Password:           conn.Password,
+		AllowEmptyPassword: true,
+	})
+
+	// the connection is closed anyway, so the unbind error is not important
+	_ = ldapConn.Unbind()
+
+	switch {
+	case err == nil:
+		return nil
+	case goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials):
+		return fmt.Errorf("failed to bind as %q: %w", conn.BindDN, ErrInvalidCredentials)
+	default:
+		return fmt.Errorf("failed to bind as %q: %w", conn.BindDN, err)
+	}
+}
+
+func (v *Verifier) dial(ctx context.Context, conn Connection) (net.Conn, error) {
+	switch conn.Protocol {
+	case ProtocolLdap, "":
+		dialer := &net.Dialer{}
+
+		return dialer.DialContext(ctx, "tcp", conn.Address())
+	case ProtocolLdaps:
+		tlsConfig := v.tlsConfig.Clone()
+		tlsConfig.ServerName = conn.Host
+
+		dialer := &tls.Dialer{Config: tlsConfig}
+
+		return dialer.DialContext(ctx, "tcp", conn.Address())
+	default:
+		return nil, fmt.Errorf("unsupported protocol %q", conn.Protocol)
+	}
+}
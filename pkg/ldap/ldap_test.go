@@ -0,0 +1,142 @@
+package ldap
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/epam/edp-nexus-operator/pkg/testutils"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	server := testutils.NewLdapServer(t, map[string]string{
+		"cn=admin,dc=example,dc=org": "secret",
+	})
+
+	tests := []struct {
+		name    string
+		conn    func() Connection
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "bind succeeded",
+			conn: func() Connection {
+				return Connection{
+					Protocol: ProtocolLdap,
+					Host:     server.Host(),
+					Port:     server.Port(),
+					BindDN:   "cn=admin,dc=example,dc=org",
+					Password: "secret",
+				}
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "invalid credentials",
+			conn: func() Connection {
+				return Connection{
+					Protocol: ProtocolLdap,
+					Host:     server.Host(),
+					Port:     server.Port(),
+					BindDN:   "cn=admin,dc=example,dc=org",
+					Password: "wrong",
+				}
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrInvalidCredentials)
+				require.ErrorContains(t, err, `failed to bind as "cn=admin,dc=example,dc=org"`)
+			},
+		},
+		{
+			name: "anonymous bind is not allowed",
+			conn: func() Connection {
+				return Connection{
+					Host: server.Host(),
+					Port: server.Port(),
+				}
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrInvalidCredentials)
+			},
+		},
+		{
+			name: "empty password",
+			conn: func() Connection {
+				return Connection{
+					Host:   server.Host(),
+					Port:   server.Port(),
+					BindDN: "cn=admin,dc=example,dc=org",
+				}
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "LDAP Result Code 53 \"Unwilling To Perform\": unauthenticated bind is not allowed")
+			},
+		},
+		{
+			name: "server is not available",
+			conn: func() Connection {
+				return Connection{
+					Protocol: ProtocolLdap,
+					Host:     "127.0.0.1",
+					Port:     unusedPort(t),
+					Timeout:  time.Second,
+				}
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to connect to 127.0.0.1")
+			},
+		},
+		{
+			name: "unsupported protocol",
+			conn: func() Connection {
+				return Connection{
+					Protocol: "http",
+					Host:     server.Host(),
+					Port:     server.Port(),
+				}
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, `unsupported protocol "http"`)
+			},
+		},
+		{
+			name: "ldaps to plain server",
+			conn: func() Connection {
+				return Connection{
+					Protocol: ProtocolLdaps,
+					Host:     server.Host(),
+					Port:     server.Port(),
+					BindDN:   "cn=admin,dc=example,dc=org",
+					Password: "secret",
+					Timeout:  time.Second,
+				}
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "failed to connect to")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.wantErr(t, NewVerifier().Verify(context.Background(), tt.conn()))
+		})
+	}
+}
+
+func unusedPort(t *testing.T) int32 {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	return int32(port)
+}
@@ -0,0 +1,199 @@
+package testutils
+
+import (
+	"bufio"
+	"encoding/asn1"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+)
+
+const (
+	ldapResultSuccess            = 0
+	ldapResultInvalidCredentials = 49
+	ldapResultUnwillingToPerform = 53
+)
+
+// LdapServer is a local stand-in of the LDAP server for tests.
+// It answers simple bind requests and checks the credentials against the given users.
+// Anonymous bind is allowed only if the users contain an empty name.
+type LdapServer struct {
+	listener net.Listener
+	users    map[string]string
+}
+
+// NewLdapServer starts the LDAP stand-in on a random local port. It is stopped when the test finishes.
+func NewLdapServer(t testing.TB, users map[string]string) *LdapServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start ldap server: %v", err)
+	}
+
+	s := &LdapServer{listener: listener, users: users}
+
+	go s.serve()
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	return s
+}
+
+// Host returns the host of the server.
+func (s *LdapServer) Host() string {
+	host, _, _ := net.SplitHostPort(s.listener.Addr().String())
+
+	return host
+}
+
+// Port returns the port of the server.
+func (s *LdapServer) Port() int32 {
+	_, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+
+	return int32(p)
+}
+
+func (s *LdapServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go s.handle(conn)
+	}
+}
+
+func (s *LdapServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+
+	for {
+		message, err := readLdapMessage(r)
+		if err != nil {
+			return
+		}
+
+		id, name, password, err := parseBindRequest(message)
+		if err != nil {
+			// unbind or unsupported operation, close the connection
+			return
+		}
+
+		code, diagnostic := ldapResultInvalidCredentials, "invalid credentials"
+
+		if expected, ok := s.users[name]; ok && expected == password {
+			code, diagnostic = ldapResultSuccess, ""
+		} else if name != "" && password == "" {
+			code, diagnostic = ldapResultUnwillingToPerform, "unauthenticated bind is not allowed"
+		}
+
+		if _, err = conn.Write(bindResponse(id, code, diagnostic)); err != nil {
+			return
+		}
+	}
+}
+
+func readLdapMessage(r *bufio.Reader) ([]byte, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+
+	length := int(header[1])
+
+	if header[1]&0x80 != 0 {
+		lengthBytes := make([]byte, header[1]&0x7f)
+		if _, err := io.ReadFull(r, lengthBytes); err != nil {
+			return nil, err
+		}
+
+		header = append(header, lengthBytes...)
+		length = 0
+
+		for _, b := range lengthBytes {
+			length = length<<8 | int(b)
+		}
+	}
+
+	value := make([]byte, length)
+	if _, err := io.ReadFull(r, value); err != nil {
+		return nil, err
+	}
+
+	return append(header, value...), nil
+}
+
+func parseBindRequest(message []byte) (id int, name, password string, err error) {
+	var ldapMessage struct {
+		ID        int
+		Operation asn1.RawValue
+	}
+
+	if _, err = asn1.Unmarshal(message, &ldapMessage); err != nil {
+		return 0, "", "", err
+	}
+
+	if ldapMessage.Operation.Class != asn1.ClassApplication || ldapMessage.Operation.Tag != 0 {
+		return 0, "", "", errors.New("not a bind request")
+	}
+
+	var (
+		version    int
+		nameBytes  []byte
+		simpleAuth asn1.RawValue
+	)
+
+	rest, err := asn1.Unmarshal(ldapMessage.Operation.Bytes, &version)
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	if rest, err = asn1.Unmarshal(rest, &nameBytes); err != nil {
+		return 0, "", "", err
+	}
+
+	if _, err = asn1.Unmarshal(rest, &simpleAuth); err != nil {
+		return 0, "", "", err
+	}
+
+	return ldapMessage.ID, string(nameBytes), string(simpleAuth.Bytes), nil
+}
+
+// bindResponse encodes the bind response.
+// Lengths are encoded in the 4 bytes long form as some LDAP servers do.
+func bindResponse(id, code int, diagnostic string) []byte {
+	response := concatBytes(
+		[]byte{0x0a, 0x01, byte(code)},
+		[]byte{0x04, 0x00},
+		berElement(0x04, []byte(diagnostic)),
+	)
+
+	return berElement(0x30, concatBytes([]byte{0x02, 0x01, byte(id)}, berElement(0x61, response)))
+}
+
+func berElement(tag byte, value []byte) []byte {
+	length := len(value)
+
+	return concatBytes(
+		[]byte{tag, 0x84, byte(length >> 24), byte(length >> 16), byte(length >> 8), byte(length)},
+		value,
+	)
+}
+
+func concatBytes(parts ...[]byte) []byte {
+	var result []byte
+
+	for _, p := range parts {
+		result = append(result, p...)
+	}
+
+	return result
+}
@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/csel"
+)
+
+// nolint:lll // configuration
+// +kubebuilder:webhook:path=/validate-edp-epam-com-v1alpha1-nexuscontentselector,mutating=false,failurePolicy=fail,sideEffects=None,groups=edp.epam.com,resources=nexuscontentselectors,verbs=create;update,versions=v1alpha1,name=vnexuscontentselector.kb.io,admissionReviewVersions=v1
+
+// NexusContentSelectorValidationWebhook is a webhook for validating NexusContentSelector CRD.
+type NexusContentSelectorValidationWebhook struct {
+}
+
+// NewNexusContentSelectorValidationWebhook creates a new webhook for validating NexusContentSelector CR.
+func NewNexusContentSelectorValidationWebhook() *NexusContentSelectorValidationWebhook {
+	return &NexusContentSelectorValidationWebhook{}
+}
+
+// SetupWebhookWithManager sets up the webhook with the manager for NexusContentSelector CR.
+func (r *NexusContentSelectorValidationWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewWebhookManagedBy(mgr).
+		For(&nexusApi.NexusContentSelector{}).
+		WithValidator(r).
+		Complete()
+	if err != nil {
+		return fmt.Errorf("failed to build NexusContentSelector validation webhook: %w", err)
+	}
+
+	return nil
+}
+
+var _ webhook.CustomValidator = &NexusContentSelectorValidationWebhook{}
+
+// ValidateCreate is a webhook for validating the creation of the NexusContentSelector CR.
+func (*NexusContentSelectorValidationWebhook) ValidateCreate(
+	ctx context.Context,
+	obj runtime.Object,
+) (warnings admission.Warnings, err error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	log.Info("Validate create")
+
+	return nil, validateContentSelector(obj)
+}
+
+// ValidateUpdate is a webhook for validating the updating of the NexusContentSelector CR.
+func (*NexusContentSelectorValidationWebhook) ValidateUpdate(
+	ctx context.Context,
+	_, newObj runtime.Object,
+) (warnings admission.Warnings, err error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	log.Info("Validate update")
+
+	return nil, validateContentSelector(newObj)
+}
+
+// ValidateDelete is a webhook for validating the deleting of the NexusContentSelector CR.
+// It is skipped for now. Deletion of the content selector used by privileges is blocked by the controller.
+func (*NexusContentSelectorValidationWebhook) ValidateDelete(
+	_ context.Context,
+	_ runtime.Object,
+) (warnings admission.Warnings, err error) {
+	return nil, nil
+}
+
+// validateContentSelector checks the syntax of the CSEL expression of the content selector.
+func validateContentSelector(obj runtime.Object) error {
+	contentSelector, ok := obj.(*nexusApi.NexusContentSelector)
+	if !ok {
+		return nil
+	}
+
+	if err := csel.Validate(contentSelector.Spec.Expression); err != nil {
+		return fmt.Errorf("object NexusContentSelector %s is invalid: expression is invalid: %w",
+			contentSelector.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+)
+
+func TestNexusContentSelectorValidationWebhook_ValidateCreate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		obj     runtime.Object
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "NexusContentSelector CR is valid",
+			obj: &nexusApi.NexusContentSelector{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+				Spec: nexusApi.NexusContentSelectorSpec{
+					Name:       "team-a",
+					Expression: `format == "maven2" and path =^ "/com/acme/teama/"`,
+				},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "NexusContentSelector CR has invalid expression",
+			obj: &nexusApi.NexusContentSelector{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+				Spec: nexusApi.NexusContentSelectorSpec{
+					Name:       "team-a",
+					Expression: `format == "maven2" and path =^`,
+				},
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "object NexusContentSelector team-a is invalid: expression is invalid")
+			},
+		},
+		{
+			name:    "skip validation for the wrong object",
+			obj:     &nexusApi.NexusRole{},
+			wantErr: require.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewNexusContentSelectorValidationWebhook()
+			ctx := ctrl.LoggerInto(context.Background(), logr.Discard())
+
+			_, err := r.ValidateCreate(ctx, tt.obj)
+			tt.wantErr(t, err)
+
+			_, err = r.ValidateUpdate(ctx, tt.obj, tt.obj)
+			tt.wantErr(t, err)
+		})
+	}
+}
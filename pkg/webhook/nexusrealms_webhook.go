@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+// nolint:lll // configuration
+// +kubebuilder:webhook:path=/validate-edp-epam-com-v1alpha1-nexusrealms,mutating=false,failurePolicy=fail,sideEffects=None,groups=edp.epam.com,resources=nexusrealms,verbs=create;update,versions=v1alpha1,name=vnexusrealms.kb.io,admissionReviewVersions=v1
+
+// realmsLookupTimeout limits the request of available realms,
+// so the webhook answers before the API server times out the admission request after 10 seconds.
+const realmsLookupTimeout = 5 * time.Second
+
+type realmClientProvider interface {
+	GetNexusRealmClientFromNexusRef(
+		ctx context.Context,
+		namespace string,
+		ref common.HasNexusRef,
+	) (*nexus.RealmClient, error)
+}
+
+// NexusRealmsValidationWebhook is a webhook for validating NexusRealms CRD.
+type NexusRealmsValidationWebhook struct {
+	apiClientProvider realmClientProvider
+	lookupTimeout     time.Duration
+}
+
+// NewNexusRealmsValidationWebhook creates a new webhook for validating NexusRealms CR.
+func NewNexusRealmsValidationWebhook(apiClientProvider realmClientProvider) *NexusRealmsValidationWebhook {
+	return &NexusRealmsValidationWebhook{apiClientProvider: apiClientProvider, lookupTimeout: realmsLookupTimeout}
+}
+
+// SetupWebhookWithManager sets up the webhook with the manager for NexusRealms CR.
+func (r *NexusRealmsValidationWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewWebhookManagedBy(mgr).
+		For(&nexusApi.NexusRealms{}).
+		WithValidator(r).
+		Complete()
+	if err != nil {
+		return fmt.Errorf("failed to build NexusRealms validation webhook: %w", err)
+	}
+
+	return nil
+}
+
+var _ webhook.CustomValidator = &NexusRealmsValidationWebhook{}
+
+// ValidateCreate is a webhook for validating the creation of the NexusRealms CR.
+func (r *NexusRealmsValidationWebhook) ValidateCreate(
+	ctx context.Context,
+	obj runtime.Object,
+) (warnings admission.Warnings, err error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	log.Info("Validate create")
+
+	return r.validateRealms(ctx, obj)
+}
+
+// ValidateUpdate is a webhook for validating the updating of the NexusRealms CR.
+func (r *NexusRealmsValidationWebhook) ValidateUpdate(
+	ctx context.Context,
+	_, newObj runtime.Object,
+) (warnings admission.Warnings, err error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	log.Info("Validate update")
+
+	return r.validateRealms(ctx, newObj)
+}
+
+// ValidateDelete is a webhook for validating the deleting of the NexusRealms CR.
+// It is skipped for now.
+func (*NexusRealmsValidationWebhook) ValidateDelete(
+	_ context.Context,
+	_ runtime.Object,
+) (warnings admission.Warnings, err error) {
+	return nil, nil
+}
+
+// validateRealms checks that the active realms are available in nexus.
+// If nexus is not reachable or doesn't answer in time, the resource is accepted with a warning,
+// so it can be applied before Nexus is ready. Nexus rejects unknown realms anyway.
+func (r *NexusRealmsValidationWebhook) validateRealms(
+	ctx context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	realms, ok := obj.(*nexusApi.NexusRealms)
+	if !ok {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.lookupTimeout)
+	defer cancel()
+
+	realmClient, err := r.apiClientProvider.GetNexusRealmClientFromNexusRef(ctx, realms.Namespace, realms)
+	if err != nil {
+		return admission.Warnings{fmt.Sprintf("available realms were not checked: %s", err)}, nil
+	}
+
+	availableRealms, err := realmClient.ListAvailable(ctx)
+	if err != nil {
+		return admission.Warnings{fmt.Sprintf("available realms were not checked: %s", err)}, nil
+	}
+
+	available := make([]string, 0, len(availableRealms))
+	for _, realm := range availableRealms {
+		available = append(available, realm.ID)
+	}
+
+	var unknown []string
+
+	for _, id := range realms.Spec.ActiveRealms {
+		if !slices.Contains(available, id) {
+			unknown = append(unknown, id)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("object NexusRealms %s is invalid: realms %s are not available in nexus, available realms: %s",
+			realms.Name, strings.Join(unknown, ", "), strings.Join(available, ", "))
+	}
+
+	return nil, nil
+}
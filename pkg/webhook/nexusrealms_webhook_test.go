@@ -0,0 +1,127 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/epam/edp-nexus-operator/api/common"
+	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
+)
+
+type testApiClientProvider struct {
+	url string
+	err error
+}
+
+func (p *testApiClientProvider) GetNexusRealmClientFromNexusRef(
+	_ context.Context,
+	_ string,
+	_ common.HasNexusRef,
+) (*nexus.RealmClient, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return nexus.NewRealmClient(nexus.ClientConfig{BaseURL: p.url}), nil
+}
+
+func TestNexusRealmsValidationWebhook_ValidateCreate(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "/service/rest/v1/security/realms/available", req.URL.Path)
+
+		rw.Header().Set("Content-Type", "application/json")
+		// nolint:errcheck // we can skip err here
+		rw.Write([]byte(`[{"id":"NexusAuthenticatingRealm","name":"Local Authenticating Realm"},` +
+			`{"id":"DockerToken","name":"Docker Bearer Token Realm"},{"id":"LdapRealm","name":"LDAP Realm"}]`))
+	}))
+	defer server.Close()
+
+	slowServer := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, req *http.Request) {
+		<-req.Context().Done()
+	}))
+	defer slowServer.Close()
+
+	realms := func(ids ...string) *nexusApi.NexusRealms {
+		return &nexusApi.NexusRealms{
+			ObjectMeta: metav1.ObjectMeta{Name: "realms"},
+			Spec: nexusApi.NexusRealmsSpec{
+				ActiveRealms: ids,
+				NexusRef:     common.NexusRef{Name: "nexus"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		obj          runtime.Object
+		provider     *testApiClientProvider
+		wantErr      require.ErrorAssertionFunc
+		wantWarnings bool
+	}{
+		{
+			name:     "NexusRealms CR is valid",
+			obj:      realms("NexusAuthenticatingRealm", "DockerToken"),
+			provider: &testApiClientProvider{url: server.URL},
+			wantErr:  require.NoError,
+		},
+		{
+			name:     "NexusRealms CR has unavailable realms",
+			obj:      realms("NexusAuthenticatingRealm", "SamlRealm", "NpmToken"),
+			provider: &testApiClientProvider{url: server.URL},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(t, err, "realms SamlRealm, NpmToken are not available in nexus")
+				require.ErrorContains(t, err, "available realms: NexusAuthenticatingRealm, DockerToken, LdapRealm")
+			},
+		},
+		{
+			name:         "nexus is not available",
+			obj:          realms("NexusAuthenticatingRealm"),
+			provider:     &testApiClientProvider{err: errors.New("nexus not found")},
+			wantErr:      require.NoError,
+			wantWarnings: true,
+		},
+		{
+			name:         "nexus doesn't answer in time",
+			obj:          realms("NexusAuthenticatingRealm"),
+			provider:     &testApiClientProvider{url: slowServer.URL},
+			wantErr:      require.NoError,
+			wantWarnings: true,
+		},
+		{
+			name:     "skip validation for the wrong object",
+			obj:      &nexusApi.NexusRole{},
+			provider: &testApiClientProvider{url: server.URL},
+			wantErr:  require.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewNexusRealmsValidationWebhook(tt.provider)
+			r.lookupTimeout = 100 * time.Millisecond
+			ctx := ctrl.LoggerInto(context.Background(), logr.Discard())
+
+			warnings, err := r.ValidateCreate(ctx, tt.obj)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantWarnings, len(warnings) > 0)
+
+			warnings, err = r.ValidateUpdate(ctx, tt.obj, tt.obj)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantWarnings, len(warnings) > 0)
+		})
+	}
+}
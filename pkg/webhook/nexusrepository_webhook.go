@@ -95,6 +95,10 @@ func (*NexusRepositoryValidationWebhook) ValidateUpdate(
 		return nil, fmt.Errorf("object NexusRepository %s is invalid: %w", updatedNexusRepository.Name, err)
 	}
 
+	if err = validateRename(oldNexusRepository, updatedNexusRepository); err != nil {
+		return nil, fmt.Errorf("object NexusRepository %s is invalid: %w", updatedNexusRepository.Name, err)
+	}
+
 	return nil, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -41,6 +42,25 @@ func TestNexusRepositoryValidationWebhook_ValidateCreate(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "NexusRepository CR with deletion policy is valid",
+			obj: &nexusApi.NexusRepository{
+				Spec: nexusApi.NexusRepositorySpec{
+					Go: &nexusApi.GoSpec{
+						Proxy: &nexusApi.GoProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name: "go-proxy",
+							},
+						},
+					},
+					NexusRef: common.NexusRef{
+						Name: "nexus",
+					},
+					DeletionPolicy: common.DeletionPolicyOrphan,
+				},
+			},
+			wantErr: require.NoError,
+		},
 		{
 			name: "NexusRepository CR is invalid - multiple types",
 			obj: &nexusApi.NexusRepository{
@@ -224,6 +244,66 @@ func TestNexusRepositoryValidationWebhook_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "NexusRepository CR is invalid - name changed",
+			oldObj: &nexusApi.NexusRepository{
+				Spec: nexusApi.NexusRepositorySpec{
+					Go: &nexusApi.GoSpec{
+						Proxy: &nexusApi.GoProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name: "go-proxy",
+							},
+						},
+					},
+				},
+			},
+			newObj: &nexusApi.NexusRepository{
+				Spec: nexusApi.NexusRepositorySpec{
+					Go: &nexusApi.GoSpec{
+						Proxy: &nexusApi.GoProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name: "go-proxy-new",
+							},
+						},
+					},
+				},
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "repository name cannot be changed from go-proxy to go-proxy-new")
+				require.Contains(t, err.Error(), "the old repository is left in nexus")
+				require.Contains(t, err.Error(), nexusApi.RenameDeleteOldAnnotation)
+			},
+		},
+		{
+			name: "NexusRepository CR is valid - name changed with rename annotation",
+			oldObj: &nexusApi.NexusRepository{
+				Spec: nexusApi.NexusRepositorySpec{
+					Go: &nexusApi.GoSpec{
+						Proxy: &nexusApi.GoProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name: "go-proxy",
+							},
+						},
+					},
+				},
+			},
+			newObj: &nexusApi.NexusRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{nexusApi.AllowRenameAnnotation: "true"},
+				},
+				Spec: nexusApi.NexusRepositorySpec{
+					Go: &nexusApi.GoSpec{
+						Proxy: &nexusApi.GoProxyRepository{
+							ProxySpec: nexusApi.ProxySpec{
+								Name: "go-proxy-new",
+							},
+						},
+					},
+				},
+			},
+			wantErr: require.NoError,
+		},
 		{
 			name: "NexusRepository CR is invalid - no format",
 			oldObj: &nexusApi.NexusRepository{
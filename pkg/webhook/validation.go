@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	nexusApi "github.com/epam/edp-nexus-operator/api/v1alpha1"
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
 func validateCreate(spec *nexusApi.NexusRepositorySpec) error {
@@ -66,8 +67,41 @@ func validateUpdate(oldSpec, newSpec *nexusApi.NexusRepositorySpec) error {
 	return nil
 }
 
+// validateRename checks that the name of the repository is not changed without AllowRenameAnnotation.
+func validateRename(oldRepository, newRepository *nexusApi.NexusRepository) error {
+	oldData, err := nexus.GetRepoData(&oldRepository.Spec)
+	if err != nil {
+		// the old object can't be created in nexus, so there is nothing to rename
+		return nil
+	}
+
+	newData, err := nexus.GetRepoData(&newRepository.Spec)
+	if err != nil {
+		return fmt.Errorf("unable to get repository data: %w", err)
+	}
+
+	if oldData.Name == newData.Name || newRepository.Annotations[nexusApi.AllowRenameAnnotation] == "true" {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"repository name cannot be changed from %s to %s, set %s annotation to \"true\" "+
+			"to create the repository with the new name, the content is not moved and the old repository is left in nexus; "+
+			"set %s annotation to \"true\" to also delete the old repository with all its content",
+		oldData.Name,
+		newData.Name,
+		nexusApi.AllowRenameAnnotation,
+		nexusApi.RenameDeleteOldAnnotation,
+	)
+}
+
 func specToMap(spec *nexusApi.NexusRepositorySpec) (map[string]map[string]interface{}, error) {
-	specj, err := json.Marshal(spec)
+	// only repository formats are validated, other fields are removed from the map
+	formats := spec.DeepCopy()
+	formats.DeletionPolicy = ""
+	formats.ManagementPolicy = ""
+
+	specj, err := json.Marshal(formats)
 	if err != nil {
 		return nil, fmt.Errorf("unable to marshal spec: %w", err)
 	}
@@ -7,17 +7,25 @@ import (
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 	"github.com/epam/edp-nexus-operator/pkg/helper"
 )
 
 // RegisterValidationWebHook registers a new webhook for validating CRD.
-func RegisterValidationWebHook(ctx context.Context, mgr ctrl.Manager, namespace string) error {
+// Self-signed certificates are stored in the operator namespace, so the webhook works in the cluster-wide mode.
+// apiClientProvider is shared with the controllers, so the webhook reuses cached nexus clients.
+func RegisterValidationWebHook(
+	ctx context.Context,
+	mgr ctrl.Manager,
+	namespace string,
+	apiClientProvider *nexus.ApiClientProvider,
+) error {
 	// for OLM installation we need to skip creating self-signed certificates. Certificates are managed by OLM.
 	if os.Getenv("SETUP_SELF_SIGNED_CERTIFICATES") != "false" {
 		if namespace == "" {
 			return fmt.Errorf(
-				"self-signed certificates can't be created in AllNamespaces mode, please specify %s",
-				helper.WatchNamespaceEnvVar,
+				"self-signed certificates can't be created without the operator namespace, please specify %s",
+				helper.OperatorNamespaceEnvVar,
 			)
 		}
 
@@ -33,5 +41,15 @@ func RegisterValidationWebHook(ctx context.Context, mgr ctrl.Manager, namespace
 		return fmt.Errorf("failed to create webhook: %w", err)
 	}
 
+	nexusContentSelectorWebHook := NewNexusContentSelectorValidationWebhook()
+	if err := nexusContentSelectorWebHook.SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	nexusRealmsWebHook := NewNexusRealmsValidationWebhook(apiClientProvider)
+	if err := nexusRealmsWebHook.SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
 	return nil
 }
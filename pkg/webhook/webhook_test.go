@@ -11,6 +11,8 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/epam/edp-nexus-operator/pkg/client/nexus"
 )
 
 var _ = Describe("webhooks registration", func() {
@@ -44,7 +46,7 @@ var _ = Describe("webhooks registration", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			By("registering validation webhooks")
-			err = RegisterValidationWebHook(ctx, k8sManager, "default")
+			err = RegisterValidationWebHook(ctx, k8sManager, "default", nexus.NewApiClientProvider(k8sManager.GetClient()))
 			Expect(err).ToNot(HaveOccurred())
 		})
 		When("scheme doesn't contain nexus types", func() {
@@ -59,7 +61,7 @@ var _ = Describe("webhooks registration", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				By("registering validation webhooks")
-				err = RegisterValidationWebHook(ctx, k8sManager, "default")
+				err = RegisterValidationWebHook(ctx, k8sManager, "default", nexus.NewApiClientProvider(k8sManager.GetClient()))
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -80,12 +82,12 @@ var _ = Describe("webhooks registration", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				By("registering validation webhooks")
-				err = RegisterValidationWebHook(ctx, k8sManager, "default")
+				err = RegisterValidationWebHook(ctx, k8sManager, "default", nexus.NewApiClientProvider(k8sManager.GetClient()))
 				Expect(err).ToNot(HaveOccurred())
 			})
 		})
 		When("namespace is empty and SETUP_SELF_SIGNED_CERTIFICATES is not false", func() {
-			It("should return error about AllNamespaces mode", func() {
+			It("should return error about missing operator namespace", func() {
 				By("creating manager")
 				k8sManager, err := ctrl.NewManager(cfg, ctrl.Options{
 					Scheme: k8sClient.Scheme(),
@@ -96,9 +98,9 @@ var _ = Describe("webhooks registration", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				By("registering validation webhooks with empty namespace")
-				err = RegisterValidationWebHook(ctx, k8sManager, "")
+				err = RegisterValidationWebHook(ctx, k8sManager, "", nexus.NewApiClientProvider(k8sManager.GetClient()))
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("self-signed certificates can't be created in AllNamespaces mode"))
+				Expect(err.Error()).To(ContainSubstring("self-signed certificates can't be created without the operator namespace"))
 			})
 		})
 	})
@@ -114,7 +116,7 @@ var _ = Describe("webhooks registration", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			By("registering mutation webhooks")
-			err = RegisterValidationWebHook(ctx, k8sManager, "default")
+			err = RegisterValidationWebHook(ctx, k8sManager, "default", nexus.NewApiClientProvider(k8sManager.GetClient()))
 			Expect(err).To(HaveOccurred())
 		})
 	})
@@ -0,0 +1,5 @@
+package client
+
+type Service struct {
+	Client *Client
+}
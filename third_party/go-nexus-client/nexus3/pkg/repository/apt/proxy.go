@@ -0,0 +1,75 @@
+package apt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	aptProxyAPIEndpoint = repositoryAptAPIEndpoint + "/proxy"
+)
+
+type RepositoryAptProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryAptProxyService(c *client.Client) *RepositoryAptProxyService {
+	return &RepositoryAptProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryAptProxyService) Create(repo repository.AptProxyRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := s.client.Post(aptProxyAPIEndpoint, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create repository '%s': HTTP: %d, %s", repo.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *RepositoryAptProxyService) Get(id string) (*repository.AptProxyRepository, error) {
+	var repo repository.AptProxyRepository
+	body, resp, err := s.client.Get(fmt.Sprintf("%s/%s", aptProxyAPIEndpoint, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	if err := json.Unmarshal(body, &repo); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryAptProxyService) Update(id string, repo repository.AptProxyRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := s.client.Put(fmt.Sprintf("%s/%s", aptProxyAPIEndpoint, id), data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *RepositoryAptProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
@@ -0,0 +1,75 @@
+package docker
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	dockerHostedAPIEndpoint = dockerAPIEndpoint + "/hosted"
+)
+
+type RepositoryDockerHostedService struct {
+	client *client.Client
+}
+
+func NewRepositoryDockerHostedService(c *client.Client) *RepositoryDockerHostedService {
+	return &RepositoryDockerHostedService{
+		client: c,
+	}
+}
+
+func (s *RepositoryDockerHostedService) Create(repo repository.DockerHostedRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := s.client.Post(dockerHostedAPIEndpoint, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create repository '%s': HTTP: %d, %s", repo.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *RepositoryDockerHostedService) Get(id string) (*repository.DockerHostedRepository, error) {
+	var repo repository.DockerHostedRepository
+	body, resp, err := s.client.Get(fmt.Sprintf("%s/%s", dockerHostedAPIEndpoint, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	if err := json.Unmarshal(body, &repo); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryDockerHostedService) Update(id string, repo repository.DockerHostedRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := s.client.Put(fmt.Sprintf("%s/%s", dockerHostedAPIEndpoint, id), data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *RepositoryDockerHostedService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
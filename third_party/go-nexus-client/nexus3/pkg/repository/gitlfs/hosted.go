@@ -0,0 +1,75 @@
+package gitlfs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	gitLfsHostedAPIEndpoint = gitLfsAPIEndpoint + "/hosted"
+)
+
+type RepositoryGitLfsHostedService struct {
+	client *client.Client
+}
+
+func NewRepositoryGitLfsHostedService(c *client.Client) *RepositoryGitLfsHostedService {
+	return &RepositoryGitLfsHostedService{
+		client: c,
+	}
+}
+
+func (s *RepositoryGitLfsHostedService) Create(repo repository.GitLfsHostedRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := s.client.Post(gitLfsHostedAPIEndpoint, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create repository '%s': HTTP: %d, %s", repo.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *RepositoryGitLfsHostedService) Get(id string) (*repository.GitLfsHostedRepository, error) {
+	var repo repository.GitLfsHostedRepository
+	body, resp, err := s.client.Get(fmt.Sprintf("%s/%s", gitLfsHostedAPIEndpoint, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	if err := json.Unmarshal(body, &repo); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryGitLfsHostedService) Update(id string, repo repository.GitLfsHostedRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := s.client.Put(fmt.Sprintf("%s/%s", gitLfsHostedAPIEndpoint, id), data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *RepositoryGitLfsHostedService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
@@ -0,0 +1,60 @@
+package privilege
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+)
+
+const (
+	securityRepositoryAdminAPIEndpoint = securityPrivilegesAPIEndpoint + "/repository-admin"
+)
+
+type SecurityPrivilegeRepositoryAdminService struct {
+	client *client.Client
+}
+
+func NewSecurityPrivilegeRepositoryAdminService(c *client.Client) *SecurityPrivilegeRepositoryAdminService {
+	return &SecurityPrivilegeRepositoryAdminService{
+		client: c,
+	}
+}
+
+func (s *SecurityPrivilegeRepositoryAdminService) Create(p security.PrivilegeRepositoryAdmin) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(p)
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := s.client.Post(securityRepositoryAdminAPIEndpoint, ioReader)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create privilege \"%s\": HTTP: %d, %s", p.Name, resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+func (s *SecurityPrivilegeRepositoryAdminService) Update(name string, p security.PrivilegeRepositoryAdmin) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(p)
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := s.client.Put(fmt.Sprintf("%s/%s", securityRepositoryAdminAPIEndpoint, p.Name), ioReader)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update privilege \"%s\": HTTP %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	return nil
+}
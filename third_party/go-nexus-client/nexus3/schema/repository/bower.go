@@ -0,0 +1,41 @@
+package repository
+
+type BowerGroupRepository struct {
+	Name    string `json:"name"`
+	Online  bool   `json:"online"`
+	Group   `json:"group"`
+	Storage `json:"storage"`
+}
+
+type BowerHostedRepository struct {
+	Name    string        `json:"name"`
+	Online  bool          `json:"online"`
+	Storage HostedStorage `json:"storage"`
+
+	*Cleanup   `json:"cleanup,omitempty"`
+	*Component `json:"component,omitempty"`
+}
+
+type BowerProxyRepository struct {
+	Name          string `json:"name"`
+	Online        bool   `json:"online"`
+	Storage       `json:"storage"`
+	Proxy         `json:"proxy"`
+	NegativeCache `json:"negativeCache"`
+	HTTPClient    `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*Cleanup `json:"cleanup,omitempty"`
+	Bower    `json:"bower"`
+}
+
+type Bower struct {
+	// Whether to force Bower to retrieve packages through this proxy repository
+	RewritePackageUrls bool `json:"rewritePackageUrls"`
+}
@@ -0,0 +1,19 @@
+package repository
+
+type ConanProxyRepository struct {
+	Name          string `json:"name"`
+	Online        bool   `json:"online"`
+	Storage       `json:"storage"`
+	Proxy         `json:"proxy"`
+	NegativeCache `json:"negativeCache"`
+	HTTPClient    `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*Cleanup `json:"cleanup,omitempty"`
+}
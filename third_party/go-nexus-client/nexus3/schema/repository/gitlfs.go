@@ -0,0 +1,10 @@
+package repository
+
+type GitLfsHostedRepository struct {
+	Name    string        `json:"name"`
+	Online  bool          `json:"online"`
+	Storage HostedStorage `json:"storage"`
+
+	*Cleanup   `json:"cleanup,omitempty"`
+	*Component `json:"component,omitempty"`
+}
@@ -0,0 +1,7 @@
+package security
+
+// Realm represents an instance of Nexus Realm
+type Realm struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
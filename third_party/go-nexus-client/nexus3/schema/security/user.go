@@ -0,0 +1,13 @@
+package security
+
+// User ..
+type User struct {
+	UserID       string   `json:"userId"`
+	FirstName    string   `json:"firstName"`
+	LastName     string   `json:"lastName"`
+	EmailAddress string   `json:"emailAddress"`
+	Password     string   `json:"password"`
+	Status       string   `json:"status"`
+	Source       string   `json:"source"`
+	Roles        []string `json:"roles"`
+}